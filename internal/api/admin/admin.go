@@ -16,20 +16,30 @@ import (
 	"github.com/openimsdk/tools/apiresp"
 	"github.com/openimsdk/tools/errs"
 	"github.com/xuri/excelize/v2"
+	"net/http"
 )
 
 type ApiAdmin struct {
-	client             rpcclient.User
-	userStorageHandler controller.User
-	config             *Config
-	tokenVerify        *token.Token
+	client                   rpcclient.User
+	userStorageHandler       controller.User
+	webhookStorageHandler    controller.Webhook
+	meetingStorageHandler    controller.Meeting
+	attendanceStorageHandler controller.Attendance
+	webhookClient            *http.Client
+	config                   *Config
+	tokenVerify              *token.Token
 }
 
-func NewAdminApi(userStorage controller.User, client rpcclient.User, t *token.Token) *ApiAdmin {
+func NewAdminApi(userStorage controller.User, webhookStorage controller.Webhook, meetingStorage controller.Meeting,
+	attendanceStorage controller.Attendance, client rpcclient.User, t *token.Token) *ApiAdmin {
 	return &ApiAdmin{
-		client:             client,
-		userStorageHandler: userStorage,
-		tokenVerify:        t,
+		client:                   client,
+		userStorageHandler:       userStorage,
+		webhookStorageHandler:    webhookStorage,
+		meetingStorageHandler:    meetingStorage,
+		attendanceStorageHandler: attendanceStorage,
+		webhookClient:            &http.Client{},
+		tokenVerify:              t,
 	}
 }
 
@@ -0,0 +1,257 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/openmeeting-server/pkg/apistruct"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	"github.com/openimsdk/openmeeting-server/pkg/common/xlsx"
+	"github.com/openimsdk/openmeeting-server/pkg/common/xlsx/definition"
+	"github.com/openimsdk/tools/a2r"
+	"github.com/openimsdk/tools/apiresp"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	reportFormatXlsx = "xlsx"
+	reportFormatCSV  = "csv"
+
+	reportAttendance = "attendance"
+	reportHostChange = "host_change"
+
+	// maxReportRange limits the meetings exported at a time by their time range, the report is built in memory
+	maxReportRange = 31 * 24 * 60 * 60
+
+	reportTimeLayout = "2006-01-02 15:04:05"
+	xlsxContentType  = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+)
+
+// ExportMeetingReport download the attendance and the host changes of a meeting or the meetings within a time range
+func (a *ApiAdmin) ExportMeetingReport(c *gin.Context) {
+	req, err := a2r.ParseRequest[apistruct.ExportMeetingReportReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if err := checkMeetingReport(req); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	loc := time.UTC
+	if req.TimeZone != "" {
+		if loc, err = time.LoadLocation(req.TimeZone); err != nil {
+			apiresp.GinError(c, errs.ErrArgs.WrapMsg("invalid time zone", "timeZone", req.TimeZone))
+			return
+		}
+	}
+
+	var (
+		attendances []*model.MeetingAttendance
+		hostChanges []*model.MeetingHostChange
+	)
+	if req.MeetingID != "" {
+		if attendances, err = a.attendanceStorageHandler.Find(c, req.MeetingID, req.OccurrenceID); err != nil {
+			apiresp.GinError(c, errs.WrapMsg(err, "get attendance failed"))
+			return
+		}
+		if hostChanges, err = a.attendanceStorageHandler.FindHostChanges(c, req.MeetingID, req.OccurrenceID); err != nil {
+			apiresp.GinError(c, errs.WrapMsg(err, "get host changes failed"))
+			return
+		}
+	} else {
+		if attendances, err = a.attendanceStorageHandler.FindByJoinTime(c, req.StartTime, req.EndTime); err != nil {
+			apiresp.GinError(c, errs.WrapMsg(err, "get attendance failed"))
+			return
+		}
+		if hostChanges, err = a.attendanceStorageHandler.FindHostChangesByTime(c, req.StartTime, req.EndTime); err != nil {
+			apiresp.GinError(c, errs.WrapMsg(err, "get host changes failed"))
+			return
+		}
+	}
+
+	meetingIDs := datautil.Distinct(append(
+		datautil.Slice(attendances, func(e *model.MeetingAttendance) string { return e.MeetingID }),
+		datautil.Slice(hostChanges, func(e *model.MeetingHostChange) string { return e.MeetingID })...))
+	userIDs := datautil.Slice(attendances, func(e *model.MeetingAttendance) string { return e.UserID })
+	for _, change := range hostChanges {
+		userIDs = append(userIDs, change.PreviousHostUserID, change.HostUserID)
+	}
+	meetingMap := a.getReportMeetings(c, meetingIDs)
+	userMap := a.getReportUsers(c, datautil.Distinct(userIDs))
+
+	attendanceRows := convertAttendanceRows(attendances, meetingMap, userMap, loc, time.Now().Unix())
+	hostChangeRows := convertHostChangeRows(hostChanges, meetingMap, userMap, loc)
+
+	name := req.MeetingID
+	if name == "" {
+		name = fmt.Sprintf("%s_%s", time.Unix(req.StartTime, 0).In(loc).Format("20060102"),
+			time.Unix(req.EndTime, 0).In(loc).Format("20060102"))
+	}
+	buf := &bytes.Buffer{}
+	contentType := xlsxContentType
+	if req.Format == reportFormatCSV {
+		var rows any = attendanceRows
+		if req.Report == reportHostChange {
+			rows = hostChangeRows
+		}
+		name = fmt.Sprintf("meeting_%s_%s.csv", xlsx.GetSheetName(rows), name)
+		contentType = "text/csv"
+		err = xlsx.ExportCSV(buf, rows)
+	} else {
+		name = fmt.Sprintf("meeting_report_%s.xlsx", name)
+		err = exportXlsx(c, buf, attendanceRows, hostChangeRows)
+	}
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename*=UTF-8''%s", url.PathEscape(name)))
+	c.Data(http.StatusOK, contentType, buf.Bytes())
+}
+
+func checkMeetingReport(req *apistruct.ExportMeetingReportReq) error {
+	switch req.Format {
+	case "":
+		req.Format = reportFormatXlsx
+	case reportFormatXlsx, reportFormatCSV:
+	default:
+		return errs.ErrArgs.WrapMsg("format should be xlsx or csv", "format", req.Format)
+	}
+	switch req.Report {
+	case "":
+		req.Report = reportAttendance
+	case reportAttendance, reportHostChange:
+	default:
+		return errs.ErrArgs.WrapMsg("report should be attendance or host_change", "report", req.Report)
+	}
+	if req.MeetingID != "" {
+		return nil
+	}
+	if req.OccurrenceID != "" {
+		return errs.ErrArgs.WrapMsg("occurrenceID requires the meetingID")
+	}
+	if req.StartTime <= 0 || req.EndTime <= req.StartTime {
+		return errs.ErrArgs.WrapMsg("meetingID or a valid time range is required", "startTime", req.StartTime, "endTime", req.EndTime)
+	}
+	if req.EndTime-req.StartTime > maxReportRange {
+		return errs.ErrArgs.WrapMsg("time range of the report is too long", "maxSeconds", maxReportRange)
+	}
+	return nil
+}
+
+func exportXlsx(ctx context.Context, buf *bytes.Buffer, attendanceRows []*definition.Attendance, hostChangeRows []*definition.HostChange) error {
+	f, err := xlsx.Export(attendanceRows, hostChangeRows)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.ZWarn(ctx, "close xlsx file failed", err)
+		}
+	}()
+	if _, err := f.WriteTo(buf); err != nil {
+		return errs.WrapMsg(err, "write xlsx failed")
+	}
+	return nil
+}
+
+// getReportMeetings get the meetings of the report, the titles are left empty if the meetings are not available
+func (a *ApiAdmin) getReportMeetings(c *gin.Context, meetingIDs []string) map[string]*model.MeetingInfo {
+	if len(meetingIDs) == 0 {
+		return nil
+	}
+	meetings, err := a.meetingStorageHandler.FindByMeetingIDs(c, meetingIDs, nil)
+	if err != nil {
+		log.ZWarn(c, "get meetings of the report failed", err, "meetingIDs", meetingIDs)
+	}
+	return datautil.SliceToMap(meetings, func(e *model.MeetingInfo) string {
+		return e.MeetingID
+	})
+}
+
+// getReportUsers get the users of the report, the nicknames are left empty if the users are not available, e.g., deleted
+func (a *ApiAdmin) getReportUsers(c *gin.Context, userIDs []string) map[string]*model.User {
+	userIDs = datautil.Filter(userIDs, func(e string) (string, bool) {
+		return e, e != ""
+	})
+	if len(userIDs) == 0 {
+		return nil
+	}
+	users, err := a.userStorageHandler.FindWithError(c, userIDs)
+	if err != nil {
+		log.ZWarn(c, "get users of the report failed", err, "userIDs", userIDs)
+	}
+	return datautil.SliceToMap(users, func(e *model.User) string {
+		return e.UserID
+	})
+}
+
+func formatReportTime(ts int64, loc *time.Location) string {
+	if ts <= 0 {
+		return ""
+	}
+	return time.Unix(ts, 0).In(loc).Format(reportTimeLayout)
+}
+
+// convertAttendanceRows the duration of the users still in the meeting is counted until now
+func convertAttendanceRows(attendances []*model.MeetingAttendance, meetingMap map[string]*model.MeetingInfo,
+	userMap map[string]*model.User, loc *time.Location, now int64) []*definition.Attendance {
+	rows := make([]*definition.Attendance, 0, len(attendances))
+	for _, attendance := range attendances {
+		leaveTime := attendance.LeaveTime
+		if leaveTime == 0 {
+			leaveTime = now
+		}
+		row := &definition.Attendance{
+			MeetingID:    attendance.MeetingID,
+			OccurrenceID: attendance.OccurrenceID,
+			UserID:       attendance.UserID,
+			JoinTime:     formatReportTime(attendance.JoinTime, loc),
+			LeaveTime:    formatReportTime(attendance.LeaveTime, loc),
+			Duration:     max(leaveTime-attendance.JoinTime, 0),
+			LeaveReason:  attendance.LeaveReason,
+		}
+		if meeting, ok := meetingMap[attendance.MeetingID]; ok {
+			row.Title = meeting.Title
+		}
+		if user, ok := userMap[attendance.UserID]; ok {
+			row.Account = user.Account
+			row.Nickname = user.Nickname
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func convertHostChangeRows(changes []*model.MeetingHostChange, meetingMap map[string]*model.MeetingInfo,
+	userMap map[string]*model.User, loc *time.Location) []*definition.HostChange {
+	rows := make([]*definition.HostChange, 0, len(changes))
+	for _, change := range changes {
+		row := &definition.HostChange{
+			MeetingID:          change.MeetingID,
+			OccurrenceID:       change.OccurrenceID,
+			ChangeTime:         formatReportTime(change.ChangeTime, loc),
+			PreviousHostUserID: change.PreviousHostUserID,
+			HostUserID:         change.HostUserID,
+			OperatorUserID:     change.OperatorUserID,
+		}
+		if meeting, ok := meetingMap[change.MeetingID]; ok {
+			row.Title = meeting.Title
+		}
+		if user, ok := userMap[change.PreviousHostUserID]; ok {
+			row.PreviousHost = user.Nickname
+		}
+		if user, ok := userMap[change.HostUserID]; ok {
+			row.Host = user.Nickname
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
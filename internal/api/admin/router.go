@@ -54,12 +54,35 @@ func newAdminGinRouter(ctx context.Context, disCov discovery.SvcDiscoveryRegistr
 	}
 	userCache := redis.NewUser(rdb, userDB, redis.GetDefaultOpt())
 	database := controller.NewUser(userDB, userCache, mgoCli.GetTx())
+	subscriptionDB, err := mgo.NewWebhookSubscriptionMongo(mgoCli.GetDB())
+	if err != nil {
+		return nil
+	}
+	deadLetterDB, err := mgo.NewWebhookDeadLetterMongo(mgoCli.GetDB())
+	if err != nil {
+		return nil
+	}
+	meetingDB, err := mgo.NewMeetingMongo(mgoCli.GetDB())
+	if err != nil {
+		return nil
+	}
+	meetingCache := redis.NewMeeting(rdb, meetingDB, redis.GetDefaultOpt())
+	attendanceDB, err := mgo.NewAttendanceMongo(mgoCli.GetDB())
+	if err != nil {
+		return nil
+	}
+	hostChangeDB, err := mgo.NewHostChangeMongo(mgoCli.GetDB())
+	if err != nil {
+		return nil
+	}
 
 	user := userfind.NewMeeting(disCov, config.Share.RpcRegisterName.User)
 	// init rpc client here
 	userRpc := rpcclient.NewUser(user)
 	userToken := token.New(config.AdminAPI.Expire, config.AdminAPI.Secret)
-	u := NewAdminApi(database, *userRpc, userToken)
+	u := NewAdminApi(database, controller.NewWebhook(subscriptionDB, deadLetterDB),
+		controller.NewMeeting(meetingDB, meetingCache, mgoCli.GetTx()),
+		controller.NewAttendance(attendanceDB, hostChangeDB), *userRpc, userToken)
 	adminRouterGroup := r.Group("/admin")
 	{
 		adminRouterGroup.POST("/login", u.AdminLogin)
@@ -67,5 +90,19 @@ func newAdminGinRouter(ctx context.Context, disCov discovery.SvcDiscoveryRegistr
 		adminRouterGroup.POST("/user/import/json", u.ImportUserByJson)
 		adminRouterGroup.POST("/user/import/xlsx", u.ImportUserByXlsx)
 	}
+	webhookRouterGroup := r.Group("/admin/webhook")
+	{
+		webhookRouterGroup.POST("/create_subscription", u.CreateWebhookSubscription)
+		webhookRouterGroup.POST("/update_subscription", u.UpdateWebhookSubscription)
+		webhookRouterGroup.POST("/delete_subscription", u.DeleteWebhookSubscription)
+		webhookRouterGroup.POST("/get_subscriptions", u.GetWebhookSubscriptions)
+		webhookRouterGroup.POST("/get_dead_letters", u.GetWebhookDeadLetters)
+		webhookRouterGroup.POST("/redeliver_dead_letter", u.RedeliverWebhookDeadLetter)
+		webhookRouterGroup.POST("/delete_dead_letters", u.DeleteWebhookDeadLetters)
+	}
+	reportRouterGroup := r.Group("/admin/report")
+	{
+		reportRouterGroup.POST("/export_meeting", u.ExportMeetingReport)
+	}
 	return r
 }
@@ -0,0 +1,252 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/openmeeting-server/pkg/apistruct"
+	"github.com/openimsdk/openmeeting-server/pkg/common/securetools"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	"github.com/openimsdk/openmeeting-server/pkg/webhook"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/a2r"
+	"github.com/openimsdk/tools/apiresp"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
+	"net/url"
+	"time"
+)
+
+const (
+	subscriptionIDSize = 8
+	webhookSecretSize  = 32
+	redeliverTimeout   = 10 * time.Second
+	secretVisibleSize  = 4
+	secretMask         = "********"
+)
+
+func (a *ApiAdmin) CreateWebhookSubscription(c *gin.Context) {
+	req, err := a2r.ParseRequest[apistruct.CreateWebhookSubscriptionReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if err := checkWebhookSubscription(req.URL, req.Events); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	subscriptionID, err := securetools.GenerateToken(subscriptionIDSize)
+	if err != nil {
+		apiresp.GinError(c, errs.WrapMsg(err, "generate subscription id failed"))
+		return
+	}
+	secret := req.Secret
+	if secret == "" {
+		if secret, err = securetools.GenerateToken(webhookSecretSize); err != nil {
+			apiresp.GinError(c, errs.WrapMsg(err, "generate webhook secret failed"))
+			return
+		}
+	}
+	now := time.Now().UnixMilli()
+	subscription := &model.WebhookSubscription{
+		SubscriptionID: subscriptionID,
+		URL:            req.URL,
+		Secret:         secret,
+		Events:         datautil.Distinct(req.Events),
+		Enable:         req.Enable == nil || *req.Enable,
+		CreateTime:     now,
+		UpdateTime:     now,
+	}
+	if err := a.webhookStorageHandler.CreateSubscription(c, subscription); err != nil {
+		apiresp.GinError(c, errs.WrapMsg(err, "create webhook subscription failed"))
+		return
+	}
+	// the only response showing the generated secret
+	resp := convertWebhookSubscription(subscription)
+	resp.Secret = subscription.Secret
+	apiresp.GinSuccess(c, resp)
+}
+
+func (a *ApiAdmin) UpdateWebhookSubscription(c *gin.Context) {
+	req, err := a2r.ParseRequest[apistruct.UpdateWebhookSubscriptionReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	subscription, err := a.webhookStorageHandler.TakeSubscription(c, req.SubscriptionID)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	updateData := map[string]any{}
+	if req.URL != nil {
+		subscription.URL = *req.URL
+		updateData["url"] = *req.URL
+	}
+	if req.Events != nil {
+		subscription.Events = datautil.Distinct(*req.Events)
+		updateData["events"] = subscription.Events
+	}
+	if err := checkWebhookSubscription(subscription.URL, subscription.Events); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if req.Secret != nil {
+		if *req.Secret == "" {
+			apiresp.GinError(c, errs.ErrArgs.WrapMsg("webhook secret could not be empty"))
+			return
+		}
+		updateData["secret"] = *req.Secret
+	}
+	if req.Enable != nil {
+		updateData["enable"] = *req.Enable
+	}
+	updateData["update_time"] = time.Now().UnixMilli()
+	if err := a.webhookStorageHandler.UpdateSubscription(c, req.SubscriptionID, updateData); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	subscription, err = a.webhookStorageHandler.TakeSubscription(c, req.SubscriptionID)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, convertWebhookSubscription(subscription))
+}
+
+func (a *ApiAdmin) DeleteWebhookSubscription(c *gin.Context) {
+	req, err := a2r.ParseRequest[apistruct.DeleteWebhookSubscriptionReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if err := a.webhookStorageHandler.DeleteSubscription(c, req.SubscriptionID); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, nil)
+}
+
+func (a *ApiAdmin) GetWebhookSubscriptions(c *gin.Context) {
+	subscriptions, err := a.webhookStorageHandler.FindSubscriptions(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, &apistruct.GetWebhookSubscriptionsResp{
+		Subscriptions: datautil.Slice(subscriptions, convertWebhookSubscription),
+	})
+}
+
+func (a *ApiAdmin) GetWebhookDeadLetters(c *gin.Context) {
+	req, err := a2r.ParseRequest[apistruct.GetWebhookDeadLettersReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if req.Pagination == nil {
+		req.Pagination = &sdkws.RequestPagination{PageNumber: 1, ShowNumber: 20}
+	}
+	total, letters, err := a.webhookStorageHandler.PageDeadLetters(c, req.SubscriptionID, req.Pagination)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, &apistruct.GetWebhookDeadLettersResp{
+		Total:       total,
+		DeadLetters: datautil.Slice(letters, convertWebhookDeadLetter),
+	})
+}
+
+// RedeliverWebhookDeadLetter posts the dead letter again with the current url and secret of the subscription,
+// the dead letter is removed if the delivery succeeds.
+func (a *ApiAdmin) RedeliverWebhookDeadLetter(c *gin.Context) {
+	req, err := a2r.ParseRequest[apistruct.RedeliverWebhookDeadLetterReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	letter, err := a.webhookStorageHandler.TakeDeadLetter(c, req.DeliveryID)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	subscription, err := a.webhookStorageHandler.TakeSubscription(c, letter.SubscriptionID)
+	if err != nil {
+		if errors.Is(err, errs.ErrRecordNotFound) {
+			err = errs.ErrArgs.WrapMsg("subscription of the dead letter is deleted", "subscriptionID", letter.SubscriptionID)
+		}
+		apiresp.GinError(c, err)
+		return
+	}
+	ctx, cancel := context.WithTimeout(c, redeliverTimeout)
+	defer cancel()
+	if err := webhook.Post(ctx, a.webhookClient, subscription.URL, subscription.Secret, letter.DeliveryID, letter.EventType, []byte(letter.Payload)); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if err := a.webhookStorageHandler.DeleteDeadLetters(c, []string{letter.DeliveryID}); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, nil)
+}
+
+func (a *ApiAdmin) DeleteWebhookDeadLetters(c *gin.Context) {
+	req, err := a2r.ParseRequest[apistruct.DeleteWebhookDeadLettersReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if err := a.webhookStorageHandler.DeleteDeadLetters(c, req.DeliveryIDs); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, nil)
+}
+
+func checkWebhookSubscription(rawURL string, events []string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errs.ErrArgs.WrapMsg("webhook url should be an absolute http or https url", "url", rawURL)
+	}
+	for _, event := range events {
+		if !datautil.Contain(event, webhook.EventTypes...) {
+			return errs.ErrArgs.WrapMsg("unknown webhook event type", "event", event)
+		}
+	}
+	return nil
+}
+
+// convertWebhookSubscription masks the secret, only its beginning is shown for telling the secrets apart.
+func convertWebhookSubscription(subscription *model.WebhookSubscription) *apistruct.WebhookSubscription {
+	return &apistruct.WebhookSubscription{
+		SubscriptionID: subscription.SubscriptionID,
+		URL:            subscription.URL,
+		Secret:         maskWebhookSecret(subscription.Secret),
+		Events:         subscription.Events,
+		Enable:         subscription.Enable,
+		CreateTime:     subscription.CreateTime,
+		UpdateTime:     subscription.UpdateTime,
+	}
+}
+
+func maskWebhookSecret(secret string) string {
+	if len(secret) <= secretVisibleSize*2 {
+		return secretMask
+	}
+	return secret[:secretVisibleSize] + secretMask
+}
+
+func convertWebhookDeadLetter(letter *model.WebhookDeadLetter) *apistruct.WebhookDeadLetter {
+	return &apistruct.WebhookDeadLetter{
+		DeliveryID:     letter.DeliveryID,
+		SubscriptionID: letter.SubscriptionID,
+		URL:            letter.URL,
+		EventType:      letter.EventType,
+		Payload:        letter.Payload,
+		Attempts:       letter.Attempts,
+		LastError:      letter.LastError,
+		CreateTime:     letter.CreateTime,
+	}
+}
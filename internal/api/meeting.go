@@ -1,10 +1,21 @@
 package api
 
 import (
+	"fmt"
 	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/openmeeting-server/pkg/common/ical"
+	"github.com/openimsdk/openmeeting-server/pkg/protocol/meetingext"
 	"github.com/openimsdk/openmeeting-server/pkg/rpcclient"
+	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/openmeeting/meeting"
 	"github.com/openimsdk/tools/a2r"
+	"github.com/openimsdk/tools/apiresp"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+	"io"
+	"net/http"
+	"strings"
+	"time"
 )
 
 type MeetingApi rpcclient.Meeting
@@ -25,8 +36,15 @@ func (m *MeetingApi) JoinMeeting(c *gin.Context) {
 	a2r.Call(meeting.MeetingServiceClient.JoinMeeting, m.Client, c)
 }
 
+// GetMeetingToken only refreshes the token of the login user.
 func (m *MeetingApi) GetMeetingToken(c *gin.Context) {
-	a2r.Call(meeting.MeetingServiceClient.GetMeetingToken, m.Client, c)
+	a2r.Call(meeting.MeetingServiceClient.GetMeetingToken, m.Client, c,
+		&a2r.Option[meeting.GetMeetingTokenReq, meeting.GetMeetingTokenResp]{
+			BindAfter: func(req *meeting.GetMeetingTokenReq) error {
+				req.UserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
 }
 
 func (m *MeetingApi) LeaveMeeting(c *gin.Context) {
@@ -72,3 +90,444 @@ func (m *MeetingApi) UpdateMeeting(c *gin.Context) {
 func (m *MeetingApi) OperateMeetingAllStream(c *gin.Context) {
 	a2r.Call(meeting.MeetingServiceClient.OperateRoomAllStream, m.Client, c)
 }
+
+// GetMeetingOccurrences only returns the occurrences readable by the login user.
+func (m *MeetingApi) GetMeetingOccurrences(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.GetMeetingOccurrences, m.ExtClient, c,
+		&a2r.Option[meetingext.GetMeetingOccurrencesReq, meetingext.GetMeetingOccurrencesResp]{
+			BindAfter: func(req *meetingext.GetMeetingOccurrencesReq) error {
+				req.UserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
+
+func (m *MeetingApi) BookRecurringMeeting(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.BookRecurringMeeting, m.ExtClient, c)
+}
+
+func (m *MeetingApi) UpdateMeetingRecurrence(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.UpdateMeetingRecurrence, m.ExtClient, c,
+		&a2r.Option[meetingext.UpdateMeetingRecurrenceReq, meetingext.UpdateMeetingRecurrenceResp]{
+			BindAfter: func(req *meetingext.UpdateMeetingRecurrenceReq) error {
+				req.UpdatingUserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
+
+func (m *MeetingApi) GetMeetingRecurrence(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.GetMeetingRecurrence, m.ExtClient, c)
+}
+
+func (m *MeetingApi) UpdateMeetingOccurrence(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.UpdateMeetingOccurrence, m.ExtClient, c,
+		&a2r.Option[meetingext.UpdateMeetingOccurrenceReq, meetingext.UpdateMeetingOccurrenceResp]{
+			BindAfter: func(req *meetingext.UpdateMeetingOccurrenceReq) error {
+				req.UpdatingUserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
+
+func (m *MeetingApi) CancelMeetingOccurrence(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.CancelMeetingOccurrence, m.ExtClient, c,
+		&a2r.Option[meetingext.CancelMeetingOccurrenceReq, meetingext.CancelMeetingOccurrenceResp]{
+			BindAfter: func(req *meetingext.CancelMeetingOccurrenceReq) error {
+				req.UserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
+
+// the invitation APIs act for the login user only
+func (m *MeetingApi) AddMeetingInvitees(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.AddMeetingInvitees, m.ExtClient, c,
+		&a2r.Option[meetingext.AddMeetingInviteesReq, meetingext.AddMeetingInviteesResp]{
+			BindAfter: func(req *meetingext.AddMeetingInviteesReq) error {
+				req.OperatorUserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
+
+func (m *MeetingApi) RemoveMeetingInvitees(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.RemoveMeetingInvitees, m.ExtClient, c,
+		&a2r.Option[meetingext.RemoveMeetingInviteesReq, meetingext.RemoveMeetingInviteesResp]{
+			BindAfter: func(req *meetingext.RemoveMeetingInviteesReq) error {
+				req.OperatorUserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
+
+func (m *MeetingApi) RespondMeetingInvitation(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.RespondMeetingInvitation, m.ExtClient, c,
+		&a2r.Option[meetingext.RespondMeetingInvitationReq, meetingext.RespondMeetingInvitationResp]{
+			BindAfter: func(req *meetingext.RespondMeetingInvitationReq) error {
+				req.UserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
+
+func (m *MeetingApi) GetMeetingInvitees(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.GetMeetingInvitees, m.ExtClient, c,
+		&a2r.Option[meetingext.GetMeetingInviteesReq, meetingext.GetMeetingInviteesResp]{
+			BindAfter: func(req *meetingext.GetMeetingInviteesReq) error {
+				req.UserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
+
+func (m *MeetingApi) GetInvitedMeetings(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.GetInvitedMeetings, m.ExtClient, c,
+		&a2r.Option[meetingext.GetInvitedMeetingsReq, meetingext.GetInvitedMeetingsResp]{
+			BindAfter: func(req *meetingext.GetInvitedMeetingsReq) error {
+				req.UserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
+
+func (m *MeetingApi) GetMeetingAttendance(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.GetMeetingAttendance, m.ExtClient, c,
+		&a2r.Option[meetingext.GetMeetingAttendanceReq, meetingext.GetMeetingAttendanceResp]{
+			BindAfter: func(req *meetingext.GetMeetingAttendanceReq) error {
+				req.UserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
+
+func (m *MeetingApi) SetMeetingWaitingRoom(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.SetMeetingWaitingRoom, m.ExtClient, c,
+		&a2r.Option[meetingext.SetMeetingWaitingRoomReq, meetingext.SetMeetingWaitingRoomResp]{
+			BindAfter: func(req *meetingext.SetMeetingWaitingRoomReq) error {
+				req.UserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
+
+func (m *MeetingApi) GetWaitingRoom(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.GetWaitingRoom, m.ExtClient, c,
+		&a2r.Option[meetingext.GetWaitingRoomReq, meetingext.GetWaitingRoomResp]{
+			BindAfter: func(req *meetingext.GetWaitingRoomReq) error {
+				req.UserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
+
+func (m *MeetingApi) AdmitWaitingUsers(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.AdmitWaitingUsers, m.ExtClient, c,
+		&a2r.Option[meetingext.RespondWaitingUsersReq, meetingext.RespondWaitingUsersResp]{
+			BindAfter: func(req *meetingext.RespondWaitingUsersReq) error {
+				req.UserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
+
+func (m *MeetingApi) DenyWaitingUsers(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.DenyWaitingUsers, m.ExtClient, c,
+		&a2r.Option[meetingext.RespondWaitingUsersReq, meetingext.RespondWaitingUsersResp]{
+			BindAfter: func(req *meetingext.RespondWaitingUsersReq) error {
+				req.UserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
+
+// GetWaitingStatus only reports the waiting status of the login user, who gets the token once admitted.
+func (m *MeetingApi) GetWaitingStatus(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.GetWaitingStatus, m.ExtClient, c,
+		&a2r.Option[meetingext.GetWaitingStatusReq, meetingext.GetWaitingStatusResp]{
+			BindAfter: func(req *meetingext.GetWaitingStatusReq) error {
+				req.UserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
+
+func (m *MeetingApi) SetMeetingLock(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.SetMeetingLock, m.ExtClient, c,
+		&a2r.Option[meetingext.SetMeetingLockReq, meetingext.SetMeetingLockResp]{
+			BindAfter: func(req *meetingext.SetMeetingLockReq) error {
+				req.UserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
+
+func (m *MeetingApi) SetMeetingLimit(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.SetMeetingLimit, m.ExtClient, c,
+		&a2r.Option[meetingext.SetMeetingLimitReq, meetingext.SetMeetingLimitResp]{
+			BindAfter: func(req *meetingext.SetMeetingLimitReq) error {
+				req.UserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
+
+func (m *MeetingApi) GetMeetingLimit(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.GetMeetingLimit, m.ExtClient, c)
+}
+
+func (m *MeetingApi) GrantMeetingRole(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.GrantMeetingRole, m.ExtClient, c,
+		&a2r.Option[meetingext.GrantMeetingRoleReq, meetingext.GrantMeetingRoleResp]{
+			BindAfter: func(req *meetingext.GrantMeetingRoleReq) error {
+				req.UserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
+
+func (m *MeetingApi) RevokeMeetingRole(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.RevokeMeetingRole, m.ExtClient, c,
+		&a2r.Option[meetingext.RevokeMeetingRoleReq, meetingext.RevokeMeetingRoleResp]{
+			BindAfter: func(req *meetingext.RevokeMeetingRoleReq) error {
+				req.UserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
+
+// GetMeetingRoles only returns the roles to the login user in the meeting.
+func (m *MeetingApi) GetMeetingRoles(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.GetMeetingRoles, m.ExtClient, c,
+		&a2r.Option[meetingext.GetMeetingRolesReq, meetingext.GetMeetingRolesResp]{
+			BindAfter: func(req *meetingext.GetMeetingRolesReq) error {
+				req.UserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
+
+func (m *MeetingApi) RaiseHand(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.RaiseHand, m.ExtClient, c,
+		&a2r.Option[meetingext.RaiseHandReq, meetingext.RaiseHandResp]{
+			BindAfter: func(req *meetingext.RaiseHandReq) error {
+				req.UserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
+
+func (m *MeetingApi) LowerHand(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.LowerHand, m.ExtClient, c,
+		&a2r.Option[meetingext.LowerHandReq, meetingext.LowerHandResp]{
+			BindAfter: func(req *meetingext.LowerHandReq) error {
+				req.UserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
+
+func (m *MeetingApi) ApproveRaisedHand(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.ApproveRaisedHand, m.ExtClient, c,
+		&a2r.Option[meetingext.ApproveRaisedHandReq, meetingext.ApproveRaisedHandResp]{
+			BindAfter: func(req *meetingext.ApproveRaisedHandReq) error {
+				req.UserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
+
+func (m *MeetingApi) ClearRaisedHands(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.ClearRaisedHands, m.ExtClient, c,
+		&a2r.Option[meetingext.ClearRaisedHandsReq, meetingext.ClearRaisedHandsResp]{
+			BindAfter: func(req *meetingext.ClearRaisedHandsReq) error {
+				req.UserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
+
+func (m *MeetingApi) SendMeetingChatMessage(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.SendMeetingChatMessage, m.ExtClient, c,
+		&a2r.Option[meetingext.SendMeetingChatMessageReq, meetingext.SendMeetingChatMessageResp]{
+			BindAfter: func(req *meetingext.SendMeetingChatMessageReq) error {
+				req.UserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
+
+func (m *MeetingApi) GetMeetingChatMessages(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.GetMeetingChatMessages, m.ExtClient, c,
+		&a2r.Option[meetingext.GetMeetingChatMessagesReq, meetingext.GetMeetingChatMessagesResp]{
+			BindAfter: func(req *meetingext.GetMeetingChatMessagesReq) error {
+				req.UserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
+
+func (m *MeetingApi) GetMeetingICalendar(c *gin.Context) {
+	req, err := a2r.ParseRequest[meetingext.GetMeetingICalendarReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	req.UserID = mcontext.GetOpUserID(c)
+	resp, err := m.ExtClient.GetMeetingICalendar(c, req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="meeting_%s.ics"`, req.MeetingID))
+	c.Data(http.StatusOK, ical.ContentType, []byte(resp.Calendar))
+}
+
+// GetCalendarFeedToken only returns the feed token of the login user, the token is a secret of the user.
+func (m *MeetingApi) GetCalendarFeedToken(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.GetCalendarFeedToken, m.ExtClient, c,
+		&a2r.Option[meetingext.GetCalendarFeedTokenReq, meetingext.GetCalendarFeedTokenResp]{
+			BindAfter: func(req *meetingext.GetCalendarFeedTokenReq) error {
+				req.UserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
+
+// GetCalendarFeed serves the subscription feed to calendar clients, which authenticate with the feed token in the path
+// instead of the login token and could not set the operationID header.
+func (m *MeetingApi) GetCalendarFeed(c *gin.Context) {
+	c.Set(constant.OperationID, fmt.Sprintf("calendar_feed_%d", time.Now().UnixMilli()))
+	resp, err := m.ExtClient.GetCalendarFeed(c, &meetingext.GetCalendarFeedReq{FeedToken: strings.TrimSuffix(c.Param("feedToken"), ".ics")})
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	c.Data(http.StatusOK, ical.ContentType, []byte(resp.Calendar))
+}
+
+// HandleLiveKitWebhook passes the webhook of the LiveKit server to the meeting rpc as it is, the signature covers the raw body.
+func (m *MeetingApi) HandleLiveKitWebhook(c *gin.Context) {
+	c.Set(constant.OperationID, fmt.Sprintf("livekit_webhook_%d", time.Now().UnixMilli()))
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		apiresp.GinError(c, errs.ErrArgs.WrapMsg("read livekit webhook body failed: "+err.Error()))
+		return
+	}
+	req := &meetingext.HandleLiveKitWebhookReq{Body: body, AuthToken: c.GetHeader("Authorization")}
+	if _, err := m.ExtClient.HandleLiveKitWebhook(c, req); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, nil)
+}
+
+func (m *MeetingApi) StartMeetingRecording(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.StartMeetingRecording, m.ExtClient, c,
+		&a2r.Option[meetingext.StartMeetingRecordingReq, meetingext.StartMeetingRecordingResp]{
+			BindAfter: func(req *meetingext.StartMeetingRecordingReq) error {
+				req.UserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
+
+func (m *MeetingApi) StopMeetingRecording(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.StopMeetingRecording, m.ExtClient, c,
+		&a2r.Option[meetingext.StopMeetingRecordingReq, meetingext.StopMeetingRecordingResp]{
+			BindAfter: func(req *meetingext.StopMeetingRecordingReq) error {
+				req.UserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
+
+func (m *MeetingApi) GetMeetingRecordings(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.GetMeetingRecordings, m.ExtClient, c,
+		&a2r.Option[meetingext.GetMeetingRecordingsReq, meetingext.GetMeetingRecordingsResp]{
+			BindAfter: func(req *meetingext.GetMeetingRecordingsReq) error {
+				req.UserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
+
+func (m *MeetingApi) AddMeetingStreamOutputs(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.AddMeetingStreamOutputs, m.ExtClient, c,
+		&a2r.Option[meetingext.AddMeetingStreamOutputsReq, meetingext.AddMeetingStreamOutputsResp]{
+			BindAfter: func(req *meetingext.AddMeetingStreamOutputsReq) error {
+				req.UserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
+
+func (m *MeetingApi) RemoveMeetingStreamOutputs(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.RemoveMeetingStreamOutputs, m.ExtClient, c,
+		&a2r.Option[meetingext.RemoveMeetingStreamOutputsReq, meetingext.RemoveMeetingStreamOutputsResp]{
+			BindAfter: func(req *meetingext.RemoveMeetingStreamOutputsReq) error {
+				req.UserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
+
+func (m *MeetingApi) GetMeetingDialIn(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.GetMeetingDialIn, m.ExtClient, c,
+		&a2r.Option[meetingext.GetMeetingDialInReq, meetingext.GetMeetingDialInResp]{
+			BindAfter: func(req *meetingext.GetMeetingDialInReq) error {
+				req.UserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
+
+func (m *MeetingApi) StartBreakoutRooms(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.StartBreakoutRooms, m.ExtClient, c,
+		&a2r.Option[meetingext.StartBreakoutRoomsReq, meetingext.StartBreakoutRoomsResp]{
+			BindAfter: func(req *meetingext.StartBreakoutRoomsReq) error {
+				req.UserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
+
+func (m *MeetingApi) AssignBreakoutRoom(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.AssignBreakoutRoom, m.ExtClient, c,
+		&a2r.Option[meetingext.AssignBreakoutRoomReq, meetingext.AssignBreakoutRoomResp]{
+			BindAfter: func(req *meetingext.AssignBreakoutRoomReq) error {
+				req.UserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
+
+func (m *MeetingApi) JoinBreakoutRoom(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.JoinBreakoutRoom, m.ExtClient, c,
+		&a2r.Option[meetingext.JoinBreakoutRoomReq, meetingext.JoinBreakoutRoomResp]{
+			BindAfter: func(req *meetingext.JoinBreakoutRoomReq) error {
+				req.UserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
+
+func (m *MeetingApi) BroadcastBreakoutMessage(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.BroadcastBreakoutMessage, m.ExtClient, c,
+		&a2r.Option[meetingext.BroadcastBreakoutMessageReq, meetingext.BroadcastBreakoutMessageResp]{
+			BindAfter: func(req *meetingext.BroadcastBreakoutMessageReq) error {
+				req.UserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
+
+func (m *MeetingApi) CloseBreakoutRooms(c *gin.Context) {
+	a2r.Call(meetingext.MeetingExtServiceClient.CloseBreakoutRooms, m.ExtClient, c,
+		&a2r.Option[meetingext.CloseBreakoutRoomsReq, meetingext.CloseBreakoutRoomsResp]{
+			BindAfter: func(req *meetingext.CloseBreakoutRoomsReq) error {
+				req.UserID = mcontext.GetOpUserID(c)
+				return nil
+			},
+		})
+}
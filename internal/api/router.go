@@ -30,10 +30,16 @@ func newGinRouter(disCov discovery.SvcDiscoveryRegistry, config *Config) *gin.En
 		grpc.WithDefaultServiceConfig(fmt.Sprintf(`{"LoadBalancingPolicy": "%s"}`, "round_robin")))
 	gin.SetMode(gin.ReleaseMode)
 	r := gin.New()
-	r.Use(gin.Recovery(), mw.CorsHandler(), mw.GinParseOperationID(), mw.GinParseToken(secretKey(config.API.Secret), whitelist))
 	// init rpc client here
 	userRpc := user.NewMeetingUserClient(disCov, config.Share.RpcRegisterName.User)
 	meetingRpc := rpcclient.NewMeeting(disCov, config.Share.RpcRegisterName.Meeting)
+	m := NewMeetingApi(*meetingRpc)
+
+	// the LiveKit server could not set the operationID or the token header, so the webhook is
+	// registered before the middlewares, it is verified by the signature with the api secret instead
+	r.POST("/rtc/livekit_webhook", gin.Recovery(), m.HandleLiveKitWebhook)
+
+	r.Use(gin.Recovery(), mw.CorsHandler(), mw.GinParseOperationID(), mw.GinParseToken(secretKey(config.API.Secret), whitelist))
 
 	userToken := token.New(config.API.Expire, config.API.Secret)
 	mwApi := apiMw.New(userRpc, userToken)
@@ -48,7 +54,6 @@ func newGinRouter(disCov discovery.SvcDiscoveryRegistry, config *Config) *gin.En
 
 	}
 
-	m := NewMeetingApi(*meetingRpc)
 	meetingRouterGroup := r.Group("/meeting")
 	{
 		meetingRouterGroup.POST("/book_meeting", mwApi.CheckToken, m.BookMeeting)
@@ -59,6 +64,49 @@ func newGinRouter(disCov discovery.SvcDiscoveryRegistry, config *Config) *gin.En
 		meetingRouterGroup.POST("/update_meeting", mwApi.CheckToken, m.UpdateMeeting)
 		meetingRouterGroup.POST("/get_meeting", mwApi.CheckToken, m.GetMeeting)
 		meetingRouterGroup.POST("/get_meetings", mwApi.CheckToken, m.GetMeetings)
+		meetingRouterGroup.POST("/get_meeting_occurrences", mwApi.CheckToken, m.GetMeetingOccurrences)
+		meetingRouterGroup.POST("/book_recurring_meeting", mwApi.CheckToken, m.BookRecurringMeeting)
+		meetingRouterGroup.POST("/update_meeting_recurrence", mwApi.CheckToken, m.UpdateMeetingRecurrence)
+		meetingRouterGroup.POST("/get_meeting_recurrence", mwApi.CheckToken, m.GetMeetingRecurrence)
+		meetingRouterGroup.POST("/update_meeting_occurrence", mwApi.CheckToken, m.UpdateMeetingOccurrence)
+		meetingRouterGroup.POST("/cancel_meeting_occurrence", mwApi.CheckToken, m.CancelMeetingOccurrence)
+		meetingRouterGroup.POST("/add_meeting_invitees", mwApi.CheckToken, m.AddMeetingInvitees)
+		meetingRouterGroup.POST("/remove_meeting_invitees", mwApi.CheckToken, m.RemoveMeetingInvitees)
+		meetingRouterGroup.POST("/respond_meeting_invitation", mwApi.CheckToken, m.RespondMeetingInvitation)
+		meetingRouterGroup.POST("/get_meeting_invitees", mwApi.CheckToken, m.GetMeetingInvitees)
+		meetingRouterGroup.POST("/get_invited_meetings", mwApi.CheckToken, m.GetInvitedMeetings)
+		meetingRouterGroup.POST("/get_meeting_attendance", mwApi.CheckToken, m.GetMeetingAttendance)
+		meetingRouterGroup.POST("/set_meeting_waiting_room", mwApi.CheckToken, m.SetMeetingWaitingRoom)
+		meetingRouterGroup.POST("/get_waiting_room", mwApi.CheckToken, m.GetWaitingRoom)
+		meetingRouterGroup.POST("/admit_waiting_users", mwApi.CheckToken, m.AdmitWaitingUsers)
+		meetingRouterGroup.POST("/deny_waiting_users", mwApi.CheckToken, m.DenyWaitingUsers)
+		meetingRouterGroup.POST("/get_waiting_status", mwApi.CheckToken, m.GetWaitingStatus)
+		meetingRouterGroup.POST("/set_meeting_lock", mwApi.CheckToken, m.SetMeetingLock)
+		meetingRouterGroup.POST("/set_meeting_limit", mwApi.CheckToken, m.SetMeetingLimit)
+		meetingRouterGroup.POST("/get_meeting_limit", mwApi.CheckToken, m.GetMeetingLimit)
+		meetingRouterGroup.POST("/grant_meeting_role", mwApi.CheckToken, m.GrantMeetingRole)
+		meetingRouterGroup.POST("/revoke_meeting_role", mwApi.CheckToken, m.RevokeMeetingRole)
+		meetingRouterGroup.POST("/get_meeting_roles", mwApi.CheckToken, m.GetMeetingRoles)
+		meetingRouterGroup.POST("/raise_hand", mwApi.CheckToken, m.RaiseHand)
+		meetingRouterGroup.POST("/lower_hand", mwApi.CheckToken, m.LowerHand)
+		meetingRouterGroup.POST("/approve_raised_hand", mwApi.CheckToken, m.ApproveRaisedHand)
+		meetingRouterGroup.POST("/clear_raised_hands", mwApi.CheckToken, m.ClearRaisedHands)
+		meetingRouterGroup.POST("/send_meeting_chat_message", mwApi.CheckToken, m.SendMeetingChatMessage)
+		meetingRouterGroup.POST("/get_meeting_chat_messages", mwApi.CheckToken, m.GetMeetingChatMessages)
+		meetingRouterGroup.POST("/start_meeting_recording", mwApi.CheckToken, m.StartMeetingRecording)
+		meetingRouterGroup.POST("/stop_meeting_recording", mwApi.CheckToken, m.StopMeetingRecording)
+		meetingRouterGroup.POST("/get_meeting_recordings", mwApi.CheckToken, m.GetMeetingRecordings)
+		meetingRouterGroup.POST("/add_meeting_stream_outputs", mwApi.CheckToken, m.AddMeetingStreamOutputs)
+		meetingRouterGroup.POST("/remove_meeting_stream_outputs", mwApi.CheckToken, m.RemoveMeetingStreamOutputs)
+		meetingRouterGroup.POST("/get_meeting_dial_in", mwApi.CheckToken, m.GetMeetingDialIn)
+		meetingRouterGroup.POST("/start_breakout_rooms", mwApi.CheckToken, m.StartBreakoutRooms)
+		meetingRouterGroup.POST("/assign_breakout_room", mwApi.CheckToken, m.AssignBreakoutRoom)
+		meetingRouterGroup.POST("/join_breakout_room", mwApi.CheckToken, m.JoinBreakoutRoom)
+		meetingRouterGroup.POST("/broadcast_breakout_message", mwApi.CheckToken, m.BroadcastBreakoutMessage)
+		meetingRouterGroup.POST("/close_breakout_rooms", mwApi.CheckToken, m.CloseBreakoutRooms)
+		meetingRouterGroup.POST("/get_meeting_ics", mwApi.CheckToken, m.GetMeetingICalendar)
+		meetingRouterGroup.POST("/get_calendar_feed_token", mwApi.CheckToken, m.GetCalendarFeedToken)
+		meetingRouterGroup.GET("/calendar_feed/:feedToken", m.GetCalendarFeed)
 		meetingRouterGroup.POST("/leave_meeting", mwApi.CheckToken, m.LeaveMeeting)
 		meetingRouterGroup.POST("/end_meeting", mwApi.CheckToken, m.EndMeeting)
 		meetingRouterGroup.POST("/set_personal_setting", mwApi.CheckToken, m.SetPersonalMeetingSettings)
@@ -0,0 +1,95 @@
+package meeting
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/constant"
+	"github.com/openimsdk/openmeeting-server/pkg/common/servererrs"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	pbmeetingext "github.com/openimsdk/openmeeting-server/pkg/protocol/meetingext"
+	pbuser "github.com/openimsdk/protocol/openmeeting/user"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/openimsdk/tools/utils/timeutil"
+)
+
+// GetMeetingAttendance get who attended the meeting and the total time of each user, only the creator or the host could do it
+func (s *meetingServer) GetMeetingAttendance(ctx context.Context, req *pbmeetingext.GetMeetingAttendanceReq) (*pbmeetingext.GetMeetingAttendanceResp, error) {
+	resp := &pbmeetingext.GetMeetingAttendanceResp{}
+	info, err := s.meetingStorageHandler.TakeWithError(ctx, req.MeetingID)
+	if err != nil {
+		return resp, errs.WrapMsg(err, "get meeting data failed")
+	}
+	if !s.checkMeetingPermission(ctx, info, req.UserID, constant.PermissionManageMeeting) {
+		return resp, servererrs.ErrMeetingAuthCheck.WrapMsg("user did not have permission to get the attendance of the meeting")
+	}
+	attendances, err := s.attendanceStorageHandler.Find(ctx, req.MeetingID, req.OccurrenceID)
+	if err != nil {
+		return resp, err
+	}
+	if len(attendances) == 0 {
+		return resp, nil
+	}
+
+	userIDs := datautil.Distinct(datautil.Slice(attendances, func(e *model.MeetingAttendance) string {
+		return e.UserID
+	}))
+	users, err := s.userRpc.GetUsersInfo(ctx, userIDs)
+	if err != nil {
+		// the attendance is still useful without the nicknames
+		log.ZWarn(ctx, "get attendees info failed", err, "meetingID", req.MeetingID)
+	}
+	userMap := datautil.SliceToMap(users, func(e *pbuser.UserInfo) string {
+		return e.UserID
+	})
+	resp.Attendees = s.generateClientAttendees(attendances, userMap, timeutil.GetCurrentTimestampBySecond())
+	return resp, nil
+}
+
+// recordJoin records the user joined the meeting, it is called when the participant connected to the room
+// instead of when the token is issued. Joining again before leaving keeps the first join time.
+func (s *meetingServer) recordJoin(ctx context.Context, meetingID, userID string) {
+	now := timeutil.GetCurrentTimestampBySecond()
+	attendance := &model.MeetingAttendance{
+		MeetingID: meetingID,
+		UserID:    userID,
+		JoinTime:  now,
+	}
+	if occurrence, err := s.occurrenceStorageHandler.TakeCurrent(ctx, meetingID, now); err == nil {
+		attendance.OccurrenceID = occurrence.OccurrenceID
+	}
+	if err := s.attendanceStorageHandler.Join(ctx, attendance); err != nil {
+		log.ZError(ctx, "record attendance join failed", err, "meetingID", meetingID, "userID", userID)
+	}
+}
+
+func (s *meetingServer) recordLeave(ctx context.Context, meetingID string, userIDs []string, reason string) {
+	if err := s.attendanceStorageHandler.Leave(ctx, meetingID, userIDs, timeutil.GetCurrentTimestampBySecond(), reason); err != nil {
+		log.ZError(ctx, "record attendance leave failed", err, "meetingID", meetingID, "userIDs", userIDs)
+	}
+}
+
+// recordMeetingEnded records all the users still in the meeting left with it.
+func (s *meetingServer) recordMeetingEnded(ctx context.Context, meetingID string) {
+	if err := s.attendanceStorageHandler.LeaveAll(ctx, meetingID, timeutil.GetCurrentTimestampBySecond(), constant.LeaveReasonMeetingEnded); err != nil {
+		log.ZError(ctx, "record attendance of ended meeting failed", err, "meetingID", meetingID)
+	}
+}
+
+// recordHostChange records the host handed over, operatorUserID is empty if the server handed it over.
+func (s *meetingServer) recordHostChange(ctx context.Context, meetingID, previousHostUserID, hostUserID, operatorUserID string) {
+	now := timeutil.GetCurrentTimestampBySecond()
+	change := &model.MeetingHostChange{
+		MeetingID:          meetingID,
+		PreviousHostUserID: previousHostUserID,
+		HostUserID:         hostUserID,
+		OperatorUserID:     operatorUserID,
+		ChangeTime:         now,
+	}
+	if occurrence, err := s.occurrenceStorageHandler.TakeCurrent(ctx, meetingID, now); err == nil {
+		change.OccurrenceID = occurrence.OccurrenceID
+	}
+	if err := s.attendanceStorageHandler.RecordHostChange(ctx, change); err != nil {
+		log.ZError(ctx, "record host change failed", err, "meetingID", meetingID, "hostUserID", hostUserID)
+	}
+}
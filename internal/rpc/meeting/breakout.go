@@ -0,0 +1,433 @@
+package meeting
+
+import (
+	"context"
+	"fmt"
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/webhook"
+	"github.com/openimsdk/openmeeting-server/pkg/common/constant"
+	"github.com/openimsdk/openmeeting-server/pkg/common/servererrs"
+	pbmeetingext "github.com/openimsdk/openmeeting-server/pkg/protocol/meetingext"
+	"github.com/openimsdk/openmeeting-server/pkg/rtc"
+	pbmeeting "github.com/openimsdk/protocol/openmeeting/meeting"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/openimsdk/tools/utils/timeutil"
+	"math/rand"
+	"strconv"
+)
+
+const maxBreakoutRooms = 50
+
+// StartBreakoutRooms split the meeting in progress into the breakout rooms, each of them is a child room of the meeting room
+func (s *meetingServer) StartBreakoutRooms(ctx context.Context, req *pbmeetingext.StartBreakoutRoomsReq) (*pbmeetingext.StartBreakoutRoomsResp, error) {
+	resp := &pbmeetingext.StartBreakoutRoomsResp{}
+	if req.Duration < 0 {
+		return resp, errs.ErrArgs.WrapMsg("duration should not be negative", "duration", req.Duration)
+	}
+	count := int(req.Count)
+	switch req.Mode {
+	case constant.BreakoutModeManual:
+		count = len(req.Rooms)
+	case constant.BreakoutModeRandom, constant.BreakoutModeSelfSelect:
+	default:
+		return resp, errs.ErrArgs.WrapMsg("mode should be Manual, Random or SelfSelect", "mode", req.Mode)
+	}
+	if count <= 0 || count > maxBreakoutRooms {
+		return resp, errs.ErrArgs.WrapMsg(fmt.Sprintf("number of the breakout rooms should be between 1 and %d", maxBreakoutRooms), "count", count)
+	}
+	metaData, ext, err := s.getBreakoutRoomData(ctx, req.MeetingID, req.UserID)
+	if err != nil {
+		return resp, err
+	}
+	if ext.Breakout != nil {
+		return resp, errs.ErrArgs.WrapMsg("breakout rooms are started already, close them first", "meetingID", req.MeetingID)
+	}
+	dbInfo, err := s.meetingStorageHandler.TakeWithError(ctx, req.MeetingID)
+	if err != nil {
+		return resp, errs.WrapMsg(err, "get meeting data failed")
+	}
+	now := timeutil.GetCurrentTimestampBySecond()
+	breakout := &pbmeetingext.MeetingBreakout{
+		Mode:        req.Mode,
+		StartUserID: req.UserID,
+		StartTime:   now,
+	}
+	if req.Duration > 0 {
+		breakout.EndTime = now + req.Duration
+	}
+	assigned := make(map[string]struct{})
+	for i := 0; i < count; i++ {
+		breakoutID := strconv.Itoa(i + 1)
+		room := &pbmeetingext.BreakoutRoom{
+			BreakoutID: breakoutID,
+			Name:       fmt.Sprintf("Breakout Room %d", i+1),
+			RoomID:     rtc.BreakoutRoomID(req.MeetingID, breakoutID),
+		}
+		if i < len(req.Rooms) {
+			if req.Rooms[i].Name != "" {
+				room.Name = req.Rooms[i].Name
+			}
+			if req.Mode == constant.BreakoutModeManual {
+				for _, userID := range datautil.Distinct(req.Rooms[i].UserIDs) {
+					if _, ok := assigned[userID]; ok {
+						return resp, errs.ErrArgs.WrapMsg("user is assigned to more than one breakout room", "userID", userID)
+					}
+					assigned[userID] = struct{}{}
+					room.UserIDs = append(room.UserIDs, userID)
+				}
+			}
+		}
+		breakout.Rooms = append(breakout.Rooms, room)
+	}
+	if req.Mode == constant.BreakoutModeRandom {
+		if err := s.assignRandomBreakoutRooms(ctx, req.MeetingID, metaData, breakout.Rooms); err != nil {
+			return resp, err
+		}
+	}
+	var created []string
+	for _, room := range breakout.Rooms {
+		// the breakout rooms carry the metadata of the meeting room, the assignments are kept in the meeting room only
+		if _, _, _, err := s.meetingRtc.CreateRoom(ctx, room.RoomID, req.UserID, metaData, nil, s.getRoomLimit(dbInfo), s.userRpc); err != nil {
+			s.closeBreakoutRTCRooms(ctx, created)
+			return resp, errs.WrapMsg(err, "create breakout room failed", "roomID", room.RoomID)
+		}
+		created = append(created, room.RoomID)
+	}
+	err = s.meetingRtc.ModifyRoomData(ctx, req.MeetingID, func(data *rtc.RoomData) (bool, error) {
+		if data.Ext.Breakout != nil {
+			return false, errs.ErrArgs.WrapMsg("breakout rooms are started already, close them first", "meetingID", req.MeetingID)
+		}
+		data.Ext.Breakout = breakout
+		return true, nil
+	})
+	if err != nil {
+		s.closeBreakoutRTCRooms(ctx, created)
+		return resp, errs.WrapMsg(err, "update meta data failed")
+	}
+	if breakout.EndTime > 0 {
+		// the hosts close the breakout rooms by themselves if the timer is lost
+		if err := s.breakoutStorageHandler.SetEndTime(ctx, req.MeetingID, breakout.EndTime); err != nil {
+			log.ZError(ctx, "set breakout end time failed", err, "meetingID", req.MeetingID)
+		}
+	}
+	s.notifyMeetingBreakout(ctx, req.MeetingID, req.UserID, breakout, false)
+	resp.Breakout = breakout
+	return resp, nil
+}
+
+// AssignBreakoutRoom move the participants to the breakout room, they are unassigned if breakoutID is empty
+func (s *meetingServer) AssignBreakoutRoom(ctx context.Context, req *pbmeetingext.AssignBreakoutRoomReq) (*pbmeetingext.AssignBreakoutRoomResp, error) {
+	resp := &pbmeetingext.AssignBreakoutRoomResp{}
+	if len(req.ParticipantUserIDs) == 0 {
+		return resp, errs.ErrArgs.WrapMsg("participantUserIDs is empty")
+	}
+	if _, _, err := s.getBreakoutRoomData(ctx, req.MeetingID, req.UserID); err != nil {
+		return resp, err
+	}
+	userIDs := datautil.Distinct(req.ParticipantUserIDs)
+	err := s.meetingRtc.ModifyRoomData(ctx, req.MeetingID, func(data *rtc.RoomData) (bool, error) {
+		breakout := data.Ext.Breakout
+		if breakout == nil {
+			return false, errs.ErrArgs.WrapMsg("breakout rooms are not started", "meetingID", req.MeetingID)
+		}
+		var target *pbmeetingext.BreakoutRoom
+		if req.BreakoutID != "" {
+			if target = s.findBreakoutRoom(breakout, req.BreakoutID); target == nil {
+				return false, errs.ErrArgs.WrapMsg("breakout room not found", "breakoutID", req.BreakoutID)
+			}
+		}
+		for _, room := range breakout.Rooms {
+			room.UserIDs = datautil.Filter(room.UserIDs, func(e string) (string, bool) {
+				return e, !datautil.Contain(e, userIDs...)
+			})
+		}
+		if target != nil {
+			target.UserIDs = append(target.UserIDs, userIDs...)
+		}
+		resp.Breakout = breakout
+		return true, nil
+	})
+	if err != nil {
+		return resp, errs.WrapMsg(err, "update meta data failed")
+	}
+	s.notifyMeetingBreakout(ctx, req.MeetingID, req.UserID, resp.Breakout, false)
+	return resp, nil
+}
+
+// JoinBreakoutRoom the participants join the assigned room, or the room of their choice in the SelfSelect mode,
+// the hosts join any of the rooms
+func (s *meetingServer) JoinBreakoutRoom(ctx context.Context, req *pbmeetingext.JoinBreakoutRoomReq) (*pbmeetingext.JoinBreakoutRoomResp, error) {
+	resp := &pbmeetingext.JoinBreakoutRoomResp{}
+	metaData, ext, err := s.meetingRtc.GetRoomDataExt(ctx, req.MeetingID)
+	if err != nil {
+		return resp, errs.WrapMsg(err, "get room data failed, only the breakout rooms of the meeting in progress could be joined", "meetingID", req.MeetingID)
+	}
+	if ext.Breakout == nil {
+		return resp, errs.ErrArgs.WrapMsg("breakout rooms are not started", "meetingID", req.MeetingID)
+	}
+	room := s.findBreakoutRoom(ext.Breakout, req.BreakoutID)
+	if room == nil {
+		return resp, errs.ErrArgs.WrapMsg("breakout room not found", "breakoutID", req.BreakoutID)
+	}
+	if !s.checkRoomPermission(metaData, ext, req.UserID, constant.PermissionBreakout) && !datautil.Contain(req.UserID, room.UserIDs...) {
+		if ext.Breakout.Mode != constant.BreakoutModeSelfSelect {
+			return resp, servererrs.ErrMeetingAuthCheck.WrapMsg("user is not assigned to the breakout room", "breakoutID", req.BreakoutID)
+		}
+		inMeeting, err := s.checkUserInBreakoutMeeting(ctx, req.MeetingID, ext.Breakout, req.UserID)
+		if err != nil {
+			return resp, err
+		}
+		if !inMeeting {
+			return resp, errs.ErrArgs.WrapMsg("user is not in the meeting", "userID", req.UserID)
+		}
+		// the selection is kept, so the others see who is in which room
+		var breakout *pbmeetingext.MeetingBreakout
+		err = s.meetingRtc.ModifyRoomData(ctx, req.MeetingID, func(data *rtc.RoomData) (bool, error) {
+			breakout = data.Ext.Breakout
+			selected := s.findBreakoutRoom(breakout, req.BreakoutID)
+			if selected == nil {
+				return false, errs.ErrArgs.WrapMsg("breakout room not found", "breakoutID", req.BreakoutID)
+			}
+			for _, other := range breakout.Rooms {
+				other.UserIDs = datautil.Filter(other.UserIDs, func(e string) (string, bool) {
+					return e, e != req.UserID
+				})
+			}
+			selected.UserIDs = append(selected.UserIDs, req.UserID)
+			return true, nil
+		})
+		if err != nil {
+			return resp, errs.WrapMsg(err, "update meta data failed")
+		}
+		s.notifyMeetingBreakout(ctx, req.MeetingID, req.UserID, breakout, false)
+	}
+	userInfo, err := s.userRpc.GetUserInfo(ctx, req.UserID)
+	if err != nil {
+		return resp, errs.WrapMsg(err, "get user info failed")
+	}
+	token, liveURL, err := s.meetingRtc.GetJoinToken(ctx, room.RoomID, req.UserID, s.generateParticipantMetaData(userInfo), false)
+	if err != nil {
+		return resp, err
+	}
+	resp.RoomID = room.RoomID
+	resp.Token = token
+	resp.LiveURL = liveURL
+	return resp, nil
+}
+
+// BroadcastBreakoutMessage send the message of the host to the participants in all the breakout rooms
+func (s *meetingServer) BroadcastBreakoutMessage(ctx context.Context, req *pbmeetingext.BroadcastBreakoutMessageReq) (*pbmeetingext.BroadcastBreakoutMessageResp, error) {
+	resp := &pbmeetingext.BroadcastBreakoutMessageResp{}
+	if req.Message == "" {
+		return resp, errs.ErrArgs.WrapMsg("message is empty")
+	}
+	_, ext, err := s.getBreakoutRoomData(ctx, req.MeetingID, req.UserID)
+	if err != nil {
+		return resp, err
+	}
+	if ext.Breakout == nil {
+		return resp, errs.ErrArgs.WrapMsg("breakout rooms are not started", "meetingID", req.MeetingID)
+	}
+	sendData := &pbmeetingext.NotifyMeetingExtData{
+		OperatorUserID: req.UserID,
+		MessageType: &pbmeetingext.NotifyMeetingExtData_BreakoutBroadcastData{BreakoutBroadcastData: &pbmeetingext.BreakoutBroadcastData{
+			Message: req.Message,
+		}},
+	}
+	for _, room := range ext.Breakout.Rooms {
+		if err := s.meetingRtc.SendRoomExtData(ctx, room.RoomID, nil, sendData); err != nil {
+			log.ZWarn(ctx, "broadcast to the breakout room failed", err, "roomID", room.RoomID)
+		}
+	}
+	return resp, nil
+}
+
+// CloseBreakoutRooms close the breakout rooms and pull everyone back to the main room
+func (s *meetingServer) CloseBreakoutRooms(ctx context.Context, req *pbmeetingext.CloseBreakoutRoomsReq) (*pbmeetingext.CloseBreakoutRoomsResp, error) {
+	resp := &pbmeetingext.CloseBreakoutRoomsResp{}
+	if _, _, err := s.getBreakoutRoomData(ctx, req.MeetingID, req.UserID); err != nil {
+		return resp, err
+	}
+	if err := s.closeBreakoutRooms(ctx, req.MeetingID, req.UserID); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+// getBreakoutRoomData only the hosts manage the breakout rooms of the meeting in progress
+func (s *meetingServer) getBreakoutRoomData(ctx context.Context, meetingID, userID string) (*pbmeeting.MeetingMetadata, *pbmeetingext.MeetingMetadataExt, error) {
+	metaData, ext, err := s.meetingRtc.GetRoomDataExt(ctx, meetingID)
+	if err != nil {
+		return nil, nil, errs.WrapMsg(err, "get room data failed, only the meeting in progress has breakout rooms", "meetingID", meetingID)
+	}
+	if !s.checkRoomPermission(metaData, ext, userID, constant.PermissionBreakout) {
+		return nil, nil, servererrs.ErrMeetingAuthCheck.WrapMsg("user did not have permission to manage the breakout rooms")
+	}
+	return metaData, ext, nil
+}
+
+func (s *meetingServer) findBreakoutRoom(breakout *pbmeetingext.MeetingBreakout, breakoutID string) *pbmeetingext.BreakoutRoom {
+	for _, room := range breakout.GetRooms() {
+		if room.BreakoutID == breakoutID {
+			return room
+		}
+	}
+	return nil
+}
+
+// assignRandomBreakoutRooms spread the participants in the meeting room evenly, the hosts and the phone participants stay
+func (s *meetingServer) assignRandomBreakoutRooms(ctx context.Context, meetingID string, metaData *pbmeeting.MeetingMetadata, rooms []*pbmeetingext.BreakoutRoom) error {
+	participants, err := s.meetingRtc.ListParticipants(ctx, meetingID)
+	if err != nil {
+		return errs.WrapMsg(err, "list participants failed", "meetingID", meetingID)
+	}
+	hostUserIDs := s.getHostsUserIDs(metaData)
+	var userIDs []string
+	for _, participant := range participants {
+		if participant.GetKind() == livekit.ParticipantInfo_SIP || datautil.Contain(participant.Identity, hostUserIDs...) {
+			continue
+		}
+		userIDs = append(userIDs, participant.Identity)
+	}
+	rand.Shuffle(len(userIDs), func(i, j int) {
+		userIDs[i], userIDs[j] = userIDs[j], userIDs[i]
+	})
+	for i, userID := range userIDs {
+		room := rooms[i%len(rooms)]
+		room.UserIDs = append(room.UserIDs, userID)
+	}
+	return nil
+}
+
+// checkUserInBreakoutMeeting check the user is in the meeting room or one of its breakout rooms
+func (s *meetingServer) checkUserInBreakoutMeeting(ctx context.Context, meetingID string, breakout *pbmeetingext.MeetingBreakout, userID string) (bool, error) {
+	roomIDs := []string{meetingID}
+	for _, room := range breakout.Rooms {
+		roomIDs = append(roomIDs, room.RoomID)
+	}
+	for _, roomID := range roomIDs {
+		userIDs, err := s.meetingRtc.GetParticipantUserIDs(ctx, roomID)
+		if err != nil {
+			if errs.ErrRecordNotFound.Is(err) {
+				continue
+			}
+			return false, err
+		}
+		if datautil.Contain(userID, userIDs...) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// closeBreakoutRooms the participants are told to return to the main room before their breakout rooms are closed,
+// operatorUserID is empty if the timer closes them
+func (s *meetingServer) closeBreakoutRooms(ctx context.Context, meetingID, operatorUserID string) error {
+	var breakout *pbmeetingext.MeetingBreakout
+	err := s.meetingRtc.ModifyRoomData(ctx, meetingID, func(data *rtc.RoomData) (bool, error) {
+		breakout = data.Ext.Breakout
+		data.Ext.Breakout = nil
+		return breakout != nil, nil
+	})
+	if err == nil || errs.ErrRecordNotFound.Is(err) {
+		if err := s.breakoutStorageHandler.DelEndTime(ctx, meetingID); err != nil {
+			log.ZWarn(ctx, "delete breakout end time failed", err, "meetingID", meetingID)
+		}
+	}
+	if err != nil {
+		if !errs.ErrRecordNotFound.Is(err) {
+			return errs.WrapMsg(err, "update meta data failed", "meetingID", meetingID)
+		}
+		// the meeting room finished with the assignments, e.g., it is empty for too long while everyone is in the breakout rooms
+		return s.closeOrphanBreakoutRooms(ctx, meetingID)
+	}
+	if breakout == nil {
+		return nil
+	}
+	s.notifyMeetingBreakout(ctx, meetingID, operatorUserID, breakout, true)
+	s.closeBreakoutRTCRooms(ctx, datautil.Slice(breakout.Rooms, func(e *pbmeetingext.BreakoutRoom) string {
+		return e.RoomID
+	}))
+	return nil
+}
+
+func (s *meetingServer) closeOrphanBreakoutRooms(ctx context.Context, meetingID string) error {
+	rooms, err := s.meetingRtc.GetAllRooms(ctx)
+	if err != nil {
+		return err
+	}
+	var roomIDs []string
+	for _, room := range rooms {
+		if parentID, _, ok := rtc.ParseBreakoutRoomID(room.Name); ok && parentID == meetingID {
+			roomIDs = append(roomIDs, room.Name)
+		}
+	}
+	s.closeBreakoutRTCRooms(ctx, roomIDs)
+	return nil
+}
+
+func (s *meetingServer) closeBreakoutRTCRooms(ctx context.Context, roomIDs []string) {
+	for _, roomID := range roomIDs {
+		if err := s.meetingRtc.CloseRoom(ctx, roomID); err != nil {
+			log.ZWarn(ctx, "close breakout room failed", err, "roomID", roomID)
+			continue
+		}
+		s.closePresence(ctx, roomID)
+	}
+}
+
+// closeOverdueBreakouts pull everyone back to the main room when the timer of the breakout rooms is up,
+// the end time is kept until the breakout rooms are closed, so the failed ones are closed in the next round
+func (s *meetingServer) closeOverdueBreakouts(ctx context.Context) {
+	meetingIDs, err := s.breakoutStorageHandler.FindOverdue(ctx, timeutil.GetCurrentTimestampBySecond())
+	if err != nil {
+		log.ZError(ctx, "find overdue breakouts failed", err)
+		return
+	}
+	for _, meetingID := range meetingIDs {
+		log.ZInfo(ctx, "close the breakout rooms reaching the end time", "meetingID", meetingID)
+		if err := s.closeBreakoutRooms(ctx, meetingID, ""); err != nil {
+			log.ZError(ctx, "close overdue breakout rooms failed", err, "meetingID", meetingID)
+		}
+	}
+}
+
+// handleBreakoutWebhook the participants in the breakout rooms attend the meeting the rooms belong to,
+// the other room events are left to the meeting room
+func (s *meetingServer) handleBreakoutWebhook(ctx context.Context, meetingID string, event *livekit.WebhookEvent) {
+	roomID := event.Room.GetName()
+	switch event.Event {
+	case webhook.EventParticipantJoined:
+		s.joinPresence(ctx, roomID, event.Participant.GetIdentity())
+		s.recordJoin(ctx, meetingID, event.Participant.GetIdentity())
+	case webhook.EventParticipantLeft:
+		s.leavePresence(ctx, roomID, []string{event.Participant.GetIdentity()})
+		s.recordLeave(ctx, meetingID, []string{event.Participant.GetIdentity()}, constant.LeaveReasonLeft)
+	case webhook.EventRoomFinished:
+		s.closePresence(ctx, roomID)
+	default:
+		log.ZDebug(ctx, "livekit webhook event of breakout room not handled", "event", event.Event, "roomID", roomID)
+	}
+}
+
+// notifyMeetingBreakout send the breakout rooms to all the participants in the meeting room and the breakout rooms
+func (s *meetingServer) notifyMeetingBreakout(ctx context.Context, meetingID, operatorUserID string, breakout *pbmeetingext.MeetingBreakout, closed bool) {
+	sendData := &pbmeetingext.NotifyMeetingExtData{
+		OperatorUserID: operatorUserID,
+		MessageType: &pbmeetingext.NotifyMeetingExtData_MeetingBreakoutData{MeetingBreakoutData: &pbmeetingext.MeetingBreakoutData{
+			Breakout: breakout,
+			Closed:   closed,
+		}},
+	}
+	roomIDs := []string{meetingID}
+	for _, room := range breakout.Rooms {
+		roomIDs = append(roomIDs, room.RoomID)
+	}
+	for _, roomID := range roomIDs {
+		if err := s.meetingRtc.SendRoomExtData(ctx, roomID, nil, sendData); err != nil {
+			log.ZWarn(ctx, "send meeting breakout data failed", err, "roomID", roomID)
+		}
+	}
+}
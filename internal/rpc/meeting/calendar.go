@@ -0,0 +1,186 @@
+package meeting
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/openimsdk/openmeeting-server/pkg/common/constant"
+	"github.com/openimsdk/openmeeting-server/pkg/common/ical"
+	"github.com/openimsdk/openmeeting-server/pkg/common/recurrence"
+	"github.com/openimsdk/openmeeting-server/pkg/common/securetools"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	pbmeetingext "github.com/openimsdk/openmeeting-server/pkg/protocol/meetingext"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/timeutil"
+	"strings"
+	"time"
+)
+
+const (
+	calendarUIDSuffix = "@openmeeting"
+	feedTokenSize     = 32
+)
+
+// GetMeetingICalendar export the meeting with its repeat rule and occurrence overrides as an iCalendar
+func (s *meetingServer) GetMeetingICalendar(ctx context.Context, req *pbmeetingext.GetMeetingICalendarReq) (*pbmeetingext.GetMeetingICalendarResp, error) {
+	resp := &pbmeetingext.GetMeetingICalendarResp{}
+	info, err := s.meetingStorageHandler.TakeWithError(ctx, req.MeetingID)
+	if err != nil {
+		return resp, errs.WrapMsg(err, "get meeting data failed")
+	}
+	overrides, err := s.occurrenceStorageHandler.FindOverrides(ctx, []string{info.MeetingID})
+	if err != nil {
+		return resp, err
+	}
+	calendar := &ical.Calendar{Name: info.Title, Events: s.generateMeetingEvents(ctx, info, overrides)}
+	resp.Calendar = string(calendar.Bytes())
+	return resp, nil
+}
+
+// GetCalendarFeedToken get the calendar feed token of the user, a new one is generated on the first call or when renewed
+func (s *meetingServer) GetCalendarFeedToken(ctx context.Context, req *pbmeetingext.GetCalendarFeedTokenReq) (*pbmeetingext.GetCalendarFeedTokenResp, error) {
+	resp := &pbmeetingext.GetCalendarFeedTokenResp{}
+	if req.UserID == "" {
+		return resp, errs.ErrArgs.WrapMsg("user id is required")
+	}
+	if !req.Renew {
+		feed, err := s.calendarStorageHandler.Take(ctx, req.UserID)
+		if err == nil {
+			resp.FeedToken = feed.Token
+			return resp, nil
+		}
+		if !errors.Is(err, errs.ErrRecordNotFound) {
+			return resp, err
+		}
+	}
+	token, err := securetools.GenerateToken(feedTokenSize)
+	if err != nil {
+		return resp, err
+	}
+	feed := &model.CalendarFeed{
+		UserID:     req.UserID,
+		Token:      token,
+		CreateTime: timeutil.GetCurrentTimestampBySecond(),
+	}
+	if err := s.calendarStorageHandler.Save(ctx, feed); err != nil {
+		return resp, err
+	}
+	resp.FeedToken = token
+	return resp, nil
+}
+
+// GetCalendarFeed get the iCalendar of all the meetings booked by or for the user owning the feed token
+func (s *meetingServer) GetCalendarFeed(ctx context.Context, req *pbmeetingext.GetCalendarFeedReq) (*pbmeetingext.GetCalendarFeedResp, error) {
+	resp := &pbmeetingext.GetCalendarFeedResp{}
+	if req.FeedToken == "" {
+		return resp, errs.ErrArgs.WrapMsg("feed token is required")
+	}
+	feed, err := s.calendarStorageHandler.TakeByToken(ctx, req.FeedToken)
+	if err != nil {
+		if errors.Is(err, errs.ErrRecordNotFound) {
+			return resp, errs.ErrNoPermission.WrapMsg("invalid feed token")
+		}
+		return resp, err
+	}
+	meetings, err := s.findUserMeetings(ctx, feed.UserID, []string{constant.Scheduled, constant.InProgress, constant.Completed})
+	if err != nil {
+		return resp, err
+	}
+	meetingIDs := make([]string, 0, len(meetings))
+	for _, one := range meetings {
+		meetingIDs = append(meetingIDs, one.MeetingID)
+	}
+	overrides, err := s.occurrenceStorageHandler.FindOverrides(ctx, meetingIDs)
+	if err != nil {
+		return resp, err
+	}
+	overrideMap := make(map[string][]*model.MeetingOverride)
+	for _, one := range overrides {
+		overrideMap[one.MeetingID] = append(overrideMap[one.MeetingID], one)
+	}
+
+	calendar := &ical.Calendar{Name: "OpenMeeting"}
+	for _, one := range meetings {
+		calendar.Events = append(calendar.Events, s.generateMeetingEvents(ctx, one, overrideMap[one.MeetingID])...)
+	}
+	resp.Calendar = string(calendar.Bytes())
+	return resp, nil
+}
+
+func (s *meetingServer) getMeetingJoinURL(meetingID string) string {
+	return strings.ReplaceAll(s.config.Rpc.Calendar.JoinURL, "{meetingID}", meetingID)
+}
+
+func (s *meetingServer) getEventDescription(meetingID, password string) string {
+	lines := []string{fmt.Sprintf("Meeting ID: %s", meetingID)}
+	if joinURL := s.getMeetingJoinURL(meetingID); joinURL != "" {
+		lines = append(lines, fmt.Sprintf("Join: %s", joinURL))
+	}
+	if password != "" {
+		// only hint the password, the feed link could be shared with others
+		lines = append(lines, "A password is required to join this meeting.")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// generateMeetingEvents builds the recurring event of the meeting in its time zone, and one more event for
+// each occurrence rescheduled or retitled. The cancelled occurrences are excluded from the recurring event.
+func (s *meetingServer) generateMeetingEvents(ctx context.Context, info *model.MeetingInfo, overrides []*model.MeetingOverride) []*ical.Event {
+	loc, err := recurrence.Location(info)
+	if err != nil {
+		log.ZWarn(ctx, "load meeting location failed, use UTC", err, "meetingID", info.MeetingID)
+		loc = time.UTC
+	}
+	uid := info.MeetingID + calendarUIDSuffix
+	event := &ical.Event{
+		UID:         uid,
+		Summary:     info.Title,
+		Description: s.getEventDescription(info.MeetingID, info.Password),
+		URL:         s.getMeetingJoinURL(info.MeetingID),
+		Status:      ical.StatusConfirmed,
+		Start:       time.Unix(info.ScheduledTime, 0).In(loc),
+		Duration:    time.Duration(info.MeetingDuration) * time.Second,
+	}
+	if !s.isRepeatMeeting(info) {
+		return []*ical.Event{event}
+	}
+
+	event.RRule = recurrence.Rule(info)
+	if info.EndDate > 0 && !strings.Contains(event.RRule, "UNTIL=") && !strings.Contains(event.RRule, "COUNT=") {
+		event.RRule += ";UNTIL=" + ical.FormatUTC(time.Unix(info.EndDate, 0))
+	}
+	for _, exDate := range info.ExDates {
+		event.ExDates = append(event.ExDates, time.Unix(exDate, 0).In(loc))
+	}
+	events := []*ical.Event{event}
+	for _, override := range overrides {
+		originalStart := time.Unix(override.OriginalStartTime, 0).In(loc)
+		if override.Cancelled {
+			event.ExDates = append(event.ExDates, originalStart)
+			continue
+		}
+		instance := &ical.Event{
+			UID:          uid,
+			Summary:      info.Title,
+			Description:  event.Description,
+			URL:          event.URL,
+			Status:       ical.StatusConfirmed,
+			Start:        originalStart,
+			Duration:     time.Duration(s.getOverrideDuration(info, override)) * time.Second,
+			RecurrenceID: originalStart,
+			LastModified: time.Unix(override.UpdateTime, 0),
+		}
+		if override.ScheduledTime > 0 {
+			instance.Start = time.Unix(override.ScheduledTime, 0).In(loc)
+		}
+		if override.Title != nil {
+			instance.Summary = *override.Title
+		}
+		if override.Password != nil {
+			instance.Description = s.getEventDescription(info.MeetingID, *override.Password)
+		}
+		events = append(events, instance)
+	}
+	return events
+}
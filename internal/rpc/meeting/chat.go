@@ -0,0 +1,153 @@
+package meeting
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/constant"
+	"github.com/openimsdk/openmeeting-server/pkg/common/securetools"
+	"github.com/openimsdk/openmeeting-server/pkg/common/servererrs"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	pbmeetingext "github.com/openimsdk/openmeeting-server/pkg/protocol/meetingext"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// maxChatContentLength limits the characters of a chat message
+const maxChatContentLength = 4096
+
+// SendMeetingChatMessage store the message and send it to the receivers in the room, the sender must be in the room
+func (s *meetingServer) SendMeetingChatMessage(ctx context.Context, req *pbmeetingext.SendMeetingChatMessageReq) (*pbmeetingext.SendMeetingChatMessageResp, error) {
+	resp := &pbmeetingext.SendMeetingChatMessageResp{}
+	if strings.TrimSpace(req.Content) == "" {
+		return resp, errs.ErrArgs.WrapMsg("content is empty")
+	}
+	if utf8.RuneCountInString(req.Content) > maxChatContentLength {
+		return resp, errs.ErrArgs.WrapMsg("content is too long", "maxLength", maxChatContentLength)
+	}
+	switch req.RecvType {
+	case constant.ChatRecvPublic, constant.ChatRecvHosts:
+		req.RecvUserID = ""
+	case constant.ChatRecvPrivate:
+		if req.RecvUserID == "" || req.RecvUserID == req.UserID {
+			return resp, errs.ErrArgs.WrapMsg("recvUserID of the private message should be another participant")
+		}
+	default:
+		return resp, errs.ErrArgs.WrapMsg("recvType should be Public, Private or Hosts", "recvType", req.RecvType)
+	}
+	metaData, err := s.meetingRtc.GetRoomData(ctx, req.MeetingID)
+	if err != nil {
+		return resp, errs.WrapMsg(err, "get room data failed, only the message in the meeting in progress could be sent", "meetingID", req.MeetingID)
+	}
+	participantMetaData, err := s.meetingRtc.GetParticipantMetaData(ctx, req.MeetingID, req.UserID)
+	if err != nil {
+		return resp, errs.WrapMsg(err, "user is not in the meeting", "userID", req.UserID)
+	}
+	if req.RecvType == constant.ChatRecvPrivate {
+		// the receiver in a breakout room reads the message from the history
+		present, err := s.checkUserPresent(ctx, req.RecvUserID, req.MeetingID)
+		if err != nil {
+			return resp, err
+		}
+		if !present {
+			return resp, errs.ErrArgs.WrapMsg("recvUserID of the private message is not in the meeting", "recvUserID", req.RecvUserID)
+		}
+	}
+	messageID, err := securetools.GenerateToken(16)
+	if err != nil {
+		return resp, err
+	}
+	now := time.Now()
+	message := &model.MeetingChatMessage{
+		MessageID:  messageID,
+		MeetingID:  req.MeetingID,
+		SendUserID: req.UserID,
+		RecvType:   req.RecvType,
+		RecvUserID: req.RecvUserID,
+		Content:    req.Content,
+		SendTime:   now.UnixMilli(),
+	}
+	if participantMetaData.UserInfo != nil {
+		message.SendNickname = participantMetaData.UserInfo.Nickname
+	}
+	if occurrence, err := s.occurrenceStorageHandler.TakeCurrent(ctx, req.MeetingID, now.Unix()); err == nil {
+		message.OccurrenceID = occurrence.OccurrenceID
+	}
+	if err := s.chatStorageHandler.Create(ctx, message); err != nil {
+		return resp, err
+	}
+
+	resp.Message = s.generateClientChatMessage(message)
+	var recvUserIDs *[]string
+	switch req.RecvType {
+	case constant.ChatRecvPrivate:
+		recvUserIDs = &[]string{req.RecvUserID, req.UserID}
+	case constant.ChatRecvHosts:
+		userIDs := datautil.Distinct(append(s.getHostsUserIDs(metaData), req.UserID))
+		recvUserIDs = &userIDs
+	}
+	// the message is kept, the receivers missing it get it from the history
+	if err := s.meetingRtc.SendRoomChatData(ctx, req.MeetingID, recvUserIDs, resp.Message); err != nil {
+		log.ZWarn(ctx, "send chat message failed", err, "meetingID", req.MeetingID, "messageID", messageID)
+	}
+	return resp, nil
+}
+
+// GetMeetingChatMessages the users attended the meeting read the history, the messages to the hosts are read by the hosts
+func (s *meetingServer) GetMeetingChatMessages(ctx context.Context, req *pbmeetingext.GetMeetingChatMessagesReq) (*pbmeetingext.GetMeetingChatMessagesResp, error) {
+	resp := &pbmeetingext.GetMeetingChatMessagesResp{}
+	info, err := s.meetingStorageHandler.TakeWithError(ctx, req.MeetingID)
+	if err != nil {
+		return resp, errs.WrapMsg(err, "get meeting data failed")
+	}
+	withHosts, err := s.checkChatReader(ctx, info, req.UserID)
+	if err != nil {
+		return resp, err
+	}
+	if req.Pagination == nil {
+		req.Pagination = &sdkws.RequestPagination{PageNumber: 1, ShowNumber: 20}
+	}
+	total, messages, err := s.chatStorageHandler.Page(ctx, req.MeetingID, req.OccurrenceID, req.UserID, withHosts, req.Pagination)
+	if err != nil {
+		return resp, err
+	}
+	resp.Total = total
+	resp.Messages = datautil.Slice(messages, s.generateClientChatMessage)
+	return resp, nil
+}
+
+// checkChatReader check the user attended the meeting, and whether the user reads the messages to the hosts,
+// which are only read by the creator after the room is closed
+func (s *meetingServer) checkChatReader(ctx context.Context, info *model.MeetingInfo, userID string) (bool, error) {
+	if userID == info.CreatorUserID {
+		return true, nil
+	}
+	withHosts := false
+	if metaData, err := s.meetingRtc.GetRoomData(ctx, info.MeetingID); err == nil {
+		withHosts = datautil.Contain(userID, s.getHostsUserIDs(metaData)...)
+	}
+	if err := s.checkAttended(ctx, info, userID); err != nil {
+		return false, err
+	}
+	return withHosts, nil
+}
+
+// checkAttended check the user is the creator or has ever joined the room of the meeting
+func (s *meetingServer) checkAttended(ctx context.Context, info *model.MeetingInfo, userID string) error {
+	if userID == info.CreatorUserID {
+		return nil
+	}
+	attendances, err := s.attendanceStorageHandler.Find(ctx, info.MeetingID, "")
+	if err != nil {
+		return err
+	}
+	for _, attendance := range attendances {
+		if attendance.UserID == userID {
+			return nil
+		}
+	}
+	return servererrs.ErrMeetingAuthCheck.WrapMsg("user did not attend the meeting")
+}
@@ -0,0 +1,89 @@
+package meeting
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/servererrs"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/controller"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	"github.com/openimsdk/openmeeting-server/pkg/rtc"
+	pbmeeting "github.com/openimsdk/protocol/openmeeting/meeting"
+	"github.com/openimsdk/tools/errs"
+	"testing"
+)
+
+// memoryRoom keeps the metadata of the rooms in memory, the other methods are not used by the checks.
+type memoryRoom struct {
+	rtc.MeetingRtc
+	rooms map[string]*pbmeeting.MeetingMetadata
+}
+
+func (m *memoryRoom) GetRoomData(_ context.Context, roomID string) (*pbmeeting.MeetingMetadata, error) {
+	metaData, ok := m.rooms[roomID]
+	if !ok {
+		return nil, errs.ErrRecordNotFound.WrapMsg("room not found", "roomID", roomID)
+	}
+	return metaData, nil
+}
+
+// memoryAttendance keeps the attendance in memory, the other methods are not used by the checks.
+type memoryAttendance struct {
+	controller.Attendance
+	attendances []*model.MeetingAttendance
+}
+
+func (m *memoryAttendance) Find(_ context.Context, meetingID, _ string) ([]*model.MeetingAttendance, error) {
+	var attendances []*model.MeetingAttendance
+	for _, one := range m.attendances {
+		if one.MeetingID == meetingID {
+			attendances = append(attendances, one)
+		}
+	}
+	return attendances, nil
+}
+
+func TestCheckChatReader(t *testing.T) {
+	info := &model.MeetingInfo{MeetingID: "1001", CreatorUserID: "1"}
+	attendance := &memoryAttendance{attendances: []*model.MeetingAttendance{
+		{MeetingID: "1001", UserID: "2"},
+		{MeetingID: "1001", UserID: "3"},
+		{MeetingID: "1002", UserID: "4"},
+	}}
+	// the host is handed over to 2, 3 attends without a role
+	metaData := newTestMetaData()
+	metaData.Detail.Info.SystemGenerated = &pbmeeting.SystemGeneratedMeetingInfo{CreatorUserID: "1"}
+	metaData.Detail.Info.CreatorDefinedMeeting.HostUserID = "2"
+	metaData.Detail.Info.CreatorDefinedMeeting.CoHostUSerID = nil
+
+	for _, tt := range []struct {
+		name          string
+		rooms         map[string]*pbmeeting.MeetingMetadata
+		userID        string
+		wantWithHosts bool
+		wantErr       errs.CodeError
+	}{
+		{name: "creator in the room", rooms: map[string]*pbmeeting.MeetingMetadata{"1001": metaData}, userID: "1", wantWithHosts: true},
+		{name: "creator after the room closed", userID: "1", wantWithHosts: true},
+		{name: "host in the room", rooms: map[string]*pbmeeting.MeetingMetadata{"1001": metaData}, userID: "2", wantWithHosts: true},
+		{name: "host after the room closed", userID: "2"},
+		{name: "attendee", rooms: map[string]*pbmeeting.MeetingMetadata{"1001": metaData}, userID: "3"},
+		{name: "attendee of another meeting", rooms: map[string]*pbmeeting.MeetingMetadata{"1001": metaData}, userID: "4", wantErr: servererrs.ErrMeetingAuthCheck},
+		{name: "stranger", userID: "5", wantErr: servererrs.ErrMeetingAuthCheck},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &meetingServer{meetingRtc: &memoryRoom{rooms: tt.rooms}, attendanceStorageHandler: attendance}
+			withHosts, err := s.checkChatReader(context.Background(), info, tt.userID)
+			if tt.wantErr != nil {
+				if !tt.wantErr.Is(err) {
+					t.Fatalf("checkChatReader() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("checkChatReader() error = %v", err)
+			}
+			if withHosts != tt.wantWithHosts {
+				t.Errorf("checkChatReader() withHosts = %v, want %v", withHosts, tt.wantWithHosts)
+			}
+		})
+	}
+}
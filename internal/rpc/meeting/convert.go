@@ -6,11 +6,14 @@ import (
 	"github.com/openimsdk/openmeeting-server/pkg/common/constant"
 	"github.com/openimsdk/openmeeting-server/pkg/common/convert"
 	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	pbmeetingext "github.com/openimsdk/openmeeting-server/pkg/protocol/meetingext"
 	pbmeeting "github.com/openimsdk/protocol/openmeeting/meeting"
 	pbuser "github.com/openimsdk/protocol/openmeeting/user"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
 	"github.com/openimsdk/tools/utils/timeutil"
+	"google.golang.org/protobuf/proto"
 	"reflect"
 	"sort"
 	"strings"
@@ -239,6 +242,15 @@ func (s *meetingServer) getMeetingDetailSetting(ctx context.Context, info *model
 		//meetingInfoSetting.Info.CreatorDefinedMeeting.MeetingDuration = metaData.Detail.Info.CreatorDefinedMeeting.MeetingDuration
 		meetingInfoSetting.Info.CreatorDefinedMeeting.HostUserID = metaData.Detail.Info.CreatorDefinedMeeting.HostUserID
 		meetingInfoSetting.Info.CreatorDefinedMeeting.CoHostUSerID = metaData.Detail.Info.CreatorDefinedMeeting.CoHostUSerID
+	} else if info.Status == constant.InProgress {
+		// the room is not available, the hosts are kept in the state of the meeting in progress
+		state, err := s.stateStorageHandler.Take(ctx, info.MeetingID)
+		if err != nil && !errs.ErrRecordNotFound.Is(err) {
+			return nil, errs.WrapMsg(err, "get meeting state failed", "meetingID", info.MeetingID)
+		}
+		if state != nil {
+			s.applyHostState(meetingInfoSetting.Info.CreatorDefinedMeeting, state)
+		}
 	}
 
 	return meetingInfoSetting, nil
@@ -301,6 +313,86 @@ func (s *meetingServer) generateClientRespMeetingSetting(
 	return meetingInfoSetting
 }
 
+func (s *meetingServer) generateClientOccurrence(occurrence *model.MeetingOccurrence, detail *pbmeeting.MeetingInfoSetting, override *model.MeetingOverride) *pbmeetingext.MeetingOccurrence {
+	if override != nil && (override.Title != nil || override.Password != nil) {
+		detail = proto.Clone(detail).(*pbmeeting.MeetingInfoSetting)
+		s.applyOverrideDetail(detail, override)
+	}
+	return &pbmeetingext.MeetingOccurrence{
+		OccurrenceID:       occurrence.OccurrenceID,
+		MeetingID:          occurrence.MeetingID,
+		OriginalStartTime:  occurrence.OriginalStartTime,
+		PlannedStartTime:   occurrence.PlannedStartTime,
+		PlannedEndTime:     occurrence.PlannedEndTime,
+		ActualStartTime:    occurrence.ActualStartTime,
+		ActualEndTime:      occurrence.ActualEndTime,
+		Status:             occurrence.Status,
+		ParticipantUserIDs: occurrence.ParticipantUserIDs,
+		MeetingDetail:      detail,
+	}
+}
+
+func (s *meetingServer) generateClientInvitee(invitation *model.MeetingInvitation, userInfo *pbuser.UserInfo) *pbmeetingext.MeetingInvitee {
+	invitee := &pbmeetingext.MeetingInvitee{
+		UserID:        invitation.UserID,
+		InviterUserID: invitation.InviterUserID,
+		Response:      invitation.Response,
+		InviteTime:    invitation.InviteTime,
+		ResponseTime:  invitation.ResponseTime,
+	}
+	if userInfo != nil {
+		invitee.Nickname = userInfo.Nickname
+	}
+	return invitee
+}
+
+func (s *meetingServer) generateClientWaitingUser(user *model.WaitingUser) *pbmeetingext.WaitingUser {
+	return &pbmeetingext.WaitingUser{
+		UserID:         user.UserID,
+		Nickname:       user.Nickname,
+		Status:         user.Status,
+		RequestTime:    user.RequestTime,
+		ResponseTime:   user.ResponseTime,
+		OperatorUserID: user.OperatorUserID,
+	}
+}
+
+func (s *meetingServer) generateClientChatMessage(message *model.MeetingChatMessage) *pbmeetingext.MeetingChatMessage {
+	return &pbmeetingext.MeetingChatMessage{
+		MessageID:    message.MessageID,
+		MeetingID:    message.MeetingID,
+		OccurrenceID: message.OccurrenceID,
+		SendUserID:   message.SendUserID,
+		SendNickname: message.SendNickname,
+		RecvType:     message.RecvType,
+		RecvUserID:   message.RecvUserID,
+		Content:      message.Content,
+		SendTime:     message.SendTime,
+	}
+}
+
+func (s *meetingServer) generateClientRecording(recording *model.MeetingRecording) *pbmeetingext.MeetingRecording {
+	return &pbmeetingext.MeetingRecording{
+		RecordingID:  recording.RecordingID,
+		MeetingID:    recording.MeetingID,
+		OccurrenceID: recording.OccurrenceID,
+		StartUserID:  recording.StartUserID,
+		StopUserID:   recording.StopUserID,
+		Status:       recording.Status,
+		StartTime:    recording.StartTime,
+		EndTime:      recording.EndTime,
+		Error:        recording.Error,
+		Files: datautil.Slice(recording.Files, func(file *model.MeetingRecordingFile) *pbmeetingext.MeetingRecordingFile {
+			return &pbmeetingext.MeetingRecordingFile{
+				Filename: file.Filename,
+				Location: file.Location,
+				Size:     file.Size,
+				Duration: file.Duration,
+			}
+		}),
+	}
+}
+
 func (s *meetingServer) getDBUpdateData(ctx context.Context, info *model.MeetingInfo, req *pbmeeting.UpdateMeetingRequest) *map[string]any {
 	updateData := map[string]any{}
 
@@ -359,12 +451,18 @@ func (s *meetingServer) updateRepeatInfo(updateData *map[string]any, req *pbmeet
 			(*updateData)["uint_type"] = req.RepeatInfo.UintType
 			(*updateData)["interval"] = req.RepeatInfo.Interval
 			(*updateData)["repeat_day_of_week"] = *s.getDBRepeatDayOfWeek(&req.RepeatInfo.RepeatDaysOfWeek)
+		} else if req.RepeatInfo.RepeatType == constant.RepeatRRule {
+			// the rule is kept, it is replaced only when the repeat type carries a new one
+			(*updateData)["repeat_times"] = 0
 		} else {
 			// reset setting
 			(*updateData)["uint_type"] = ""
 			(*updateData)["interval"] = 0
 			(*updateData)["repeat_day_of_week"] = nil
 		}
+		if req.RepeatInfo.RepeatType != constant.RepeatRRule {
+			(*updateData)["rrule"] = ""
+		}
 	}
 }
 
@@ -425,3 +523,36 @@ func (s *meetingServer) mergeAndUnique(array1, array2 []string) []string {
 	}
 	return result
 }
+
+// generateClientAttendees groups the attendances by user, in the order of their first join.
+func (s *meetingServer) generateClientAttendees(attendances []*model.MeetingAttendance, userMap map[string]*pbuser.UserInfo, now int64) []*pbmeetingext.MeetingAttendee {
+	var attendees []*pbmeetingext.MeetingAttendee
+	attendeeMap := make(map[string]*pbmeetingext.MeetingAttendee)
+	for _, one := range attendances {
+		attendee, ok := attendeeMap[one.UserID]
+		if !ok {
+			attendee = &pbmeetingext.MeetingAttendee{UserID: one.UserID, FirstJoinTime: one.JoinTime}
+			if userInfo, ok := userMap[one.UserID]; ok {
+				attendee.Nickname = userInfo.Nickname
+			}
+			attendeeMap[one.UserID] = attendee
+			attendees = append(attendees, attendee)
+		}
+		leaveTime := one.LeaveTime
+		if leaveTime == 0 {
+			leaveTime = now
+		}
+		record := &pbmeetingext.AttendanceRecord{
+			OccurrenceID: one.OccurrenceID,
+			JoinTime:     one.JoinTime,
+			LeaveTime:    one.LeaveTime,
+			LeaveReason:  one.LeaveReason,
+			Duration:     max(leaveTime-one.JoinTime, 0),
+		}
+		attendee.Records = append(attendee.Records, record)
+		attendee.TotalDuration += record.Duration
+		// the records are ordered by join time, so the last one decides whether the user is still in
+		attendee.LastLeaveTime = one.LeaveTime
+	}
+	return attendees
+}
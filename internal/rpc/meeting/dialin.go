@@ -0,0 +1,245 @@
+package meeting
+
+import (
+	"context"
+	"github.com/livekit/protocol/livekit"
+	"github.com/openimsdk/openmeeting-server/pkg/common/securetools"
+	"github.com/openimsdk/openmeeting-server/pkg/common/servererrs"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	pbmeetingext "github.com/openimsdk/openmeeting-server/pkg/protocol/meetingext"
+	"github.com/openimsdk/openmeeting-server/pkg/rtc"
+	pbmeeting "github.com/openimsdk/protocol/openmeeting/meeting"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/openimsdk/tools/utils/timeutil"
+)
+
+const (
+	// defaultPinLength the digits of the PIN if it is not configured
+	defaultPinLength = 6
+	// allocatePinRetry the times to retry when the random PIN is taken by another meeting
+	allocatePinRetry = 5
+	// phoneParticipantName the nickname of the phone participant whose number is hidden
+	phoneParticipantName = "Phone"
+)
+
+// GetMeetingDialIn get the dial-in of the meeting for the creator, the invitees and the participants in the room
+func (s *meetingServer) GetMeetingDialIn(ctx context.Context, req *pbmeetingext.GetMeetingDialInReq) (*pbmeetingext.GetMeetingDialInResp, error) {
+	resp := &pbmeetingext.GetMeetingDialInResp{}
+	if !s.config.Rtc.SIP.Enable {
+		return resp, errs.ErrArgs.WrapMsg("dial-in is not enabled")
+	}
+	info, err := s.meetingStorageHandler.TakeWithError(ctx, req.MeetingID)
+	if err != nil {
+		return resp, errs.WrapMsg(err, "get meeting data failed")
+	}
+	if err := s.checkDialInReader(ctx, info, req.UserID); err != nil {
+		return resp, err
+	}
+	dialIn, err := s.takeDialIn(ctx, req.MeetingID)
+	if err != nil {
+		return resp, err
+	}
+	// the PIN allocated during the meeting works at once
+	if dialIn.RuleID == "" {
+		if _, err := s.meetingRtc.GetRoom(ctx, req.MeetingID); err == nil {
+			s.openDialIn(ctx, req.MeetingID)
+		}
+	}
+	resp.Numbers = s.config.Rtc.SIP.Numbers
+	resp.Pin = dialIn.PIN
+	return resp, nil
+}
+
+func (s *meetingServer) checkDialInReader(ctx context.Context, info *model.MeetingInfo, userID string) error {
+	if userID == info.CreatorUserID {
+		return nil
+	}
+	invitations, err := s.invitationStorageHandler.FindByMeetingID(ctx, info.MeetingID)
+	if err != nil {
+		return err
+	}
+	for _, invitation := range invitations {
+		if invitation.UserID == userID {
+			return nil
+		}
+	}
+	if userIDs, err := s.meetingRtc.GetParticipantUserIDs(ctx, info.MeetingID); err == nil && datautil.Contain(userID, userIDs...) {
+		return nil
+	}
+	return servererrs.ErrMeetingAuthCheck.WrapMsg("user is not invited to the meeting")
+}
+
+// takeDialIn get the dial-in of the meeting, a random PIN is allocated if the meeting has none
+func (s *meetingServer) takeDialIn(ctx context.Context, meetingID string) (*model.MeetingDialIn, error) {
+	dialIn, err := s.dialInStorageHandler.Take(ctx, meetingID)
+	if err == nil || !errs.ErrRecordNotFound.Is(err) {
+		return dialIn, err
+	}
+	pinLength := s.config.Rtc.SIP.PinLength
+	if pinLength <= 0 {
+		pinLength = defaultPinLength
+	}
+	for i := 0; i < allocatePinRetry; i++ {
+		pin, err := securetools.GenerateDigits(pinLength)
+		if err != nil {
+			return nil, err
+		}
+		dialIn = &model.MeetingDialIn{MeetingID: meetingID, PIN: pin, CreateTime: timeutil.GetCurrentTimestampBySecond()}
+		err = s.dialInStorageHandler.Create(ctx, dialIn)
+		if err == nil {
+			return dialIn, nil
+		}
+		if !errs.ErrDuplicateKey.Is(err) {
+			return nil, err
+		}
+		// allocated by a concurrent request of the meeting
+		if dialIn, err := s.dialInStorageHandler.Take(ctx, meetingID); err == nil {
+			return dialIn, nil
+		}
+	}
+	return nil, errs.ErrInternalServer.WrapMsg("allocate dial-in pin failed, try a longer pin", "meetingID", meetingID)
+}
+
+// getSIPTrunkID get the configured trunk, or find or create the trunk of the configured numbers once
+func (s *meetingServer) getSIPTrunkID(ctx context.Context) (string, error) {
+	conf := &s.config.Rtc.SIP
+	if conf.TrunkID != "" {
+		return conf.TrunkID, nil
+	}
+	s.sipTrunkLock.Lock()
+	defer s.sipTrunkLock.Unlock()
+	if s.sipTrunkID != "" {
+		return s.sipTrunkID, nil
+	}
+	trunkID, err := s.meetingRtc.FindSIPTrunk(ctx, conf.Numbers)
+	if errs.ErrRecordNotFound.Is(err) {
+		trunkID, err = s.meetingRtc.CreateSIPTrunk(ctx, &rtc.SIPTrunk{
+			Numbers:          conf.Numbers,
+			InboundAddresses: conf.InboundAddresses,
+			InboundUsername:  conf.InboundUsername,
+			InboundPassword:  conf.InboundPassword,
+		})
+	}
+	if err != nil {
+		return "", err
+	}
+	s.sipTrunkID = trunkID
+	return trunkID, nil
+}
+
+// openDialIn dispatch the calls entering the PIN into the room which is just open, the rule left by the previous room is replaced
+func (s *meetingServer) openDialIn(ctx context.Context, meetingID string) {
+	if !s.config.Rtc.SIP.Enable {
+		return
+	}
+	dialIn, err := s.takeDialIn(ctx, meetingID)
+	if err != nil {
+		log.ZError(ctx, "allocate dial-in failed", err, "meetingID", meetingID)
+		return
+	}
+	s.deleteDialInRule(ctx, dialIn)
+	trunkID, err := s.getSIPTrunkID(ctx)
+	if err != nil {
+		log.ZError(ctx, "get sip trunk failed", err, "meetingID", meetingID)
+		return
+	}
+	ruleID, err := s.meetingRtc.CreateSIPDispatchRule(ctx, meetingID, dialIn.PIN, []string{trunkID}, s.config.Rtc.SIP.HidePhoneNumber)
+	if err != nil {
+		log.ZError(ctx, "create sip dispatch rule failed", err, "meetingID", meetingID)
+		return
+	}
+	if err := s.dialInStorageHandler.UpdateRuleID(ctx, meetingID, ruleID); err != nil {
+		log.ZError(ctx, "update dial-in rule failed", err, "meetingID", meetingID, "ruleID", ruleID)
+	}
+}
+
+// closeDialIn stop dispatching the calls into the room which is closed, the PIN is released if the meeting will not start again
+func (s *meetingServer) closeDialIn(ctx context.Context, meetingID string, release bool) {
+	dialIn, err := s.dialInStorageHandler.Take(ctx, meetingID)
+	if err != nil {
+		if !errs.ErrRecordNotFound.Is(err) {
+			log.ZError(ctx, "get dial-in failed", err, "meetingID", meetingID)
+		}
+		return
+	}
+	s.deleteDialInRule(ctx, dialIn)
+	if release {
+		err = s.dialInStorageHandler.Delete(ctx, meetingID)
+	} else if dialIn.RuleID != "" {
+		err = s.dialInStorageHandler.UpdateRuleID(ctx, meetingID, "")
+	}
+	if err != nil {
+		log.ZError(ctx, "update dial-in failed", err, "meetingID", meetingID, "release", release)
+	}
+}
+
+func (s *meetingServer) deleteDialInRule(ctx context.Context, dialIn *model.MeetingDialIn) {
+	if dialIn.RuleID == "" {
+		return
+	}
+	if err := s.meetingRtc.DeleteSIPDispatchRule(ctx, dialIn.RuleID); err != nil && !errs.ErrRecordNotFound.Is(err) {
+		log.ZWarn(ctx, "delete sip dispatch rule failed", err, "meetingID", dialIn.MeetingID, "ruleID", dialIn.RuleID)
+	}
+}
+
+// setPhoneParticipantData the phone participant joins without the participant metadata, which is named by the rtc server
+// admitPhoneParticipant remove the phone participant if the meeting is locked, the waiting room is on or the meeting is full,
+// the callers could not wait for the admission of the hosts
+func (s *meetingServer) admitPhoneParticipant(ctx context.Context, roomID, userID string) bool {
+	reason, err := s.checkPhoneParticipant(ctx, roomID, userID)
+	if err == nil && reason == "" {
+		return true
+	}
+	log.ZInfo(ctx, "remove the phone participant", "roomID", roomID, "userID", userID, "reason", reason, "err", err)
+	if err := s.meetingRtc.RemoveParticipant(ctx, roomID, userID); err != nil {
+		log.ZWarn(ctx, "remove phone participant failed", err, "roomID", roomID, "userID", userID)
+	}
+	return false
+}
+
+// checkPhoneParticipant get the reason why the phone participant is refused, empty if it is admitted
+func (s *meetingServer) checkPhoneParticipant(ctx context.Context, roomID, userID string) (string, error) {
+	info, err := s.meetingStorageHandler.TakeWithError(ctx, roomID)
+	if err != nil {
+		return "", errs.WrapMsg(err, "get meeting data failed")
+	}
+	if info.WaitingRoom {
+		return "waiting room is on", nil
+	}
+	metaData, err := s.meetingRtc.GetRoomData(ctx, roomID)
+	if err != nil {
+		return "", errs.WrapMsg(err, "get room data failed", "roomID", roomID)
+	}
+	if metaData.Detail.Setting.GetLockMeeting() {
+		return "meeting is locked", nil
+	}
+	maxParticipants := s.getRoomLimit(info).MaxParticipants
+	if maxParticipants == 0 {
+		return "", nil
+	}
+	userIDs, err := s.meetingRtc.GetParticipantUserIDs(ctx, roomID)
+	if err != nil {
+		return "", errs.WrapMsg(err, "get participants failed", "roomID", roomID)
+	}
+	// the phone participant is already in the room
+	others := datautil.Filter(userIDs, func(e string) (string, bool) {
+		return e, e != userID
+	})
+	if uint32(len(others)) >= maxParticipants {
+		return "meeting is full", nil
+	}
+	return "", nil
+}
+
+func (s *meetingServer) setPhoneParticipantData(ctx context.Context, roomID string, participant *livekit.ParticipantInfo) {
+	nickname := participant.GetName()
+	if nickname == "" {
+		nickname = phoneParticipantName
+	}
+	data := &pbmeeting.ParticipantMetaData{UserInfo: &pbmeeting.UserInfo{UserID: participant.GetIdentity(), Nickname: nickname}}
+	if err := s.meetingRtc.UpdateParticipantData(ctx, data, roomID, participant.GetIdentity()); err != nil {
+		log.ZWarn(ctx, "set phone participant data failed", err, "roomID", roomID, "userID", participant.GetIdentity())
+	}
+}
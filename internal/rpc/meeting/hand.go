@@ -0,0 +1,226 @@
+package meeting
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/constant"
+	"github.com/openimsdk/openmeeting-server/pkg/common/servererrs"
+	pbmeetingext "github.com/openimsdk/openmeeting-server/pkg/protocol/meetingext"
+	"github.com/openimsdk/openmeeting-server/pkg/rtc"
+	pbwrapper "github.com/openimsdk/protocol/wrapperspb"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/openimsdk/tools/utils/timeutil"
+)
+
+// RaiseHand put the user at the end of the speaking queue, raising the hand again keeps the position
+func (s *meetingServer) RaiseHand(ctx context.Context, req *pbmeetingext.RaiseHandReq) (*pbmeetingext.RaiseHandResp, error) {
+	resp := &pbmeetingext.RaiseHandResp{}
+	_, ext, err := s.meetingRtc.GetRoomDataExt(ctx, req.MeetingID)
+	if err != nil {
+		return resp, errs.WrapMsg(err, "get room data failed, only the hand in the meeting in progress could be raised", "meetingID", req.MeetingID)
+	}
+	for i, hand := range ext.RaisedHands {
+		if hand.UserID == req.UserID {
+			resp.Position = int32(i + 1)
+			return resp, nil
+		}
+	}
+	userIDs, err := s.meetingRtc.GetParticipantUserIDs(ctx, req.MeetingID)
+	if err != nil {
+		return resp, err
+	}
+	if !datautil.Contain(req.UserID, userIDs...) {
+		return resp, errs.ErrArgs.WrapMsg("user is not in the meeting", "userID", req.UserID)
+	}
+	userInfo, err := s.userRpc.GetUserInfo(ctx, req.UserID)
+	if err != nil {
+		return resp, errs.WrapMsg(err, "get user info failed")
+	}
+	hand := &pbmeetingext.RaisedHand{
+		UserID:    req.UserID,
+		Nickname:  userInfo.Nickname,
+		RaiseTime: timeutil.GetCurrentTimestampBySecond(),
+	}
+	var hands []*pbmeetingext.RaisedHand
+	err = s.meetingRtc.ModifyRoomData(ctx, req.MeetingID, func(data *rtc.RoomData) (bool, error) {
+		hands = nil
+		for i, raised := range data.Ext.RaisedHands {
+			if raised.UserID == req.UserID {
+				resp.Position = int32(i + 1)
+				return false, nil
+			}
+		}
+		data.Ext.RaisedHands = append(data.Ext.RaisedHands, hand)
+		hands = data.Ext.RaisedHands
+		resp.Position = int32(len(hands))
+		return true, nil
+	})
+	if err != nil {
+		return resp, errs.WrapMsg(err, "update meta data failed")
+	}
+	if hands != nil {
+		s.notifySpeakingQueue(ctx, req.MeetingID, req.UserID, hands)
+	}
+	return resp, nil
+}
+
+// LowerHand the user lowers the own hand, the roles muting others could lower the hand of others
+func (s *meetingServer) LowerHand(ctx context.Context, req *pbmeetingext.LowerHandReq) (*pbmeetingext.LowerHandResp, error) {
+	resp := &pbmeetingext.LowerHandResp{}
+	participantUserID := req.ParticipantUserID
+	if participantUserID == "" {
+		participantUserID = req.UserID
+	}
+	metaData, ext, err := s.meetingRtc.GetRoomDataExt(ctx, req.MeetingID)
+	if err != nil {
+		return resp, errs.WrapMsg(err, "get room data failed", "meetingID", req.MeetingID)
+	}
+	if participantUserID != req.UserID && !s.checkRoomPermission(metaData, ext, req.UserID, constant.PermissionMuteOthers) {
+		return resp, servererrs.ErrMeetingAuthCheck.WrapMsg("user did not have permission to lower the hand of others")
+	}
+	changed, hands, err := s.modifyRaisedHands(ctx, req.MeetingID, func(ext *pbmeetingext.MeetingMetadataExt) bool {
+		return s.removeRaisedHand(ext, participantUserID)
+	})
+	if err != nil {
+		return resp, err
+	}
+	if changed {
+		s.notifySpeakingQueue(ctx, req.MeetingID, req.UserID, hands)
+	}
+	return resp, nil
+}
+
+// ApproveRaisedHand take the participant out of the speaking queue and lift the microphone limit of the participant
+func (s *meetingServer) ApproveRaisedHand(ctx context.Context, req *pbmeetingext.ApproveRaisedHandReq) (*pbmeetingext.ApproveRaisedHandResp, error) {
+	resp := &pbmeetingext.ApproveRaisedHandResp{}
+	metaData, ext, err := s.meetingRtc.GetRoomDataExt(ctx, req.MeetingID)
+	if err != nil {
+		return resp, errs.WrapMsg(err, "get room data failed", "meetingID", req.MeetingID)
+	}
+	if !s.checkRoomPermission(metaData, ext, req.UserID, constant.PermissionMuteOthers) {
+		return resp, servererrs.ErrMeetingAuthCheck.WrapMsg("user did not have permission to approve the raised hand")
+	}
+	if s.getUserRole(metaData, ext, req.ParticipantUserID) == constant.RoleViewer {
+		return resp, errs.ErrArgs.WrapMsg("viewer could not speak, grant the participant another role first", "userID", req.ParticipantUserID)
+	}
+	raisedUserIDs := datautil.Slice(ext.RaisedHands, func(e *pbmeetingext.RaisedHand) string {
+		return e.UserID
+	})
+	if !datautil.Contain(req.ParticipantUserID, raisedUserIDs...) {
+		return resp, errs.ErrArgs.WrapMsg("hand of the participant is not raised", "userID", req.ParticipantUserID)
+	}
+	err = s.meetingRtc.ModifyRoomData(ctx, req.MeetingID, func(data *rtc.RoomData) (bool, error) {
+		if !s.removeRaisedHand(data.Ext, req.ParticipantUserID) {
+			return false, errs.ErrArgs.WrapMsg("hand of the participant is not raised", "userID", req.ParticipantUserID)
+		}
+		var found bool
+		for _, personalData := range data.MetaData.PersonalData {
+			if personalData.GetUserID() == req.ParticipantUserID {
+				if personalData.LimitSetting == nil {
+					personalData.LimitSetting = s.generateDefaultPersonalData(req.ParticipantUserID).LimitSetting
+				}
+				personalData.LimitSetting.MicrophoneOnEntry = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			data.MetaData.PersonalData = append(data.MetaData.PersonalData, s.generateDefaultPersonalData(req.ParticipantUserID))
+		}
+		metaData, ext = data.MetaData, data.Ext
+		return true, nil
+	})
+	if err != nil {
+		return resp, errs.WrapMsg(err, "update meta data failed")
+	}
+	s.saveParticipantState(ctx, req.MeetingID, s.getRoomPersonalData(metaData, req.ParticipantUserID), map[string]any{"limit_microphone_on_entry": true})
+	s.updatePublishPermission(ctx, req.MeetingID, metaData, ext, req.ParticipantUserID)
+	if err := s.sendStreamOperateData2Client(ctx, req.MeetingID, req.ParticipantUserID, nil, &pbwrapper.BoolValue{Value: true}); err != nil {
+		log.ZWarn(ctx, "notify the approved participant failed", err, "meetingID", req.MeetingID, "userID", req.ParticipantUserID)
+	}
+	s.notifySpeakingQueue(ctx, req.MeetingID, req.UserID, ext.RaisedHands)
+	return resp, nil
+}
+
+func (s *meetingServer) ClearRaisedHands(ctx context.Context, req *pbmeetingext.ClearRaisedHandsReq) (*pbmeetingext.ClearRaisedHandsResp, error) {
+	resp := &pbmeetingext.ClearRaisedHandsResp{}
+	metaData, ext, err := s.meetingRtc.GetRoomDataExt(ctx, req.MeetingID)
+	if err != nil {
+		return resp, errs.WrapMsg(err, "get room data failed", "meetingID", req.MeetingID)
+	}
+	if !s.checkRoomPermission(metaData, ext, req.UserID, constant.PermissionMuteOthers) {
+		return resp, servererrs.ErrMeetingAuthCheck.WrapMsg("user did not have permission to clear the raised hands")
+	}
+	changed, hands, err := s.modifyRaisedHands(ctx, req.MeetingID, func(ext *pbmeetingext.MeetingMetadataExt) bool {
+		if len(ext.RaisedHands) == 0 {
+			return false
+		}
+		ext.RaisedHands = nil
+		return true
+	})
+	if err != nil {
+		return resp, err
+	}
+	if changed {
+		s.notifySpeakingQueue(ctx, req.MeetingID, req.UserID, hands)
+	}
+	return resp, nil
+}
+
+func (s *meetingServer) removeRaisedHand(ext *pbmeetingext.MeetingMetadataExt, userID string) bool {
+	for i, hand := range ext.RaisedHands {
+		if hand.UserID == userID {
+			ext.RaisedHands = append(ext.RaisedHands[:i], ext.RaisedHands[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// modifyRaisedHands apply modify on the latest speaking queue, the queue is returned if it is changed
+func (s *meetingServer) modifyRaisedHands(ctx context.Context, roomID string, modify func(ext *pbmeetingext.MeetingMetadataExt) bool) (bool, []*pbmeetingext.RaisedHand, error) {
+	var hands []*pbmeetingext.RaisedHand
+	changed := false
+	err := s.meetingRtc.ModifyRoomData(ctx, roomID, func(data *rtc.RoomData) (bool, error) {
+		changed = modify(data.Ext)
+		hands = data.Ext.RaisedHands
+		return changed, nil
+	})
+	if err != nil {
+		return false, nil, errs.WrapMsg(err, "update meta data failed")
+	}
+	return changed, hands, nil
+}
+
+// lowerHandOnLeave the participant leaving the room leaves the speaking queue
+func (s *meetingServer) lowerHandOnLeave(ctx context.Context, roomID, userID string) {
+	changed, hands, err := s.modifyRaisedHands(ctx, roomID, func(ext *pbmeetingext.MeetingMetadataExt) bool {
+		return s.removeRaisedHand(ext, userID)
+	})
+	if err != nil {
+		if errs.ErrRecordNotFound.Is(err) {
+			log.ZDebug(ctx, "room is closed, no need to lower the hand", "roomID", roomID, "userID", userID)
+			return
+		}
+		log.ZWarn(ctx, "lower the hand of the leaving participant failed", err, "roomID", roomID, "userID", userID)
+		return
+	}
+	if changed {
+		s.notifySpeakingQueue(ctx, roomID, userID, hands)
+	}
+}
+
+// notifySpeakingQueue send the whole speaking queue to all the participants in the room
+func (s *meetingServer) notifySpeakingQueue(ctx context.Context, roomID, operatorUserID string, hands []*pbmeetingext.RaisedHand) {
+	sendData := &pbmeetingext.NotifyMeetingExtData{
+		OperatorUserID: operatorUserID,
+		MessageType: &pbmeetingext.NotifyMeetingExtData_SpeakingQueueData{SpeakingQueueData: &pbmeetingext.SpeakingQueueData{
+			RaisedHands: hands,
+		}},
+	}
+	// the clients subscribing to the system topic only get the queue as well
+	if err := s.meetingRtc.SendRoomSystemExtData(ctx, roomID, nil, sendData); err != nil {
+		log.ZWarn(ctx, "send speaking queue data failed", err, "roomID", roomID)
+	}
+}
@@ -6,23 +6,43 @@ import (
 	"github.com/openimsdk/openmeeting-server/pkg/common/storage/cache/redis"
 	"github.com/openimsdk/openmeeting-server/pkg/common/storage/controller"
 	"github.com/openimsdk/openmeeting-server/pkg/common/storage/database/mgo"
+	"github.com/openimsdk/openmeeting-server/pkg/notification"
+	pbmeetingext "github.com/openimsdk/openmeeting-server/pkg/protocol/meetingext"
 	"github.com/openimsdk/openmeeting-server/pkg/rpcclient"
 	"github.com/openimsdk/openmeeting-server/pkg/rtc"
 	"github.com/openimsdk/openmeeting-server/pkg/rtc/livekit"
 	userfind "github.com/openimsdk/openmeeting-server/pkg/user"
+	"github.com/openimsdk/openmeeting-server/pkg/webhook"
 	pbmeeting "github.com/openimsdk/protocol/openmeeting/meeting"
 	"github.com/openimsdk/tools/db/mongoutil"
 	"github.com/openimsdk/tools/db/redisutil"
 	registry "github.com/openimsdk/tools/discovery"
 	"google.golang.org/grpc"
+	"sync"
 )
 
 type meetingServer struct {
-	meetingStorageHandler controller.Meeting
-	RegisterCenter        registry.SvcDiscoveryRegistry
-	meetingRtc            rtc.MeetingRtc
-	config                *Config
-	userRpc               *rpcclient.User
+	meetingStorageHandler     controller.Meeting
+	occurrenceStorageHandler  controller.Occurrence
+	calendarStorageHandler    controller.CalendarFeed
+	invitationStorageHandler  controller.Invitation
+	attendanceStorageHandler  controller.Attendance
+	waitingRoomStorageHandler controller.WaitingRoom
+	chatStorageHandler        controller.Chat
+	recordingStorageHandler   controller.Recording
+	dialInStorageHandler      controller.DialIn
+	presenceStorageHandler    controller.Presence
+	breakoutStorageHandler    controller.Breakout
+	stateStorageHandler       controller.MeetingState
+	notificationDispatcher    *notification.Dispatcher
+	webhookPublisher          webhook.Publisher
+	RegisterCenter            registry.SvcDiscoveryRegistry
+	meetingRtc                rtc.MeetingRtc
+	config                    *Config
+	userRpc                   *rpcclient.User
+	// sipTrunkID is the trunk of the dial-in numbers found or created by the server, see getSIPTrunkID
+	sipTrunkID   string
+	sipTrunkLock sync.Mutex
 }
 
 type Config struct {
@@ -48,9 +68,65 @@ func Start(ctx context.Context, config *Config, client registry.SvcDiscoveryRegi
 	if err != nil {
 		return err
 	}
+	occurrenceDB, err := mgo.NewOccurrenceMongo(mgoCli.GetDB())
+	if err != nil {
+		return err
+	}
+	overrideDB, err := mgo.NewMeetingOverrideMongo(mgoCli.GetDB())
+	if err != nil {
+		return err
+	}
+	calendarFeedDB, err := mgo.NewCalendarFeedMongo(mgoCli.GetDB())
+	if err != nil {
+		return err
+	}
+	invitationDB, err := mgo.NewInvitationMongo(mgoCli.GetDB())
+	if err != nil {
+		return err
+	}
+	attendanceDB, err := mgo.NewAttendanceMongo(mgoCli.GetDB())
+	if err != nil {
+		return err
+	}
+	hostChangeDB, err := mgo.NewHostChangeMongo(mgoCli.GetDB())
+	if err != nil {
+		return err
+	}
+	chatDB, err := mgo.NewChatMongo(mgoCli.GetDB())
+	if err != nil {
+		return err
+	}
+	recordingDB, err := mgo.NewRecordingMongo(mgoCli.GetDB())
+	if err != nil {
+		return err
+	}
+	dialInDB, err := mgo.NewDialInMongo(mgoCli.GetDB())
+	if err != nil {
+		return err
+	}
+	stateDB, err := mgo.NewMeetingStateMongo(mgoCli.GetDB())
+	if err != nil {
+		return err
+	}
 	meetingCache := redis.NewMeeting(rdb, meetingDB, redis.GetDefaultOpt())
 	database := controller.NewMeeting(meetingDB, meetingCache, mgoCli.GetTx())
-	meetingRtc := livekit.NewLiveKit(&config.Rtc)
+
+	// the publisher stays nil if the outbound webhooks are disabled
+	var webhookPublisher webhook.Publisher
+	if config.Rpc.Webhook.Enable {
+		subscriptionDB, err := mgo.NewWebhookSubscriptionMongo(mgoCli.GetDB())
+		if err != nil {
+			return err
+		}
+		deadLetterDB, err := mgo.NewWebhookDeadLetterMongo(mgoCli.GetDB())
+		if err != nil {
+			return err
+		}
+		deliverer := webhook.NewDeliverer(controller.NewWebhook(subscriptionDB, deadLetterDB), &config.Rpc.Webhook)
+		deliverer.Start(ctx)
+		webhookPublisher = deliverer
+	}
+	meetingRtc := livekit.NewLiveKit(&config.Rtc, webhookPublisher, redis.NewRoomLock(rdb))
 
 	user := userfind.NewMeeting(client, config.Share.RpcRegisterName.User)
 
@@ -58,12 +134,30 @@ func Start(ctx context.Context, config *Config, client registry.SvcDiscoveryRegi
 	userRpc := rpcclient.NewUser(user)
 
 	u := &meetingServer{
-		meetingStorageHandler: database,
-		RegisterCenter:        client,
-		config:                config,
-		meetingRtc:            meetingRtc,
-		userRpc:               userRpc,
+		meetingStorageHandler:     database,
+		occurrenceStorageHandler:  controller.NewOccurrence(occurrenceDB, overrideDB, mgoCli.GetTx()),
+		calendarStorageHandler:    controller.NewCalendarFeed(calendarFeedDB),
+		invitationStorageHandler:  controller.NewInvitation(invitationDB),
+		attendanceStorageHandler:  controller.NewAttendance(attendanceDB, hostChangeDB),
+		waitingRoomStorageHandler: controller.NewWaitingRoom(redis.NewWaitingRoom(rdb)),
+		chatStorageHandler:        controller.NewChat(chatDB),
+		recordingStorageHandler:   controller.NewRecording(recordingDB, redis.NewRecording(rdb)),
+		dialInStorageHandler:      controller.NewDialIn(dialInDB),
+		presenceStorageHandler:    controller.NewPresence(redis.NewPresence(rdb)),
+		breakoutStorageHandler:    controller.NewBreakout(redis.NewBreakout(rdb)),
+		stateStorageHandler:       controller.NewMeetingState(stateDB),
+		notificationDispatcher:    newNotificationDispatcher(&config.Rpc.Notification),
+		webhookPublisher:          webhookPublisher,
+		RegisterCenter:            client,
+		config:                    config,
+		meetingRtc:                meetingRtc,
+		userRpc:                   userRpc,
 	}
 	pbmeeting.RegisterMeetingServiceServer(server, u)
+	pbmeetingext.RegisterMeetingExtServiceServer(server, u)
+	u.notificationDispatcher.Start(ctx)
+	if config.Rpc.Scheduler.Enable {
+		go newMeetingScheduler(u).Run(ctx)
+	}
 	return nil
 }
@@ -0,0 +1,183 @@
+package meeting
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/constant"
+	"github.com/openimsdk/openmeeting-server/pkg/common/servererrs"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	pbmeetingext "github.com/openimsdk/openmeeting-server/pkg/protocol/meetingext"
+	pbuser "github.com/openimsdk/protocol/openmeeting/user"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/openimsdk/tools/utils/timeutil"
+)
+
+// AddMeetingInvitees invite the users to the meeting, only the creator or the host could do it
+func (s *meetingServer) AddMeetingInvitees(ctx context.Context, req *pbmeetingext.AddMeetingInviteesReq) (*pbmeetingext.AddMeetingInviteesResp, error) {
+	resp := &pbmeetingext.AddMeetingInviteesResp{}
+	info, err := s.takeInvitationMeeting(ctx, req.MeetingID, req.OperatorUserID)
+	if err != nil {
+		return resp, err
+	}
+	if info.Status == constant.Completed {
+		return resp, servererrs.ErrMeetingAlreadyCompleted.WrapMsg("meeting is already completed, can not invite anymore")
+	}
+	userIDs := datautil.Filter(datautil.Distinct(req.UserIDs), func(userID string) (string, bool) {
+		return userID, userID != "" && userID != info.CreatorUserID
+	})
+	if len(userIDs) == 0 {
+		return resp, errs.ErrArgs.WrapMsg("no user to invite")
+	}
+	// make sure all the invitees are registered users
+	if _, err := s.userRpc.GetUsersInfo(ctx, userIDs); err != nil {
+		return resp, err
+	}
+
+	now := timeutil.GetCurrentTimestampBySecond()
+	invitations := make([]*model.MeetingInvitation, 0, len(userIDs))
+	for _, userID := range userIDs {
+		invitations = append(invitations, &model.MeetingInvitation{
+			MeetingID:     req.MeetingID,
+			UserID:        userID,
+			InviterUserID: req.OperatorUserID,
+			Response:      constant.InvitationPending,
+			InviteTime:    now,
+		})
+	}
+	if err := s.invitationStorageHandler.CreateIfNotExist(ctx, invitations); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+// RemoveMeetingInvitees withdraw the invitations of the users, only the creator or the host could do it
+func (s *meetingServer) RemoveMeetingInvitees(ctx context.Context, req *pbmeetingext.RemoveMeetingInviteesReq) (*pbmeetingext.RemoveMeetingInviteesResp, error) {
+	resp := &pbmeetingext.RemoveMeetingInviteesResp{}
+	if _, err := s.takeInvitationMeeting(ctx, req.MeetingID, req.OperatorUserID); err != nil {
+		return resp, err
+	}
+	if err := s.invitationStorageHandler.Delete(ctx, req.MeetingID, req.UserIDs); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+// RespondMeetingInvitation set the RSVP of the invited user
+func (s *meetingServer) RespondMeetingInvitation(ctx context.Context, req *pbmeetingext.RespondMeetingInvitationReq) (*pbmeetingext.RespondMeetingInvitationResp, error) {
+	resp := &pbmeetingext.RespondMeetingInvitationResp{}
+	switch req.Response {
+	case constant.InvitationAccepted, constant.InvitationDeclined, constant.InvitationTentative:
+	default:
+		return resp, errs.ErrArgs.WrapMsg("not support for this response", "response", req.Response)
+	}
+	info, err := s.meetingStorageHandler.TakeWithError(ctx, req.MeetingID)
+	if err != nil {
+		return resp, errs.WrapMsg(err, "get meeting data failed")
+	}
+	if info.Status == constant.Completed {
+		return resp, servererrs.ErrMeetingAlreadyCompleted.WrapMsg("meeting is already completed, can not respond anymore")
+	}
+	err = s.invitationStorageHandler.UpdateResponse(ctx, req.MeetingID, req.UserID, req.Response, timeutil.GetCurrentTimestampBySecond())
+	if err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+// GetMeetingInvitees get the invitees of the meeting and their responses, only the users who could read the meeting get them
+func (s *meetingServer) GetMeetingInvitees(ctx context.Context, req *pbmeetingext.GetMeetingInviteesReq) (*pbmeetingext.GetMeetingInviteesResp, error) {
+	resp := &pbmeetingext.GetMeetingInviteesResp{}
+	info, err := s.meetingStorageHandler.TakeWithError(ctx, req.MeetingID)
+	if err != nil {
+		return resp, errs.WrapMsg(err, "get meeting data failed")
+	}
+	if err := s.checkMeetingReader(ctx, info, req.UserID); err != nil {
+		return resp, err
+	}
+	invitations, err := s.invitationStorageHandler.FindByMeetingID(ctx, req.MeetingID)
+	if err != nil {
+		return resp, err
+	}
+	userIDs := datautil.Slice(invitations, func(e *model.MeetingInvitation) string {
+		return e.UserID
+	})
+	users, err := s.userRpc.GetUsersInfo(ctx, userIDs)
+	if err != nil {
+		// the invitee list is still useful without the nicknames
+		log.ZWarn(ctx, "get invitees info failed", err, "meetingID", req.MeetingID)
+	}
+	userMap := datautil.SliceToMap(users, func(e *pbuser.UserInfo) string {
+		return e.UserID
+	})
+	for _, one := range invitations {
+		resp.Invitees = append(resp.Invitees, s.generateClientInvitee(one, userMap[one.UserID]))
+	}
+	return resp, nil
+}
+
+// GetInvitedMeetings get the meetings the user is invited to, filtered by the meeting status and the RSVP
+func (s *meetingServer) GetInvitedMeetings(ctx context.Context, req *pbmeetingext.GetInvitedMeetingsReq) (*pbmeetingext.GetInvitedMeetingsResp, error) {
+	resp := &pbmeetingext.GetInvitedMeetingsResp{}
+	invitations, err := s.invitationStorageHandler.FindByUserID(ctx, req.UserID, req.Responses)
+	if err != nil {
+		return resp, err
+	}
+	invitationMap := datautil.SliceToMap(invitations, func(e *model.MeetingInvitation) string {
+		return e.MeetingID
+	})
+	meetings, err := s.meetingStorageHandler.FindByMeetingIDs(ctx, datautil.Keys(invitationMap), req.Status)
+	if err != nil {
+		return resp, err
+	}
+	for _, one := range meetings {
+		detailSetting, err := s.getMeetingDetailSetting(ctx, one)
+		if err != nil {
+			continue
+		}
+		resp.Meetings = append(resp.Meetings, &pbmeetingext.InvitedMeeting{
+			MeetingDetail: detailSetting,
+			Invitation:    s.generateClientInvitee(invitationMap[one.MeetingID], nil),
+		})
+	}
+	return resp, nil
+}
+
+// findUserMeetings get the meetings created by the user and the ones the user is invited to unless declined
+func (s *meetingServer) findUserMeetings(ctx context.Context, userID string, status []string) ([]*model.MeetingInfo, error) {
+	meetings, err := s.meetingStorageHandler.FindByStatus(ctx, status, userID)
+	if err != nil {
+		return nil, err
+	}
+	invitations, err := s.invitationStorageHandler.FindByUserID(ctx, userID,
+		[]string{constant.InvitationPending, constant.InvitationAccepted, constant.InvitationTentative})
+	if err != nil {
+		return nil, err
+	}
+	createdIDs := datautil.SliceSetAny(meetings, func(e *model.MeetingInfo) string {
+		return e.MeetingID
+	})
+	var invitedIDs []string
+	for _, one := range invitations {
+		if _, ok := createdIDs[one.MeetingID]; !ok {
+			invitedIDs = append(invitedIDs, one.MeetingID)
+		}
+	}
+	invitedMeetings, err := s.meetingStorageHandler.FindByMeetingIDs(ctx, invitedIDs, status)
+	if err != nil {
+		return nil, err
+	}
+	return append(meetings, invitedMeetings...), nil
+}
+
+// takeInvitationMeeting get the meeting whose invitee list is managed by the user
+func (s *meetingServer) takeInvitationMeeting(ctx context.Context, meetingID, operatorUserID string) (*model.MeetingInfo, error) {
+	info, err := s.meetingStorageHandler.TakeWithError(ctx, meetingID)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "get meeting data failed")
+	}
+	if !s.checkMeetingPermission(ctx, info, operatorUserID, constant.PermissionManageMeeting) {
+		return nil, servererrs.ErrMeetingAuthCheck.WrapMsg("user did not have permission to manage the invitees of the meeting")
+	}
+	return info, nil
+}
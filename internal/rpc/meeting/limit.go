@@ -0,0 +1,165 @@
+package meeting
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/constant"
+	"github.com/openimsdk/openmeeting-server/pkg/common/servererrs"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	pbmeetingext "github.com/openimsdk/openmeeting-server/pkg/protocol/meetingext"
+	"github.com/openimsdk/openmeeting-server/pkg/rtc"
+	"github.com/openimsdk/openmeeting-server/pkg/webhook"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/timeutil"
+)
+
+// endTypeMaxDuration is the end type of the meeting ended by its maximum duration
+const endTypeMaxDuration = "MaxDurationType"
+
+// SetMeetingLimit set the limits of the meeting, only the creator or the host could do it
+func (s *meetingServer) SetMeetingLimit(ctx context.Context, req *pbmeetingext.SetMeetingLimitReq) (*pbmeetingext.SetMeetingLimitResp, error) {
+	resp := &pbmeetingext.SetMeetingLimitResp{}
+	info, err := s.meetingStorageHandler.TakeWithError(ctx, req.MeetingID)
+	if err != nil {
+		return resp, errs.WrapMsg(err, "get meeting data failed")
+	}
+	if info.Status == constant.Completed {
+		return resp, servererrs.ErrMeetingAlreadyCompleted.WrapMsg("meeting is already completed, can not update anymore")
+	}
+	if !s.checkMeetingPermission(ctx, info, req.UserID, constant.PermissionManageMeeting) {
+		return resp, servererrs.ErrMeetingAuthCheck.WrapMsg("user did not have permission to set the limit of the meeting")
+	}
+	updateData := map[string]any{}
+	if req.MaxParticipants != nil {
+		if req.MaxParticipants.Value < 0 {
+			return resp, errs.ErrArgs.WrapMsg("maxParticipants could not be negative")
+		}
+		updateData["max_participants"] = req.MaxParticipants.Value
+	}
+	if req.EmptyTimeout != nil {
+		if req.EmptyTimeout.Value < 0 {
+			return resp, errs.ErrArgs.WrapMsg("emptyTimeout could not be negative")
+		}
+		updateData["empty_timeout"] = req.EmptyTimeout.Value
+	}
+	if req.MaxDuration != nil {
+		if req.MaxDuration.Value < 0 {
+			return resp, errs.ErrArgs.WrapMsg("maxDuration could not be negative")
+		}
+		updateData["max_duration"] = req.MaxDuration.Value
+	}
+	if len(updateData) == 0 {
+		return resp, nil
+	}
+	if err := s.meetingStorageHandler.Update(ctx, req.MeetingID, updateData); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+func (s *meetingServer) GetMeetingLimit(ctx context.Context, req *pbmeetingext.GetMeetingLimitReq) (*pbmeetingext.GetMeetingLimitResp, error) {
+	resp := &pbmeetingext.GetMeetingLimitResp{}
+	info, err := s.meetingStorageHandler.TakeWithError(ctx, req.MeetingID)
+	if err != nil {
+		return resp, errs.WrapMsg(err, "get meeting data failed")
+	}
+	limit := s.getRoomLimit(info)
+	resp.Limit = &pbmeetingext.MeetingLimit{
+		MaxParticipants: info.MaxParticipants,
+		EmptyTimeout:    info.EmptyTimeout,
+		MaxDuration:     info.MaxDuration,
+	}
+	resp.EffectiveLimit = &pbmeetingext.MeetingLimit{
+		MaxParticipants: int32(limit.MaxParticipants),
+		EmptyTimeout:    int32(limit.EmptyTimeout),
+		MaxDuration:     s.getMaxDuration(info),
+	}
+	return resp, nil
+}
+
+// lowerLimit get the lower one of the meeting limit and the server limit, 0 means no limit
+func lowerLimit[T int32 | int64 | uint32](meetingLimit, serverLimit T) T {
+	if meetingLimit <= 0 {
+		return serverLimit
+	}
+	if serverLimit <= 0 {
+		return meetingLimit
+	}
+	return min(meetingLimit, serverLimit)
+}
+
+// getRoomLimit the zero values are left to the defaults of the rtc
+func (s *meetingServer) getRoomLimit(info *model.MeetingInfo) *rtc.RoomLimit {
+	return &rtc.RoomLimit{
+		MaxParticipants: lowerLimit(uint32(max(info.MaxParticipants, 0)), s.config.Rtc.MaxParticipants),
+		EmptyTimeout:    lowerLimit(uint32(max(info.EmptyTimeout, 0)), s.config.Rtc.EmptyTimeout),
+	}
+}
+
+func (s *meetingServer) getMaxDuration(info *model.MeetingInfo) int64 {
+	return lowerLimit(info.MaxDuration, s.config.Rtc.MaxDuration)
+}
+
+// checkMeetingFull refuse the user if the room reaches the participant cap, rejoining of the user in the room is allowed
+func (s *meetingServer) checkMeetingFull(ctx context.Context, info *model.MeetingInfo, userID string) error {
+	maxParticipants := s.getRoomLimit(info).MaxParticipants
+	if maxParticipants == 0 {
+		return nil
+	}
+	userIDs, err := s.meetingRtc.GetParticipantUserIDs(ctx, info.MeetingID)
+	if err != nil {
+		// the room is not opened yet
+		if errs.ErrRecordNotFound.Is(err) {
+			return nil
+		}
+		return errs.WrapMsg(err, "get participants failed", "meetingID", info.MeetingID)
+	}
+	count := uint32(0)
+	for _, one := range userIDs {
+		if one == userID {
+			return nil
+		}
+		count++
+	}
+	if count >= maxParticipants {
+		return servererrs.ErrMeetingFull.WrapMsg("meeting is full", "meetingID", info.MeetingID, "maxParticipants", maxParticipants)
+	}
+	return nil
+}
+
+// endOverdueMeetings end the meetings in progress longer than their maximum duration, the duration counts from the room opened,
+// only the meetings lasting for it since their start time are checked against the rtc server
+func (s *meetingServer) endOverdueMeetings(ctx context.Context) {
+	now := timeutil.GetCurrentTimestampBySecond()
+	meetings, err := s.meetingStorageHandler.FindOverdue(ctx, constant.InProgress, s.config.Rtc.MaxDuration, now)
+	if err != nil {
+		log.ZError(ctx, "find overdue meetings failed", err)
+		return
+	}
+	for _, info := range meetings {
+		maxDuration := s.getMaxDuration(info)
+		if maxDuration <= 0 {
+			continue
+		}
+		room, err := s.meetingRtc.GetRoom(ctx, info.MeetingID)
+		if err != nil {
+			continue
+		}
+		if now-room.CreationTime < maxDuration {
+			continue
+		}
+		log.ZInfo(ctx, "end the meeting reaching its maximum duration", "meetingID", info.MeetingID, "maxDuration", maxDuration)
+		if err := s.handleCompleteMeeting(ctx, info.MeetingID); err != nil {
+			continue
+		}
+		s.recordMeetingEnded(ctx, info.MeetingID)
+		if err := s.completeMeeting(ctx, info); err != nil {
+			log.ZError(ctx, "complete overdue meeting failed", err, "meetingID", info.MeetingID)
+		}
+		s.publishWebhookEvent(ctx, &webhook.Event{
+			Type:      webhook.EventMeetingEnded,
+			MeetingID: info.MeetingID,
+			Data:      map[string]string{"endType": endTypeMaxDuration},
+		})
+	}
+}
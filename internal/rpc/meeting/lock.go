@@ -0,0 +1,107 @@
+package meeting
+
+import (
+	"context"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/openimsdk/openmeeting-server/pkg/common/constant"
+	"github.com/openimsdk/openmeeting-server/pkg/common/servererrs"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	pbmeetingext "github.com/openimsdk/openmeeting-server/pkg/protocol/meetingext"
+	"github.com/openimsdk/openmeeting-server/pkg/rtc"
+	pbmeeting "github.com/openimsdk/protocol/openmeeting/meeting"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"strings"
+)
+
+// SetMeetingLock lock or unlock the meeting in progress, only the creator, the host or the co-hosts could do it
+func (s *meetingServer) SetMeetingLock(ctx context.Context, req *pbmeetingext.SetMeetingLockReq) (*pbmeetingext.SetMeetingLockResp, error) {
+	resp := &pbmeetingext.SetMeetingLockResp{}
+	metaData, ext, err := s.meetingRtc.GetRoomDataExt(ctx, req.MeetingID)
+	if err != nil {
+		return resp, errs.WrapMsg(err, "get room data failed, only the meeting in progress could be locked", "meetingID", req.MeetingID)
+	}
+	if !s.checkRoomPermission(metaData, ext, req.UserID, constant.PermissionLockMeeting) {
+		return resp, servererrs.ErrMeetingAuthCheck.WrapMsg("user did not have permission to lock the meeting")
+	}
+	if metaData.Detail.Setting.GetLockMeeting() == req.Locked {
+		return resp, nil
+	}
+	info, err := s.meetingStorageHandler.TakeWithError(ctx, req.MeetingID)
+	if err != nil {
+		return resp, errs.WrapMsg(err, "get meeting data failed")
+	}
+	// keep the setting stored in step, otherwise updating the meeting brings the previous lock back to the room
+	if err := s.updateLockSetting(ctx, info, req.Locked); err != nil {
+		return resp, err
+	}
+	err = s.meetingRtc.ModifyRoomData(ctx, req.MeetingID, func(data *rtc.RoomData) (bool, error) {
+		if data.MetaData.Detail.Setting == nil {
+			data.MetaData.Detail.Setting = &pbmeeting.MeetingSetting{}
+		}
+		data.MetaData.Detail.Setting.LockMeeting = req.Locked
+		return true, nil
+	})
+	if err != nil {
+		return resp, errs.WrapMsg(err, "update meta data failed")
+	}
+	s.notifyMeetingLock(ctx, req.MeetingID, req.UserID, req.Locked)
+	return resp, nil
+}
+
+func (s *meetingServer) updateLockSetting(ctx context.Context, info *model.MeetingInfo, locked bool) error {
+	setting := &pbmeeting.MeetingSetting{}
+	if info.Setting != "" {
+		unMarshal := jsonpb.Unmarshaler{}
+		if err := unMarshal.Unmarshal(strings.NewReader(info.Setting), setting); err != nil {
+			return errs.WrapMsg(err, "unMarshal db data failed")
+		}
+	}
+	if setting.LockMeeting == locked {
+		return nil
+	}
+	setting.LockMeeting = locked
+	marshal := jsonpb.Marshaler{}
+	settingString, err := marshal.MarshalToString(setting)
+	if err != nil {
+		return errs.WrapMsg(err, "marshal meeting setting failed")
+	}
+	return s.meetingStorageHandler.Update(ctx, info.MeetingID, map[string]any{"setting": settingString})
+}
+
+// clearMeetingLock unlock the meeting when its session ends, the lock does not carry over to the next session
+func (s *meetingServer) clearMeetingLock(ctx context.Context, meetingID string) {
+	info, err := s.meetingStorageHandler.TakeWithError(ctx, meetingID)
+	if err == nil {
+		err = s.updateLockSetting(ctx, info, false)
+	}
+	if err != nil {
+		log.ZWarn(ctx, "clear meeting lock failed", err, "meetingID", meetingID)
+	}
+}
+
+// checkMeetingLocked refuse the new participants of the locked meeting, the hosts and the users joined before could still join
+func (s *meetingServer) checkMeetingLocked(metaData *pbmeeting.MeetingMetadata, userID string) error {
+	if !metaData.Detail.Setting.GetLockMeeting() || s.checkRoomPermission(metaData, nil, userID, constant.PermissionLockMeeting) {
+		return nil
+	}
+	for _, personalData := range metaData.PersonalData {
+		if personalData.UserID == userID {
+			return nil
+		}
+	}
+	return servererrs.ErrMeetingLocked.WrapMsg("meeting is locked, please ask the host to unlock it", "meetingID", metaData.Detail.Info.SystemGenerated.MeetingID)
+}
+
+// notifyMeetingLock send the lock state to all the participants in the room
+func (s *meetingServer) notifyMeetingLock(ctx context.Context, roomID, operatorUserID string, locked bool) {
+	sendData := &pbmeetingext.NotifyMeetingExtData{
+		OperatorUserID: operatorUserID,
+		MessageType: &pbmeetingext.NotifyMeetingExtData_MeetingLockData{MeetingLockData: &pbmeetingext.MeetingLockData{
+			Locked: locked,
+		}},
+	}
+	if err := s.meetingRtc.SendRoomExtData(ctx, roomID, nil, sendData); err != nil {
+		log.ZWarn(ctx, "send meeting lock data failed", err, "roomID", roomID, "locked", locked)
+	}
+}
@@ -2,20 +2,23 @@ package meeting
 
 import (
 	"context"
-	"fmt"
 	"github.com/openimsdk/openmeeting-server/pkg/common/constant"
+	"github.com/openimsdk/openmeeting-server/pkg/common/recurrence"
 	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	pbmeetingext "github.com/openimsdk/openmeeting-server/pkg/protocol/meetingext"
+	"github.com/openimsdk/openmeeting-server/pkg/rtc"
 	pbmeeting "github.com/openimsdk/protocol/openmeeting/meeting"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
-	"github.com/openimsdk/tools/utils/stringutil"
 	"github.com/openimsdk/tools/utils/timeutil"
+	"github.com/teambition/rrule-go"
 	"time"
 )
 
 const (
-	video = "video"
-	audio = "audio"
+	video  = rtc.StreamTypeVideo
+	audio  = rtc.StreamTypeAudio
+	screen = rtc.StreamTypeScreen
 )
 
 func (s *meetingServer) setSelfPersonalSetting(ctx context.Context, metaData *pbmeeting.MeetingMetadata, req *pbmeeting.SetPersonalMeetingSettingsReq) error {
@@ -43,14 +46,17 @@ func (s *meetingServer) setSelfPersonalSetting(ctx context.Context, metaData *pb
 	if req.MicrophoneOnEntry != nil {
 		personalData.PersonalSetting.MicrophoneOnEntry = req.MicrophoneOnEntry.Value
 	}
-	toggle := s.checkUserEnableCamera(metaData.Detail.Setting, personalData)
-	if err := s.meetingRtc.ToggleMimeStream(ctx, req.MeetingID, req.UserID, video, !toggle); err != nil {
-		return errs.WrapMsg(err, "toggle camera stream failed")
+	// unmuting is left to the client of the user
+	if !s.checkUserEnableCamera(metaData.Detail.Setting, personalData) {
+		if err := s.muteStream(ctx, req.MeetingID, req.UserID, video); err != nil {
+			return errs.WrapMsg(err, "toggle camera stream failed")
+		}
 	}
 
-	toggle = s.checkUserEnableMicrophone(metaData.Detail.Setting, personalData)
-	if err := s.meetingRtc.ToggleMimeStream(ctx, req.MeetingID, req.UserID, audio, !toggle); err != nil {
-		return errs.WrapMsg(err, "toggle microphone stream failed")
+	if !s.checkUserEnableMicrophone(metaData.Detail.Setting, personalData) {
+		if err := s.muteStream(ctx, req.MeetingID, req.UserID, audio); err != nil {
+			return errs.WrapMsg(err, "toggle microphone stream failed")
+		}
 	}
 
 	// judge whether user need to change or not
@@ -62,9 +68,25 @@ func (s *meetingServer) setSelfPersonalSetting(ctx context.Context, metaData *pb
 		// no need update
 		return nil
 	}
-	if err := s.meetingRtc.UpdateMetaData(ctx, metaData); err != nil {
+	updateData := make(map[string]any)
+	if req.CameraOnEntry != nil {
+		updateData["camera_on_entry"] = req.CameraOnEntry.Value
+	}
+	if req.MicrophoneOnEntry != nil {
+		updateData["microphone_on_entry"] = req.MicrophoneOnEntry.Value
+	}
+	_, err := s.modifyPersonalData(ctx, req.MeetingID, req.UserID, func(personalData *pbmeeting.PersonalData) {
+		if req.CameraOnEntry != nil {
+			personalData.PersonalSetting.CameraOnEntry = req.CameraOnEntry.Value
+		}
+		if req.MicrophoneOnEntry != nil {
+			personalData.PersonalSetting.MicrophoneOnEntry = req.MicrophoneOnEntry.Value
+		}
+	})
+	if err != nil {
 		return errs.WrapMsg(err, "update meta data failed")
 	}
+	s.saveParticipantState(ctx, req.MeetingID, personalData, updateData)
 
 	return nil
 }
@@ -104,14 +126,14 @@ func (s *meetingServer) setParticipantPersonalSetting(ctx context.Context, metaD
 	)
 	if cameraOn = s.checkUserEnableCamera(metaData.Detail.Setting, personalData); !cameraOn {
 		// no need to care the scene that turning on the camera
-		if err := s.meetingRtc.ToggleMimeStream(ctx, req.MeetingID, req.UserID, video, true); err != nil {
+		if err := s.muteStream(ctx, req.MeetingID, req.UserID, video); err != nil {
 			return errs.WrapMsg(err, "toggle camera stream failed")
 		}
 	}
 
 	if microphoneOn = s.checkUserEnableMicrophone(metaData.Detail.Setting, personalData); !microphoneOn {
 		// no need to care the scene that turning on the microphone
-		if err := s.meetingRtc.ToggleMimeStream(ctx, req.MeetingID, req.UserID, audio, true); err != nil {
+		if err := s.muteStream(ctx, req.MeetingID, req.UserID, audio); err != nil {
 			return errs.WrapMsg(err, "toggle microphone stream failed")
 		}
 	}
@@ -124,9 +146,30 @@ func (s *meetingServer) setParticipantPersonalSetting(ctx context.Context, metaD
 		log.CInfo(ctx, "no need update meta data for set setting")
 		return nil
 	}
-	if err := s.meetingRtc.UpdateMetaData(ctx, metaData); err != nil {
+	updateData := make(map[string]any)
+	if req.CameraOnEntry != nil {
+		updateData["limit_camera_on_entry"] = req.CameraOnEntry.Value
+	}
+	if req.MicrophoneOnEntry != nil {
+		updateData["limit_microphone_on_entry"] = req.MicrophoneOnEntry.Value
+	}
+	metaData, err := s.modifyPersonalData(ctx, req.MeetingID, req.UserID, func(personalData *pbmeeting.PersonalData) {
+		if personalData.LimitSetting == nil {
+			personalData.LimitSetting = s.generateDefaultPersonalData(req.UserID).LimitSetting
+		}
+		if req.CameraOnEntry != nil {
+			personalData.LimitSetting.CameraOnEntry = req.CameraOnEntry.Value
+		}
+		if req.MicrophoneOnEntry != nil {
+			personalData.LimitSetting.MicrophoneOnEntry = req.MicrophoneOnEntry.Value
+		}
+	})
+	if err != nil {
 		return errs.WrapMsg(err, "update meta data failed")
 	}
+	s.saveParticipantState(ctx, req.MeetingID, personalData, updateData)
+	// the participant could not unmute the blocked streams by the client
+	s.updatePublishPermission(ctx, req.MeetingID, metaData, nil, req.UserID)
 
 	if err := s.sendStreamOperateData2Client(ctx, req.MeetingID, req.UserID, req.CameraOnEntry, req.MicrophoneOnEntry); err != nil {
 		return errs.WrapMsg(err, "send data failed")
@@ -135,12 +178,98 @@ func (s *meetingServer) setParticipantPersonalSetting(ctx context.Context, metaD
 	return nil
 }
 
-func (s *meetingServer) muteAllStream(ctx context.Context, roomID, streamType string, mute bool) (streamNotExistUserIDList []string, failedUserIDList []string, err error) {
+// modifyPersonalData apply modify on the personal data of the user in the latest room data,
+// the default personal data is added if the user has none, modify could be nil to only add it
+func (s *meetingServer) modifyPersonalData(ctx context.Context, roomID, userID string, modify func(personalData *pbmeeting.PersonalData)) (*pbmeeting.MeetingMetadata, error) {
+	var metaData *pbmeeting.MeetingMetadata
+	err := s.meetingRtc.ModifyRoomData(ctx, roomID, func(data *rtc.RoomData) (bool, error) {
+		metaData = data.MetaData
+		var personalData *pbmeeting.PersonalData
+		for _, one := range data.MetaData.PersonalData {
+			if one.GetUserID() == userID {
+				personalData = one
+				break
+			}
+		}
+		added := personalData == nil
+		if added {
+			personalData = s.generateDefaultPersonalData(userID)
+			data.MetaData.PersonalData = append(data.MetaData.PersonalData, personalData)
+		}
+		if modify != nil {
+			modify(personalData)
+		}
+		return added || modify != nil, nil
+	})
+	return metaData, err
+}
+
+// muteStream the participant who did not publish the stream is already muted
+func (s *meetingServer) muteStream(ctx context.Context, roomID, userID, streamType string) error {
+	if err := s.meetingRtc.ToggleMimeStream(ctx, roomID, userID, streamType, true); err != nil && !errs.ErrRecordNotFound.Is(err) {
+		return err
+	}
+	return nil
+}
+
+// getPublishStreamTypes the participant publishes the streams which are not blocked by the hosts and allowed by the role,
+// the meeting setting limits the camera and the microphone of the participants other than the hosts and the co-hosts
+func (s *meetingServer) getPublishStreamTypes(metaData *pbmeeting.MeetingMetadata, ext *pbmeetingext.MeetingMetadataExt, userID string) []string {
+	if s.getUserRole(metaData, ext, userID) == constant.RoleViewer {
+		return nil
+	}
+	limitSetting := s.generateDefaultPersonalData(userID).LimitSetting
+	for _, personalData := range metaData.PersonalData {
+		if personalData.GetUserID() == userID && personalData.LimitSetting != nil {
+			limitSetting = personalData.LimitSetting
+			break
+		}
+	}
+	enableCamera, unmuteMicrophone := true, true
+	if setting := metaData.Detail.GetSetting(); setting != nil && !s.checkRoomPermission(metaData, ext, userID, constant.PermissionMuteOthers) {
+		enableCamera, unmuteMicrophone = setting.CanParticipantsEnableCamera, setting.CanParticipantsUnmuteMicrophone
+	}
+	var streamTypes []string
+	if limitSetting.CameraOnEntry && enableCamera {
+		streamTypes = append(streamTypes, video)
+	}
+	if limitSetting.MicrophoneOnEntry && unmuteMicrophone {
+		streamTypes = append(streamTypes, audio)
+	}
+	if s.checkRoomPermission(metaData, ext, userID, constant.PermissionShareScreen) {
+		streamTypes = append(streamTypes, screen)
+	}
+	return streamTypes
+}
+
+// updatePublishPermission apply the streams the participants could publish to the rtc, ext is got from the rtc if it is nil
+func (s *meetingServer) updatePublishPermission(ctx context.Context, roomID string, metaData *pbmeeting.MeetingMetadata, ext *pbmeetingext.MeetingMetadataExt, userIDs ...string) {
+	if ext == nil {
+		var err error
+		if metaData, ext, err = s.meetingRtc.GetRoomDataExt(ctx, roomID); err != nil {
+			log.ZWarn(ctx, "get room data failed, skip updating the publish permission", err, "roomID", roomID)
+			return
+		}
+	}
+	for _, userID := range userIDs {
+		streamTypes := s.getPublishStreamTypes(metaData, ext, userID)
+		// the participant not in the room gets the permission when joining
+		if err := s.meetingRtc.UpdatePublishPermission(ctx, roomID, userID, streamTypes); err != nil && !errs.ErrRecordNotFound.Is(err) {
+			log.ZWarn(ctx, "update publish permission failed", err, "roomID", roomID, "userID", userID, "streamTypes", streamTypes)
+		}
+	}
+}
+
+// muteAllStream toggle the stream of all the participants except the operator
+func (s *meetingServer) muteAllStream(ctx context.Context, roomID, operatorUserID, streamType string, mute bool) (streamNotExistUserIDList []string, failedUserIDList []string, err error) {
 	participants, err := s.meetingRtc.ListParticipants(ctx, roomID)
 	if err != nil {
 		return nil, nil, errs.WrapMsg(err, "get participant list failed")
 	}
 	for _, v := range participants {
+		if v.Identity == operatorUserID {
+			continue
+		}
 		err := s.meetingRtc.ToggleMimeStream(ctx, roomID, v.Identity, streamType, mute)
 		if err != nil {
 			log.ZError(ctx, "muteAllStream failed", err)
@@ -156,118 +285,34 @@ func (s *meetingServer) muteAllStream(ctx context.Context, roomID, streamType st
 
 func (s *meetingServer) refreshNonRepeatMeeting(ctx context.Context, info *model.MeetingInfo) map[string]any {
 	updateData := map[string]any{}
-	nowTimestamp, err := timeutil.GetTimestampByTimezone(info.TimeZone)
-	if err != nil {
-		return updateData
-	}
+	nowTimestamp := timeutil.GetCurrentTimestampBySecond()
 	if info.StartTime+info.MeetingDuration < nowTimestamp {
 		updateData["status"] = constant.Completed
-	} else if info.StartTime+info.MeetingDuration < nowTimestamp && info.StartTime > nowTimestamp {
-		if info.Status == constant.InProgress {
-			return updateData
-		}
+	} else if info.StartTime <= nowTimestamp && info.Status != constant.InProgress {
 		updateData["status"] = constant.InProgress
 	}
 	return updateData
 }
 
+// IsTodayNeedMeeting check whether one occurrence of the meeting starts today in the meeting's time zone
 func (s *meetingServer) IsTodayNeedMeeting(ctx context.Context, info *model.MeetingInfo) bool {
-	currentTimestamp, err := timeutil.GetTimestampByTimezone(info.TimeZone)
+	loc, err := recurrence.Location(info)
 	if err != nil {
+		log.ZError(ctx, "error", err)
 		return false
 	}
-	switch info.RepeatType {
-	case constant.RepeatDaily:
-		// always return true
-		return true
-	case constant.RepeatWeekly:
-		// judge the day is the same day
-		sameDay, err := timeutil.IsSameWeekday(info.TimeZone, info.ScheduledTime)
-		if err != nil {
-			log.ZError(ctx, "error", err)
-			return false
-		}
-		return sameDay
-	case constant.RepeatMonth:
-		// judge the day is the same day of one month
-		sameDay, err := timeutil.IsSameDayOfMonth(info.TimeZone, info.ScheduledTime)
-		if err != nil {
-			log.ZError(ctx, "error", err)
-			return false
-		}
-		return sameDay
-	case constant.RepeatWeekDay:
-		// judge the day is weekday
-		return timeutil.IsWeekday(currentTimestamp)
-
-	case constant.RepeatCustom:
-		// customize repeat days
-		if len(info.RepeatDayOfWeek) > 0 {
-			startTime := time.Unix(currentTimestamp, 0)
-			if stringutil.IsContainInt32(int32(startTime.Day()), info.RepeatDayOfWeek) {
-				return true
-			}
-			return false
-		}
-		return processIntervalType(ctx, info)
-	default:
-		return false
-	}
-}
-
-func checkCycle(ctx context.Context, functions ...func() (bool, error)) bool {
-	for _, fn := range functions {
-		result, err := fn()
-		if err != nil {
-			log.ZError(ctx, "error", err)
-			return false
-		}
-		// return true only when all functions return true
-		if !result {
-			return false
-		}
+	now := time.Now().In(loc)
+	dayStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	dayEnd := dayStart.AddDate(0, 0, 1)
+	if !s.isRepeatMeeting(info) {
+		return info.ScheduledTime >= dayStart.Unix() && info.ScheduledTime < dayEnd.Unix()
 	}
-	return true
-}
-
-func checkNthMonthCycle(ctx context.Context, info *model.MeetingInfo) bool {
-	return checkCycle(ctx,
-		func() (bool, error) {
-			return timeutil.IsNthMonthCycle(info.TimeZone, info.ScheduledTime, int(info.Interval))
-		},
-		func() (bool, error) {
-			return timeutil.IsSameDayOfMonth(info.TimeZone, info.ScheduledTime)
-		})
-}
-
-func checkNthWeekCycle(ctx context.Context, info *model.MeetingInfo) bool {
-	return checkCycle(ctx,
-		func() (bool, error) {
-			return timeutil.IsNthWeekCycle(info.TimeZone, info.ScheduledTime, int(info.Interval))
-		},
-		func() (bool, error) {
-			return timeutil.IsSameWeekday(info.TimeZone, info.ScheduledTime)
-		})
-}
-
-func checkNthDayCycle(ctx context.Context, info *model.MeetingInfo) bool {
-	return checkCycle(ctx,
-		func() (bool, error) {
-			return timeutil.IsNthDayCycle(info.TimeZone, info.ScheduledTime, int(info.Interval))
-		})
-}
-
-func processIntervalType(ctx context.Context, info *model.MeetingInfo) bool {
-	switch info.UintType {
-	case constant.UnitTypeMonth:
-		return checkNthMonthCycle(ctx, info)
-	case constant.UnitTypeWeek:
-		return checkNthWeekCycle(ctx, info)
-	case constant.UnitTypeDay:
-		return checkNthDayCycle(ctx, info)
-	default:
+	set, err := recurrence.NewSet(info)
+	if err != nil {
+		log.ZError(ctx, "error", err)
 		return false
 	}
+	return len(set.Between(dayStart, dayEnd.Add(-time.Second), true)) > 0
 }
 
 //func (s *meetingServer) refreshRepeatMeeting(ctx context.Context, info *model.MeetingInfo) map[string]any {
@@ -310,118 +355,94 @@ func processIntervalType(ctx context.Context, info *model.MeetingInfo) bool {
 //	return updateData
 //}
 
-func (s *meetingServer) refreshRepeatMeeting(ctx context.Context, info *model.MeetingInfo) map[string]any {
+func (s *meetingServer) refreshRepeatMeeting(ctx context.Context, info *model.MeetingInfo, overrides map[int64]*model.MeetingOverride) map[string]any {
 	updateData := map[string]any{}
-	// get current timestamp
-	nowTimestamp := timeutil.GetCurrentTimestampBySecond()
-	if info.EndDate > 0 && info.EndDate < nowTimestamp {
-		updateData["status"] = constant.Completed
+	now := time.Now()
+	if s.liveOccurrenceStartTime(ctx, info, overrides, now) > 0 {
+		if info.Status != constant.InProgress {
+			updateData["status"] = constant.InProgress
+		}
 		return updateData
 	}
-
-	if !s.IsTodayNeedMeeting(ctx, info) {
+	if next, _ := s.nextOccurrenceAfter(ctx, info, now, overrides); next == 0 {
+		updateData["status"] = constant.Completed
+	} else if info.Status != constant.Scheduled {
 		updateData["status"] = constant.Scheduled
-		nextTime := s.nextMeetingTimestamp(ctx, info)
-		if nextTime == 0 {
-			updateData["status"] = constant.Completed
-		}
-		return updateData
 	}
+	return updateData
+}
 
-	startTime := s.GetDayTimestamp(info.ScheduledTime)
-	now := s.GetDayTimestamp(nowTimestamp)
-	if startTime+info.MeetingDuration < now {
-		updateData["status"] = constant.Scheduled
-	} else if startTime+info.MeetingDuration < now && startTime > now {
-		if info.Status == constant.InProgress {
-			return updateData
+// liveOccurrenceStartTime returns the start time of the occurrence planned to be in progress at now,
+// 0 means no occurrence is, the cancelled occurrences are skipped.
+func (s *meetingServer) liveOccurrenceStartTime(ctx context.Context, info *model.MeetingInfo, overrides map[int64]*model.MeetingOverride, now time.Time) int64 {
+	maxDuration := info.MeetingDuration
+	for _, override := range overrides {
+		maxDuration = max(maxDuration, override.MeetingDuration)
+	}
+	// the occurrence which starts within the longest meeting duration could be the one in progress
+	after := now.Add(-time.Duration(maxDuration) * time.Second)
+	for {
+		startTime, duration := s.nextOccurrenceAfter(ctx, info, after, overrides)
+		if startTime == 0 || startTime > now.Unix() {
+			return 0
 		}
-		updateData["status"] = constant.InProgress
+		if startTime+duration > now.Unix() {
+			return startTime
+		}
+		after = time.Unix(startTime, 0)
 	}
-
-	return updateData
 }
 
 func (s *meetingServer) GetDayTimestamp(timestamp int64) int64 {
 	return timestamp - timeutil.GetCurDayZeroTimestamp()
 }
 
-func (s *meetingServer) isValidDay(day time.Weekday, days []int32) bool {
-	for _, d := range days {
-		if int32(day) == d {
-			return true
-		}
-	}
-	return false
+func (s *meetingServer) nextMeetingTimestamp(ctx context.Context, info *model.MeetingInfo) int64 {
+	return s.nextMeetingTimestampAfter(ctx, info, time.Now())
 }
 
-func (s *meetingServer) nextMeetingTimestamp(ctx context.Context, info *model.MeetingInfo) int64 {
-	loc, err := time.LoadLocation(info.TimeZone)
+// nextMeetingTimestampAfter returns the start timestamp of the first occurrence later than the given time
+// with the occurrence overrides honored, 0 means there is no more occurrence.
+func (s *meetingServer) nextMeetingTimestampAfter(ctx context.Context, info *model.MeetingInfo, after time.Time) int64 {
+	startTime, _ := s.nextOccurrenceAfter(ctx, info, after, s.getMeetingOverrides(ctx, info))
+	return startTime
+}
+
+// nextSeriesTimestampAfter returns the start timestamp generated by the repetition later than the given time,
+// 0 means there is no more occurrence. The repetition is evaluated in the meeting's time zone.
+func (s *meetingServer) nextSeriesTimestampAfter(ctx context.Context, info *model.MeetingInfo, after time.Time) int64 {
+	return s.nextTimestampInSeries(info, s.meetingSeries(ctx, info), after)
+}
+
+// meetingSeries builds the recurrence set of the repeating meeting once for walking through its occurrences,
+// nil is returned if the meeting does not repeat or its recurrence is invalid.
+func (s *meetingServer) meetingSeries(ctx context.Context, info *model.MeetingInfo) *rrule.Set {
+	if !s.isRepeatMeeting(info) {
+		return nil
+	}
+	set, err := recurrence.NewSet(info)
 	if err != nil {
-		log.ZError(ctx, "load location failed", err, "timezone invalid", info.TimeZone)
-		fmt.Println("Unable to parse timezone:", err)
-		return 0
+		log.ZError(ctx, "build meeting recurrence failed", err, "meetingID", info.MeetingID)
+		return nil
 	}
+	return set
+}
 
-	scheduledTime := time.Unix(info.ScheduledTime, 0).In(loc)
-	now := time.Now().In(loc)
-
-	if info.RepeatType == constant.NoneRepeat {
-		if now.Before(scheduledTime) {
-			return scheduledTime.Unix()
+// nextTimestampInSeries is nextSeriesTimestampAfter with the recurrence set built by meetingSeries.
+func (s *meetingServer) nextTimestampInSeries(info *model.MeetingInfo, series *rrule.Set, after time.Time) int64 {
+	if !s.isRepeatMeeting(info) {
+		if after.Unix() < info.ScheduledTime {
+			return info.ScheduledTime
 		}
 		return 0
 	}
-
-	if info.EndDate > 0 && now.After(time.Unix(info.EndDate, 0).In(loc)) {
+	if series == nil || (info.EndDate > 0 && after.Unix() > info.EndDate) {
 		return 0
 	}
-
-	var nextTime time.Time
-
-	if now.After(scheduledTime) {
-		switch info.RepeatType {
-		case constant.RepeatDaily:
-			for nextTime = scheduledTime; !now.Before(nextTime); nextTime = nextTime.AddDate(0, 0, 1) {
-			}
-		case constant.RepeatWeekly:
-			for nextTime = scheduledTime; !now.Before(nextTime); nextTime = nextTime.AddDate(0, 0, 7) {
-			}
-		case constant.RepeatWeekDay:
-			nextTime = scheduledTime
-			for !now.Before(nextTime) || nextTime.Weekday() == time.Saturday || nextTime.Weekday() == time.Sunday {
-				nextTime = nextTime.AddDate(0, 0, 1)
-			}
-		case constant.RepeatMonth:
-			for nextTime = scheduledTime; !now.Before(nextTime); nextTime = nextTime.AddDate(0, 1, 0) {
-			}
-		case constant.RepeatCustom:
-			nextTime = scheduledTime
-			interval := int(info.Interval)
-			for !now.Before(nextTime) {
-				switch info.UintType {
-				case constant.UnitTypeDay:
-					nextTime = nextTime.AddDate(0, 0, interval)
-				case constant.UnitTypeWeek:
-					nextTime = nextTime.AddDate(0, 0, interval*7)
-				case constant.UnitTypeMonth:
-					nextTime = nextTime.AddDate(0, interval, 0)
-				}
-				if len(info.RepeatDayOfWeek) > 0 {
-					for !s.isValidDay(nextTime.Weekday(), info.RepeatDayOfWeek) {
-						nextTime = nextTime.AddDate(0, 0, 1)
-					}
-				}
-			}
-		}
-	} else {
-		nextTime = scheduledTime
-	}
-
-	if info.EndDate > 0 && nextTime.After(time.Unix(info.EndDate, 0).In(loc)) {
+	nextTime := series.After(after, false)
+	if nextTime.IsZero() || (info.EndDate > 0 && nextTime.Unix() > info.EndDate) {
 		return 0
 	}
-
 	return nextTime.Unix()
 }
 
@@ -431,21 +452,39 @@ func (s *meetingServer) refreshMeetingStatus(ctx context.Context) {
 		log.ZError(ctx, "find meetings failed", err)
 		return
 	}
+	overrides := s.findMeetingsOverrides(ctx, meetings)
 	for _, one := range meetings {
-		updateData := map[string]any{}
+		// the lease is lost, the new leader goes on with the rest
+		if ctx.Err() != nil {
+			log.ZWarn(ctx, "refresh meeting status stopped", ctx.Err())
+			return
+		}
+		var updateData map[string]any
 		if one.RepeatType == constant.NoneRepeat || one.RepeatType == "" {
 			updateData = s.refreshNonRepeatMeeting(ctx, one)
 		} else {
-			updateData = s.refreshRepeatMeeting(ctx, one)
+			updateData = s.refreshRepeatMeeting(ctx, one, overrides[one.MeetingID])
 		}
-		if len(updateData) > 0 {
-			if updateData["status"] == constant.Completed {
-				_ = s.handleCompleteMeeting(ctx, one.MeetingID)
-			}
-			if err := s.meetingStorageHandler.Update(ctx, one.MeetingID, updateData); err != nil {
-				log.ZError(ctx, "update meeting status failed", err)
+		if len(updateData) == 0 {
+			continue
+		}
+		log.ZDebug(ctx, "refresh meeting status", "meetingID", one.MeetingID, "from", one.Status, "to", updateData["status"])
+		status, _ := updateData["status"].(string)
+		// the room is kept for the occurrence running over, the empty timeout closes it.
+		// the completed meeting keeps its status until the room is closed, the next refresh retries.
+		if status == constant.Completed {
+			if err := s.closeRoomIfExist(ctx, one.MeetingID); err != nil {
+				log.ZError(ctx, "close the room of the completed meeting failed", err, "meetingID", one.MeetingID)
+				continue
 			}
 		}
+		if err := s.meetingStorageHandler.Update(ctx, one.MeetingID, updateData); err != nil {
+			log.ZError(ctx, "update meeting status failed", err, "meetingID", one.MeetingID)
+			continue
+		}
+		if one.Status == constant.InProgress || status == constant.Completed {
+			s.endMeetingSession(ctx, one.MeetingID, status)
+		}
 	}
 }
 
@@ -457,10 +496,29 @@ func (s *meetingServer) checkCanStartMeeting(info *model.MeetingInfo) bool {
 	return true
 }
 
+// closeRoomIfExist close the room of the meeting if it is still open, the room could be opened by joining
+// the meeting which is still scheduled.
+func (s *meetingServer) closeRoomIfExist(ctx context.Context, meetingID string) error {
+	if _, err := s.meetingRtc.RoomIsExist(ctx, meetingID); err != nil {
+		if errs.ErrRecordNotFound.Is(err) {
+			return nil
+		}
+		return err
+	}
+	return s.handleCompleteMeeting(ctx, meetingID)
+}
+
+// handleCompleteMeeting close the room of the meeting, the live stream and the dial-in are stopped before
 func (s *meetingServer) handleCompleteMeeting(ctx context.Context, meetingID string) error {
+	s.stopLiveStream(ctx, meetingID)
+	s.closeDialIn(ctx, meetingID, false)
+	if err := s.closeBreakoutRooms(ctx, meetingID, ""); err != nil {
+		log.ZWarn(ctx, "close breakout rooms of the completed meeting failed", err, "meetingID", meetingID)
+	}
 	if err := s.meetingRtc.CloseRoom(ctx, meetingID); err != nil {
 		log.ZError(ctx, "handle complete meeting close room failed", err, "meetingID", meetingID)
 		return err
 	}
+	s.closePresence(ctx, meetingID)
 	return nil
 }
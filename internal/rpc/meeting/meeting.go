@@ -6,21 +6,40 @@ import (
 	"errors"
 	"github.com/openimsdk/openmeeting-server/pkg/common"
 	"github.com/openimsdk/openmeeting-server/pkg/common/constant"
+	"github.com/openimsdk/openmeeting-server/pkg/common/recurrence"
 	"github.com/openimsdk/openmeeting-server/pkg/common/servererrs"
 	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	"github.com/openimsdk/openmeeting-server/pkg/notification"
+	"github.com/openimsdk/openmeeting-server/pkg/rtc"
+	"github.com/openimsdk/openmeeting-server/pkg/webhook"
 	sysConstant "github.com/openimsdk/protocol/constant"
 	pbmeeting "github.com/openimsdk/protocol/openmeeting/meeting"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
 )
 
 // BookMeeting Implement the MeetingServiceServer interface
 func (s *meetingServer) BookMeeting(ctx context.Context, req *pbmeeting.BookMeetingReq) (*pbmeeting.BookMeetingResp, error) {
 	resp := &pbmeeting.BookMeetingResp{}
+	if req.RepeatInfo != nil && req.RepeatInfo.RepeatType == constant.RepeatRRule {
+		return resp, errs.ErrArgs.WrapMsg("repeat type should carry the rrule, e.g., RRULE:FREQ=WEEKLY;BYDAY=MO")
+	}
 	meetingDBInfo, err := s.generateMeetingDBData4Booking(ctx, req)
 	if err != nil {
 		return resp, errs.WrapMsg(err, "generate meeting data failed")
 	}
+	if req.RepeatInfo != nil && recurrence.IsRecurrence(req.RepeatInfo.RepeatType) {
+		if _, err := setRepeatTypeRecurrence(meetingDBInfo, req.RepeatInfo.RepeatType); err != nil {
+			return resp, err
+		}
+	}
+	return s.createBookedMeeting(ctx, meetingDBInfo)
+}
+
+// createBookedMeeting saves the booked meeting and generates its upcoming occurrences
+func (s *meetingServer) createBookedMeeting(ctx context.Context, meetingDBInfo *model.MeetingInfo) (*pbmeeting.BookMeetingResp, error) {
+	resp := &pbmeeting.BookMeetingResp{}
 	metaData, err := s.generateMeetingMetaData(ctx, meetingDBInfo)
 	if err != nil {
 		return resp, errs.WrapMsg(err, "generate meeting meta data failed")
@@ -30,6 +49,12 @@ func (s *meetingServer) BookMeeting(ctx context.Context, req *pbmeeting.BookMeet
 	if err != nil {
 		return resp, err
 	}
+	// the meeting just booked has no override yet
+	if err := s.syncMeetingOccurrences(ctx, meetingDBInfo, nil); err != nil {
+		// the scheduler generates the missing occurrences in the next round
+		log.ZError(ctx, "generate meeting occurrences failed", err, "meetingID", meetingDBInfo.MeetingID)
+	}
+	s.notifyMeetingEvent(ctx, notification.EventMeetingBooked, meetingDBInfo, nil, "")
 	resp.Detail = metaData.Detail
 	return resp, nil
 }
@@ -37,7 +62,7 @@ func (s *meetingServer) BookMeeting(ctx context.Context, req *pbmeeting.BookMeet
 func (s *meetingServer) CreateImmediateMeeting(ctx context.Context, req *pbmeeting.CreateImmediateMeetingReq) (*pbmeeting.CreateImmediateMeetingResp, error) {
 	resp := &pbmeeting.CreateImmediateMeetingResp{}
 
-	inMeeting, err := s.checkUserInMeeting(ctx, req.CreatorUserID)
+	inMeeting, err := s.checkUserInMeeting(ctx, req.CreatorUserID, "")
 	if err != nil {
 		return resp, errs.WrapMsg(err, "create meeting failed")
 	}
@@ -61,7 +86,7 @@ func (s *meetingServer) CreateImmediateMeeting(ctx context.Context, req *pbmeeti
 	}
 	participantMetaData := s.generateParticipantMetaData(userInfo)
 
-	_, token, liveUrl, err := s.meetingRtc.CreateRoom(ctx, meetingDBInfo.MeetingID, req.CreatorUserID, metaData, participantMetaData, s.userRpc)
+	_, token, liveUrl, err := s.meetingRtc.CreateRoom(ctx, meetingDBInfo.MeetingID, req.CreatorUserID, metaData, participantMetaData, s.getRoomLimit(meetingDBInfo), s.userRpc)
 	if err != nil {
 		return resp, err
 	}
@@ -70,11 +95,15 @@ func (s *meetingServer) CreateImmediateMeeting(ctx context.Context, req *pbmeeti
 	if err != nil {
 		return resp, err
 	}
+	if err := s.createImmediateOccurrence(ctx, meetingDBInfo); err != nil {
+		log.ZError(ctx, "create immediate meeting occurrence failed", err, "meetingID", meetingDBInfo.MeetingID)
+	}
 
 	// create meeting meta data
-	if err := s.meetingRtc.UpdateMetaData(ctx, metaData); err != nil {
+	if err := s.meetingRtc.InitRoomData(ctx, meetingDBInfo.MeetingID, metaData); err != nil {
 		return resp, err
 	}
+	s.joinPresence(ctx, meetingDBInfo.MeetingID, req.CreatorUserID)
 
 	resp.Detail = metaData.Detail
 	resp.LiveKit = &pbmeeting.LiveKit{
@@ -95,8 +124,11 @@ func (s *meetingServer) JoinMeeting(ctx context.Context, req *pbmeeting.JoinMeet
 	if err != nil {
 		return resp, errs.WrapMsg(err, "get meeting data failed")
 	}
+	if s.isCurrentOccurrenceCancelled(ctx, dbInfo) {
+		return resp, servererrs.ErrMeetingCancelled.WrapMsg("the current occurrence of the meeting is cancelled", "meetingID", req.MeetingID)
+	}
 
-	inMeeting, err := s.checkUserInMeeting(ctx, req.UserID)
+	inMeeting, err := s.checkUserInMeeting(ctx, req.UserID, req.MeetingID)
 	if err != nil {
 		return resp, errs.WrapMsg(err, "join meeting failed")
 	}
@@ -119,6 +151,25 @@ func (s *meetingServer) JoinMeeting(ctx context.Context, req *pbmeeting.JoinMeet
 		if err != nil {
 			return resp, errs.WrapMsg(err, "generate meeting meta data failed")
 		}
+		s.applyCurrentOverrideDetail(ctx, dbInfo, metaData.Detail)
+		// the room is created again in the same session, e.g., the rtc server restarted
+		if dbInfo.Status == constant.InProgress {
+			if err := s.applyMeetingState(ctx, req.MeetingID, metaData); err != nil {
+				return resp, err
+			}
+		}
+		if !s.checkAdmitted(ctx, dbInfo, req.UserID) {
+			if err := s.checkMeetingLocked(metaData, req.UserID); err != nil {
+				return resp, err
+			}
+			if err := s.enterWaitingRoom(ctx, dbInfo, metaData, userInfo); err != nil {
+				return resp, err
+			}
+		}
+		// the room may be opened by others meanwhile
+		if err := s.checkMeetingFull(ctx, dbInfo, req.UserID); err != nil {
+			return resp, err
+		}
 		participantMetaData := s.generateParticipantMetaData(userInfo)
 		if ps, err := s.meetingRtc.ListParticipants(ctx, req.MeetingID); err != nil {
 			for _, p := range ps {
@@ -128,10 +179,17 @@ func (s *meetingServer) JoinMeeting(ctx context.Context, req *pbmeeting.JoinMeet
 				}
 			}
 		}
-		_, token, liveUrl, err := s.meetingRtc.CreateRoom(ctx, dbInfo.MeetingID, userInfo.UserID, metaData, participantMetaData, s.userRpc)
+		_, token, liveUrl, err := s.meetingRtc.CreateRoom(ctx, dbInfo.MeetingID, userInfo.UserID, metaData, participantMetaData, s.getRoomLimit(dbInfo), s.userRpc)
 		if err != nil {
 			return resp, err
 		}
+		if dbInfo.Status == constant.InProgress {
+			s.applyRolesState(ctx, req.MeetingID)
+		}
+		s.startCurrentOccurrence(ctx, req.MeetingID)
+		s.addOccurrenceParticipant(ctx, req.MeetingID, req.UserID)
+		s.leaveWaitingRoom(ctx, dbInfo, req.UserID)
+		s.joinPresence(ctx, req.MeetingID, req.UserID)
 
 		resp.LiveKit = &pbmeeting.LiveKit{
 			Token: token,
@@ -145,6 +203,14 @@ func (s *meetingServer) JoinMeeting(ctx context.Context, req *pbmeeting.JoinMeet
 			if err != nil {
 				return resp, errs.WrapMsg(err, "generate meeting meta data failed")
 			}
+			metaData.Detail.Info.SystemGenerated.MeetingID = req.MeetingID
+			if err := s.applyMeetingState(ctx, req.MeetingID, metaData); err != nil {
+				return resp, err
+			}
+			if err := s.meetingRtc.InitRoomData(ctx, req.MeetingID, metaData); err != nil {
+				return resp, errs.WrapMsg(err, "init meta data failed")
+			}
+			s.applyRolesState(ctx, req.MeetingID)
 		} else {
 			if err := json.Unmarshal([]byte(room.Metadata), metaData); err != nil {
 				log.ZError(ctx, "Unmarshal failed roomId:", err)
@@ -153,10 +219,24 @@ func (s *meetingServer) JoinMeeting(ctx context.Context, req *pbmeeting.JoinMeet
 		}
 	}
 
-	if req.UserID != s.getHostUserID(metaData) && req.UserID != s.getCreatorUserID(metaData) &&
+	// the admitted user has passed the password check before waiting
+	admitted := s.checkAdmitted(ctx, dbInfo, req.UserID)
+	if !admitted && req.UserID != s.getHostUserID(metaData) && req.UserID != s.getCreatorUserID(metaData) &&
 		req.Password != metaData.Detail.Info.CreatorDefinedMeeting.Password {
 		return resp, servererrs.ErrMeetingPasswordNotMatch.WrapMsg("meeting password not match, please check and try again!")
 	}
+	// the admitted user is let in by the hosts even if the meeting is locked after that
+	if !admitted {
+		if err := s.checkMeetingLocked(metaData, req.UserID); err != nil {
+			return resp, err
+		}
+		if err := s.enterWaitingRoom(ctx, dbInfo, metaData, userInfo); err != nil {
+			return resp, err
+		}
+	}
+	if err := s.checkMeetingFull(ctx, dbInfo, req.UserID); err != nil {
+		return resp, err
+	}
 
 	metaData.Detail.Info.SystemGenerated.MeetingID = req.MeetingID
 	participantMetaData := s.generateParticipantMetaData(userInfo)
@@ -165,21 +245,13 @@ func (s *meetingServer) JoinMeeting(ctx context.Context, req *pbmeeting.JoinMeet
 		return resp, errs.WrapMsg(err, "get join token failed")
 	}
 
-	// update meta data to liveKit
-	found := false
-	for _, personalData := range metaData.PersonalData {
-		if personalData.UserID == req.UserID {
-			found = true
-			break
-		}
-	}
-	if !found {
-		personalData := s.generateDefaultPersonalData(req.UserID)
-		metaData.PersonalData = append(metaData.PersonalData, personalData)
-	}
-	if err := s.meetingRtc.UpdateMetaData(ctx, metaData); err != nil {
+	// the personal data of the user is added to the latest meta data, which others may update meanwhile
+	if _, err := s.modifyPersonalData(ctx, req.MeetingID, req.UserID, nil); err != nil {
 		return resp, errs.WrapMsg(err, "update meta data failed")
 	}
+	s.addOccurrenceParticipant(ctx, req.MeetingID, req.UserID)
+	s.leaveWaitingRoom(ctx, dbInfo, req.UserID)
+	s.joinPresence(ctx, req.MeetingID, req.UserID)
 	resp.LiveKit = &pbmeeting.LiveKit{
 		Token: token,
 		Url:   liveUrl,
@@ -189,6 +261,15 @@ func (s *meetingServer) JoinMeeting(ctx context.Context, req *pbmeeting.JoinMeet
 
 func (s *meetingServer) GetMeetingToken(ctx context.Context, req *pbmeeting.GetMeetingTokenReq) (*pbmeeting.GetMeetingTokenResp, error) {
 	resp := &pbmeeting.GetMeetingTokenResp{}
+	// the token is refreshed for the user in the meeting only, others should go through JoinMeeting,
+	// where the password, the waiting room, the lock and the participant limit are checked
+	present, err := s.checkUserPresent(ctx, req.UserID, req.MeetingID)
+	if err != nil {
+		return resp, err
+	}
+	if !present {
+		return resp, servererrs.ErrMeetingAuthCheck.WrapMsg("user is not in the meeting, join the meeting first", "meetingID", req.MeetingID)
+	}
 	userInfo, err := s.userRpc.GetUserInfo(ctx, req.UserID)
 	if err != nil {
 		return resp, errs.WrapMsg(err, "get user info failed")
@@ -215,6 +296,8 @@ func (s *meetingServer) LeaveMeeting(ctx context.Context, req *pbmeeting.LeaveMe
 	if err := s.meetingRtc.RemoveParticipant(ctx, req.MeetingID, req.UserID); err != nil {
 		return resp, err
 	}
+	s.leavePresence(ctx, req.MeetingID, []string{req.UserID})
+	s.recordLeave(ctx, req.MeetingID, []string{req.UserID}, constant.LeaveReasonLeft)
 
 	return resp, nil
 }
@@ -226,46 +309,80 @@ func (s *meetingServer) EndMeeting(ctx context.Context, req *pbmeeting.EndMeetin
 		return resp, errs.WrapMsg(err, "get meeting data failed")
 	}
 
+	// the creator still can end the meeting if the room is not found
+	if !s.checkMeetingPermission(ctx, dbInfo, req.UserID, constant.PermissionEndMeeting) {
+		return resp, servererrs.ErrMeetingAuthCheck.WrapMsg("user did not have permission to end somebody's meeting")
+	}
 	hostUserID := dbInfo.CreatorUserID
-	metaData, err := s.meetingRtc.GetRoomData(ctx, req.MeetingID)
-	if err != nil {
+	if metaData, err := s.meetingRtc.GetRoomData(ctx, req.MeetingID); err != nil {
 		log.ZDebug(ctx, "get room failed still can end meeting", "roomID", req.MeetingID)
 	} else {
 		hostUserID = metaData.Detail.Info.CreatorDefinedMeeting.HostUserID
 	}
-	if !s.checkAuthPermission(dbInfo.CreatorUserID, hostUserID, req.UserID) {
-		return resp, servererrs.ErrMeetingAuthCheck.WrapMsg("user did not have permission to end somebody's meeting")
-	}
-	// change status to completed
-	status := constant.Completed
-	// if we have next meeting schedule
-	if s.nextMeetingTimestamp(ctx, dbInfo) > 0 {
-		status = constant.Scheduled
-	}
-
-	updateData := map[string]any{
-		"status": status,
-	}
-	if err := s.meetingRtc.CloseRoom(ctx, req.MeetingID); err != nil {
+	if err := s.handleCompleteMeeting(ctx, req.MeetingID); err != nil {
 		return resp, err
 	}
+	s.recordMeetingEnded(ctx, req.MeetingID)
 	if req.EndType == pbmeeting.MeetingEndType_EndType {
-		if err := s.meetingStorageHandler.Update(ctx, req.MeetingID, updateData); err != nil {
+		if err := s.completeMeeting(ctx, dbInfo); err != nil {
 			return resp, err
 		}
 	} else if req.EndType == pbmeeting.MeetingEndType_CancelType {
 		if err := s.meetingStorageHandler.Delete(ctx, req.MeetingID); err != nil {
 			return resp, err
 		}
+		// notify before the invitations are deleted, the recipients are resolved from them
+		s.notifyMeetingEvent(ctx, notification.EventMeetingCancelled, dbInfo, nil, hostUserID)
+		if err := s.occurrenceStorageHandler.DeleteByMeetingID(ctx, req.MeetingID); err != nil {
+			log.ZError(ctx, "delete meeting occurrences failed", err, "meetingID", req.MeetingID)
+		}
+		if err := s.invitationStorageHandler.DeleteByMeetingID(ctx, req.MeetingID); err != nil {
+			log.ZError(ctx, "delete meeting invitations failed", err, "meetingID", req.MeetingID)
+		}
+		if err := s.chatStorageHandler.DeleteByMeetingID(ctx, req.MeetingID); err != nil {
+			log.ZError(ctx, "delete meeting chat messages failed", err, "meetingID", req.MeetingID)
+		}
+		s.closeDialIn(ctx, req.MeetingID, true)
 	} else {
 		return resp, errs.ErrArgs.WrapMsg("not support for this end type", "type:", req.EndType)
 	}
+	s.publishWebhookEvent(ctx, &webhook.Event{
+		Type:           webhook.EventMeetingEnded,
+		MeetingID:      req.MeetingID,
+		OperatorUserID: req.UserID,
+		Data:           map[string]string{"endType": req.EndType.String()},
+	})
 
 	return resp, nil
 }
 
+// completeMeeting marks the meeting completed when its room is closed and ends the running occurrence.
+func (s *meetingServer) completeMeeting(ctx context.Context, info *model.MeetingInfo) error {
+	// change status to completed
+	status := constant.Completed
+	// if we have next meeting schedule
+	if s.nextMeetingTimestamp(ctx, info) > 0 {
+		status = constant.Scheduled
+	}
+	if err := s.meetingStorageHandler.Update(ctx, info.MeetingID, map[string]any{"status": status}); err != nil {
+		return err
+	}
+	s.endMeetingSession(ctx, info.MeetingID, status)
+	return nil
+}
+
+// endMeetingSession clear what only lives for the session in progress, status is the one the meeting moves to
+func (s *meetingServer) endMeetingSession(ctx context.Context, meetingID, status string) {
+	s.endCurrentOccurrence(ctx, meetingID)
+	s.clearWaitingRoom(ctx, meetingID)
+	s.clearMeetingState(ctx, meetingID)
+	s.clearMeetingLock(ctx, meetingID)
+	if status == constant.Completed {
+		s.closeDialIn(ctx, meetingID, true)
+	}
+}
+
 func (s *meetingServer) GetMeetings(ctx context.Context, req *pbmeeting.GetMeetingsReq) (*pbmeeting.GetMeetingsResp, error) {
-	s.refreshMeetingStatus(ctx)
 	resp := &pbmeeting.GetMeetingsResp{}
 	meetings, err := s.meetingStorageHandler.FindByStatus(ctx, req.Status, req.UserID)
 	if err != nil {
@@ -312,6 +429,12 @@ func (s *meetingServer) UpdateMeeting(ctx context.Context, req *pbmeeting.Update
 		log.CInfo(ctx, "meeting is already completed, can not update anymore", "meetingID:", req.MeetingID)
 		return resp, servererrs.ErrMeetingAlreadyCompleted.WrapMsg("meeting is already completed, can not update anymore")
 	}
+	if !s.checkMeetingPermission(ctx, info, req.UpdatingUserID, constant.PermissionManageMeeting) {
+		return resp, servererrs.ErrMeetingAuthCheck.WrapMsg("user did not have permission to update the meeting")
+	}
+	if req.RepeatInfo != nil && req.RepeatInfo.RepeatType == constant.RepeatRRule && info.RRule == "" {
+		return resp, errs.ErrArgs.WrapMsg("repeat type should carry the rrule, e.g., RRULE:FREQ=WEEKLY;BYDAY=MO")
+	}
 
 	metaData, err := s.meetingRtc.GetRoomData(ctx, req.MeetingID)
 	if err != nil {
@@ -320,6 +443,24 @@ func (s *meetingServer) UpdateMeeting(ctx context.Context, req *pbmeeting.Update
 	}
 
 	updateData := s.getDBUpdateData(ctx, info, req)
+	if req.RepeatInfo != nil && recurrence.IsRecurrence(req.RepeatInfo.RepeatType) {
+		updated := *info
+		updated.EndDate = req.RepeatInfo.EndDate
+		if timeZone, ok := (*updateData)["time_zone"].(string); ok {
+			updated.TimeZone = timeZone
+		}
+		recurrenceData, err := setRepeatTypeRecurrence(&updated, req.RepeatInfo.RepeatType)
+		if err != nil {
+			return resp, err
+		}
+		for key, value := range recurrenceData {
+			(*updateData)[key] = value
+		}
+	}
+	if scheduledTime, ok := (*updateData)["scheduled_time"]; ok && info.Status == constant.Scheduled {
+		// the meeting has not started yet, so it starts at the new scheduled time
+		(*updateData)["start_time"] = scheduledTime
+	}
 
 	if len(*updateData) == 0 {
 		log.ZDebug(ctx, "no need to update meeting", "meeting id", req.MeetingID)
@@ -330,6 +471,12 @@ func (s *meetingServer) UpdateMeeting(ctx context.Context, req *pbmeeting.Update
 	if err := s.meetingStorageHandler.Update(ctx, req.MeetingID, *updateData); err != nil {
 		return resp, err
 	}
+	if s.isScheduleUpdated(*updateData) {
+		if err := s.regenerateMeetingOccurrences(ctx, req.MeetingID); err != nil {
+			log.ZError(ctx, "regenerate meeting occurrences failed", err, "meetingID", req.MeetingID)
+		}
+	}
+	s.notifyUpdatedMeeting(ctx, req.MeetingID, metaData)
 
 	// do not get the metadata, then return successfully
 	if metaData == nil {
@@ -337,9 +484,23 @@ func (s *meetingServer) UpdateMeeting(ctx context.Context, req *pbmeeting.Update
 	}
 
 	// get the latest data from database and update metadata
+	setting := metaData.Detail.GetSetting()
+	locked, enableCamera, unmuteMicrophone := setting.GetLockMeeting(), setting.GetCanParticipantsEnableCamera(), setting.GetCanParticipantsUnmuteMicrophone()
 	if err := s.updateMeetingMetaData(ctx, req.MeetingID, metaData); err != nil {
 		return resp, err
 	}
+	setting = metaData.Detail.GetSetting()
+	if setting.GetLockMeeting() != locked {
+		s.notifyMeetingLock(ctx, req.MeetingID, req.UpdatingUserID, !locked)
+	}
+	if setting.GetCanParticipantsEnableCamera() != enableCamera || setting.GetCanParticipantsUnmuteMicrophone() != unmuteMicrophone {
+		userIDs, err := s.meetingRtc.GetParticipantUserIDs(ctx, req.MeetingID)
+		if err != nil {
+			log.ZWarn(ctx, "get participants failed, skip updating the publish permission", err, "meetingID", req.MeetingID)
+		} else {
+			s.updatePublishPermission(ctx, req.MeetingID, nil, nil, userIDs...)
+		}
+	}
 	return resp, nil
 }
 
@@ -349,14 +510,19 @@ func (s *meetingServer) updateMeetingMetaData(ctx context.Context, meetingID str
 		return err
 	}
 
-	metaData.Detail, err = s.getMeetingDetailSetting(ctx, info)
+	detail, err := s.getMeetingDetailSetting(ctx, info)
 	if err != nil {
 		return err
 	}
+	s.applyCurrentOverrideDetail(ctx, info, detail)
 
-	if err := s.meetingRtc.UpdateMetaData(ctx, metaData); err != nil {
+	if err := s.meetingRtc.ModifyRoomData(ctx, meetingID, func(data *rtc.RoomData) (bool, error) {
+		data.MetaData.Detail = detail
+		return true, nil
+	}); err != nil {
 		return err
 	}
+	metaData.Detail = detail
 
 	return nil
 }
@@ -388,9 +554,9 @@ func (s *meetingServer) SetPersonalMeetingSettings(ctx context.Context, req *pbm
 		return resp, errs.WrapMsg(err, "get userid from context failed")
 	}
 	userID := result.(string)
-	hostUser := s.getHostUserID(metaData)
-	// non host user can not change other people's personal setting
-	if userID != hostUser && userID != req.UserID {
+	// only the roles muting others could change other people's personal setting
+	if userID != req.UserID && (!s.checkRoomPermission(metaData, nil, userID, constant.PermissionMuteOthers) ||
+		!s.checkOperateParticipant(metaData, userID, req.UserID)) {
 		return resp, servererrs.ErrMeetingAuthCheck.WrapMsg("do not have the permission to change other participant's setting")
 	}
 
@@ -415,24 +581,28 @@ func (s *meetingServer) OperateRoomAllStream(ctx context.Context, req *pbmeeting
 		return resp, err
 	}
 
-	hostUserID := s.getHostUserID(metaData)
-	creatorUserID := s.getCreatorUserID(metaData)
-	if !s.checkAuthPermission(creatorUserID, hostUserID, req.OperatorUserID) {
+	if !s.checkRoomPermission(metaData, nil, req.OperatorUserID, constant.PermissionMuteOthers) {
 		return resp, servererrs.ErrMeetingAuthCheck.WrapMsg("do not have the permission")
 	}
 	if req.MicrophoneOnEntry != nil {
-		resp.StreamNotExistUserIDList, resp.FailedUserIDList, err = s.muteAllStream(ctx, req.MeetingID, audio, !req.MicrophoneOnEntry.Value)
+		notExist, failed, err := s.muteAllStream(ctx, req.MeetingID, req.OperatorUserID, audio, !req.MicrophoneOnEntry.Value)
 		if err != nil {
 			return resp, errs.WrapMsg(err, "operate room all microphone stream failed")
 		}
+		resp.StreamNotExistUserIDList = append(resp.StreamNotExistUserIDList, notExist...)
+		resp.FailedUserIDList = append(resp.FailedUserIDList, failed...)
 	}
 
 	if req.CameraOnEntry != nil {
-		resp.StreamNotExistUserIDList, resp.StreamNotExistUserIDList, err = s.muteAllStream(ctx, req.MeetingID, video, !req.CameraOnEntry.Value)
+		notExist, failed, err := s.muteAllStream(ctx, req.MeetingID, req.OperatorUserID, video, !req.CameraOnEntry.Value)
 		if err != nil {
 			return resp, errs.WrapMsg(err, "operate room all camera stream failed")
 		}
+		resp.StreamNotExistUserIDList = append(resp.StreamNotExistUserIDList, notExist...)
+		resp.FailedUserIDList = append(resp.FailedUserIDList, failed...)
 	}
+	resp.StreamNotExistUserIDList = datautil.Distinct(resp.StreamNotExistUserIDList)
+	resp.FailedUserIDList = datautil.Distinct(resp.FailedUserIDList)
 
 	if err := s.broadcastStreamOperateData(ctx, req, resp.StreamNotExistUserIDList, resp.FailedUserIDList); err != nil {
 		return resp, errs.WrapMsg(err, "send notification to all participant failed")
@@ -449,9 +619,8 @@ func (s *meetingServer) ModifyMeetingParticipantNickName(ctx context.Context, re
 		return resp, errs.WrapMsg(err, "get room data failed", req.MeetingID)
 	}
 	// check permission
-	hostUserID := s.getHostUserID(metaData)
-	creatorUserID := s.getCreatorUserID(metaData)
-	if !s.checkAuthPermission(creatorUserID, hostUserID, req.UserID) {
+	if !s.checkRoomPermission(metaData, nil, req.UserID, constant.PermissionRename) ||
+		!s.checkOperateParticipant(metaData, req.UserID, req.ParticipantUserID) {
 		return resp, servererrs.ErrMeetingAuthCheck.WrapMsg("user did not have permission to modify meeting participant's nickname")
 	}
 	participantMetaData, err := s.meetingRtc.GetParticipantMetaData(ctx, req.MeetingID, req.ParticipantUserID)
@@ -472,12 +641,14 @@ func (s *meetingServer) RemoveParticipants(ctx context.Context, req *pbmeeting.R
 	if err != nil {
 		return resp, errs.WrapMsg(err, "get room data failed", req.MeetingID)
 	}
-	hostUserID := s.getHostUserID(metaData)
-	creatorUserID := s.getCreatorUserID(metaData)
-	// check permission only host can remove somebody
-	if !s.checkAuthPermission(creatorUserID, hostUserID, req.UserID) {
+	if !s.checkRoomPermission(metaData, nil, req.UserID, constant.PermissionKick) {
 		return resp, servererrs.ErrMeetingAuthCheck.WrapMsg("user did not have permission to remove participant out of the meeting")
 	}
+	for _, one := range req.ParticipantUserIDs {
+		if !s.checkOperateParticipant(metaData, req.UserID, one) {
+			return resp, servererrs.ErrMeetingAuthCheck.WrapMsg("user did not have permission to remove the host out of the meeting")
+		}
+	}
 	var failedList []string
 	var successList []string
 	for _, one := range req.ParticipantUserIDs {
@@ -490,6 +661,16 @@ func (s *meetingServer) RemoveParticipants(ctx context.Context, req *pbmeeting.R
 	}
 	resp.FailedUserIDList = failedList
 	resp.SuccessUserIDList = successList
+	if len(successList) > 0 {
+		s.leavePresence(ctx, req.MeetingID, successList)
+		s.recordLeave(ctx, req.MeetingID, successList, constant.LeaveReasonKicked)
+		s.publishWebhookEvent(ctx, &webhook.Event{
+			Type:           webhook.EventParticipantsRemoved,
+			MeetingID:      req.MeetingID,
+			UserIDs:        successList,
+			OperatorUserID: req.UserID,
+		})
+	}
 
 	return resp, nil
 }
@@ -502,29 +683,65 @@ func (s *meetingServer) SetMeetingHostInfo(ctx context.Context, req *pbmeeting.S
 		return resp, errs.WrapMsg(err, "get room data failed", req.MeetingID)
 	}
 	hostUserID := s.getHostUserID(metaData)
-	creatorUserID := s.getCreatorUserID(metaData)
-	// check permission only host can remove somebody
-	if !s.checkAuthPermission(creatorUserID, hostUserID, req.UserID) {
+	// handing over the host or changing the co-hosts is left to the host
+	if !s.checkRoomPermission(metaData, nil, req.UserID, constant.PermissionManageRoles) ||
+		s.getUserRole(metaData, nil, req.UserID) != constant.RoleHost {
 		return resp, servererrs.ErrMeetingAuthCheck.WrapMsg("user did not have permission to set host info of the meeting")
 	}
+	err = s.meetingRtc.ModifyRoomData(ctx, req.MeetingID, func(data *rtc.RoomData) (bool, error) {
+		info := data.MetaData.Detail.Info.CreatorDefinedMeeting
+		hostUserID = s.getHostUserID(data.MetaData)
+		if req.CoHostUserIDs != nil {
+			info.CoHostUSerID = s.mergeAndUnique(info.CoHostUSerID, req.CoHostUserIDs)
+		}
+		if req.HostUserID != nil {
+			info.HostUserID = req.HostUserID.Value
+			// the host is not one of the co-hosts
+			info.CoHostUSerID = datautil.Filter(info.CoHostUSerID, func(e string) (string, bool) {
+				return e, e != info.HostUserID
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return resp, errs.WrapMsg(err, "update meta data failed")
+	}
+	// the co-hosts are saved before the host, which takes the host out of them
+	if req.CoHostUserIDs != nil {
+		s.saveCoHostsState(ctx, req.MeetingID, req.CoHostUserIDs, nil)
+	}
+	if req.HostUserID != nil {
+		s.saveHostState(ctx, req.MeetingID, req.HostUserID.Value)
+	}
+	// the clients are notified of the change saved only
 	if req.HostUserID != nil {
-		metaData.Detail.Info.CreatorDefinedMeeting.HostUserID = req.HostUserID.Value
 		if err := s.sendMeetingHostData2Client(ctx, req.MeetingID, req.UserID, req.HostUserID.Value, constant.HostTypeHost); err != nil {
-			return resp, errs.ErrArgs.WrapMsg("notify host info to participant failed")
+			log.ZError(ctx, "notify host info to participant failed", err, "meetingID", req.MeetingID)
 		}
 	}
-	if req.CoHostUserIDs != nil {
-		metaData.Detail.Info.CreatorDefinedMeeting.CoHostUSerID = s.mergeAndUnique(
-			metaData.Detail.Info.CreatorDefinedMeeting.CoHostUSerID, req.CoHostUserIDs)
-
-		for _, one := range req.CoHostUserIDs {
-			if err := s.sendMeetingHostData2Client(ctx, req.MeetingID, req.UserID, one, constant.HostTypeCoHost); err != nil {
-				return resp, errs.ErrArgs.WrapMsg("notify host info to participant failed")
-			}
+	for _, one := range req.CoHostUserIDs {
+		if req.HostUserID != nil && one == req.HostUserID.Value {
+			continue
+		}
+		if err := s.sendMeetingHostData2Client(ctx, req.MeetingID, req.UserID, one, constant.HostTypeCoHost); err != nil {
+			log.ZError(ctx, "notify co-host info to participant failed", err, "meetingID", req.MeetingID, "userID", one)
 		}
 	}
-	if err := s.meetingRtc.UpdateMetaData(ctx, metaData); err != nil {
-		return resp, errs.WrapMsg(err, "update meta data failed")
+	hostChanged := req.HostUserID != nil && req.HostUserID.Value != hostUserID
+	if hostChanged {
+		s.recordHostChange(ctx, req.MeetingID, hostUserID, req.HostUserID.Value, req.UserID)
+		s.publishWebhookEvent(ctx, &webhook.Event{
+			Type:           webhook.EventHostChanged,
+			MeetingID:      req.MeetingID,
+			UserIDs:        []string{req.HostUserID.Value},
+			OperatorUserID: req.UserID,
+			Data:           map[string]string{"previousHostUserID": hostUserID},
+		})
+		if info, err := s.meetingStorageHandler.TakeWithError(ctx, req.MeetingID); err != nil {
+			log.ZWarn(ctx, "get meeting data failed, skip the host changed notification", err, "meetingID", req.MeetingID)
+		} else {
+			s.notifyMeetingEvent(ctx, notification.EventHostChanged, info, nil, req.HostUserID.Value)
+		}
 	}
 	return resp, nil
 }
@@ -532,29 +749,26 @@ func (s *meetingServer) SetMeetingHostInfo(ctx context.Context, req *pbmeeting.S
 func (s *meetingServer) CleanPreviousMeetings(ctx context.Context, req *pbmeeting.CleanPreviousMeetingsReq) (*pbmeeting.CleanPreviousMeetingsResp, error) {
 	resp := &pbmeeting.CleanPreviousMeetingsResp{}
 
-	rooms, err := s.meetingRtc.GetAllRooms(ctx)
+	roomIDs, err := s.presenceStorageHandler.FindUserRooms(ctx, req.UserID)
 	if err != nil {
-		log.ZError(ctx, "get all rooms error", err, "login and clean previous rooms", req.UserID)
-		return resp, errs.WrapMsg(err, "get all rooms error", "login and clean previous rooms", req.UserID)
+		log.ZError(ctx, "find user rooms error", err, "login and clean previous rooms", req.UserID)
+		return resp, errs.WrapMsg(err, "find user rooms error", "login and clean previous rooms", req.UserID)
 	}
 
-	for _, room := range rooms {
-		ps, err := s.meetingRtc.ListParticipants(ctx, room.Name)
-		if err != nil {
-			log.ZError(ctx, "list participant error", err, "login and clean previous rooms", room.Name, req.UserID)
+	for _, roomID := range roomIDs {
+		if err := s.notifyKickOffMeetingInfo2Client(ctx, roomID, req.UserID, req.Reason, pbmeeting.KickOffReason(req.ReasonCode)); err != nil {
+			log.ZError(ctx, "notify kickoff msg to client error", err, "login and clean previous rooms", roomID, req.UserID)
 		}
-		for _, p := range ps {
-			if p.Identity != req.UserID {
-				continue
-			}
-			if err := s.notifyKickOffMeetingInfo2Client(ctx, room.Name, req.UserID, req.Reason, pbmeeting.KickOffReason(req.ReasonCode)); err != nil {
-				log.ZError(ctx, "notify kickoff msg to client error", err, "login and clean previous rooms", room.Name, req.UserID)
-			}
-			if err := s.meetingRtc.RemoveParticipant(ctx, room.Name, req.UserID); err != nil {
-				log.ZError(ctx, "remove participant error", err, "login and clean previous rooms", room.Name, req.UserID)
-				continue
-			}
+		if err := s.meetingRtc.RemoveParticipant(ctx, roomID, req.UserID); err != nil {
+			log.ZError(ctx, "remove participant error", err, "login and clean previous rooms", roomID, req.UserID)
+			continue
+		}
+		s.leavePresence(ctx, roomID, []string{req.UserID})
+		meetingID := roomID
+		if parentID, _, ok := rtc.ParseBreakoutRoomID(roomID); ok {
+			meetingID = parentID
 		}
+		s.recordLeave(ctx, meetingID, []string{req.UserID}, pbmeeting.KickOffReason(req.ReasonCode).String())
 	}
 
 	return resp, nil
@@ -2,10 +2,15 @@ package meeting
 
 import (
 	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/constant"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	pbmeetingext "github.com/openimsdk/openmeeting-server/pkg/protocol/meetingext"
 	pbmeeting "github.com/openimsdk/protocol/openmeeting/meeting"
 	pbwrapper "github.com/openimsdk/protocol/wrapperspb"
 	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
 )
 
 func (s *meetingServer) sendMeetingHostData2Client(ctx context.Context, roomID, operateUserID, userID, hostType string) error {
@@ -126,3 +131,31 @@ func (s *meetingServer) notifyKickOffMeetingInfo2Client(ctx context.Context, roo
 
 	return nil
 }
+
+// notifyWaitingRoom send the users still waiting to the host and the co-hosts in the room
+func (s *meetingServer) notifyWaitingRoom(ctx context.Context, roomID, operatorUserID string) {
+	metaData, err := s.meetingRtc.GetRoomData(ctx, roomID)
+	if err != nil {
+		// the hosts get the waiting room after the room is open
+		log.ZDebug(ctx, "room is not open, skip the waiting room notification", "roomID", roomID)
+		return
+	}
+	users, err := s.waitingRoomStorageHandler.FindUsers(ctx, roomID)
+	if err != nil {
+		log.ZWarn(ctx, "get waiting users failed", err, "roomID", roomID)
+		return
+	}
+	users = datautil.Filter(users, func(e *model.WaitingUser) (*model.WaitingUser, bool) {
+		return e, e.Status == constant.WaitingStatusWaiting
+	})
+	hostUserIDs := s.getHostsUserIDs(metaData)
+	sendData := &pbmeetingext.NotifyMeetingExtData{
+		OperatorUserID: operatorUserID,
+		MessageType: &pbmeetingext.NotifyMeetingExtData_WaitingRoomData{WaitingRoomData: &pbmeetingext.WaitingRoomData{
+			Users: datautil.Slice(users, s.generateClientWaitingUser),
+		}},
+	}
+	if err := s.meetingRtc.SendRoomExtData(ctx, roomID, &hostUserIDs, sendData); err != nil {
+		log.ZWarn(ctx, "send waiting room data failed", err, "roomID", roomID)
+	}
+}
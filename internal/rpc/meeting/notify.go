@@ -0,0 +1,159 @@
+package meeting
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/config"
+	"github.com/openimsdk/openmeeting-server/pkg/common/constant"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	"github.com/openimsdk/openmeeting-server/pkg/notification"
+	"github.com/openimsdk/openmeeting-server/pkg/webhook"
+	pbmeeting "github.com/openimsdk/protocol/openmeeting/meeting"
+	pbuser "github.com/openimsdk/protocol/openmeeting/user"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/openimsdk/tools/utils/timeutil"
+	"time"
+)
+
+// newNotificationDispatcher builds the senders enabled in the config, the dispatcher does nothing without a sender.
+func newNotificationDispatcher(conf *config.Notification) *notification.Dispatcher {
+	var senders []notification.Sender
+	if conf.Enable {
+		if conf.SMTP.Enable {
+			senders = append(senders, notification.NewSMTPSender(&conf.SMTP))
+		}
+		if conf.Webhook.Enable {
+			senders = append(senders, notification.NewWebhookSender(&conf.Webhook))
+		}
+	}
+	return notification.NewDispatcher(senders, conf.Workers, conf.QueueSize)
+}
+
+// notifyMeetingEvent dispatches the lifecycle event of the meeting to the creator, the host and the invitees
+// who did not decline. The event is about the next occurrence of the meeting if occurrence is nil.
+func (s *meetingServer) notifyMeetingEvent(ctx context.Context, eventType string, info *model.MeetingInfo, occurrence *model.MeetingOccurrence, hostUserID string) {
+	if !s.notificationDispatcher.Enabled() {
+		return
+	}
+	event := &notification.Event{
+		Type:           eventType,
+		MeetingID:      info.MeetingID,
+		Title:          info.Title,
+		StartTime:      info.ScheduledTime,
+		Duration:       info.MeetingDuration,
+		TimeZone:       info.TimeZone,
+		OperatorUserID: mcontext.GetOpUserID(ctx),
+		HostUserID:     hostUserID,
+		CreateTime:     timeutil.GetCurrentTimestampBySecond(),
+	}
+	if occurrence != nil {
+		event.OccurrenceID = occurrence.OccurrenceID
+		event.StartTime = occurrence.PlannedStartTime
+		event.Duration = occurrence.PlannedEndTime - occurrence.PlannedStartTime
+	} else if next := s.nextMeetingTimestamp(ctx, info); next > 0 {
+		event.StartTime = next
+	}
+	event.Recipients = s.getNotificationRecipients(ctx, info, hostUserID)
+	s.notificationDispatcher.Dispatch(ctx, event)
+}
+
+// notifyUpdatedMeeting notifies the latest data of the updated meeting, the host is known only if the room exists.
+func (s *meetingServer) notifyUpdatedMeeting(ctx context.Context, meetingID string, metaData *pbmeeting.MeetingMetadata) {
+	if !s.notificationDispatcher.Enabled() {
+		return
+	}
+	info, err := s.meetingStorageHandler.TakeWithError(ctx, meetingID)
+	if err != nil {
+		log.ZWarn(ctx, "get meeting data failed, skip the updated notification", err, "meetingID", meetingID)
+		return
+	}
+	var hostUserID string
+	if metaData != nil {
+		hostUserID = s.getHostUserID(metaData)
+	}
+	s.notifyMeetingEvent(ctx, notification.EventMeetingUpdated, info, nil, hostUserID)
+}
+
+// getOverriddenInfo returns the meeting with the title of the occurrence override.
+func (s *meetingServer) getOverriddenInfo(info *model.MeetingInfo, override *model.MeetingOverride) *model.MeetingInfo {
+	if override.Title == nil {
+		return info
+	}
+	overridden := *info
+	overridden.Title = *override.Title
+	return &overridden
+}
+
+// getNotificationRecipients returns the users concerned by the meeting, the user info is best effort.
+func (s *meetingServer) getNotificationRecipients(ctx context.Context, info *model.MeetingInfo, hostUserID string) []*notification.Recipient {
+	userIDs := []string{info.CreatorUserID}
+	if hostUserID != "" {
+		userIDs = append(userIDs, hostUserID)
+	}
+	invitations, err := s.invitationStorageHandler.FindByMeetingID(ctx, info.MeetingID)
+	if err != nil {
+		log.ZWarn(ctx, "find meeting invitations failed", err, "meetingID", info.MeetingID)
+	}
+	for _, one := range invitations {
+		if one.Response != constant.InvitationDeclined {
+			userIDs = append(userIDs, one.UserID)
+		}
+	}
+	userIDs = datautil.Distinct(userIDs)
+
+	users, err := s.userRpc.GetUsersInfo(ctx, userIDs)
+	if err != nil {
+		log.ZWarn(ctx, "get recipients info failed", err, "meetingID", info.MeetingID)
+	}
+	userMap := datautil.SliceToMap(users, func(e *pbuser.UserInfo) string {
+		return e.UserID
+	})
+	recipients := make([]*notification.Recipient, 0, len(userIDs))
+	for _, userID := range userIDs {
+		recipient := &notification.Recipient{UserID: userID}
+		if user, ok := userMap[userID]; ok {
+			recipient.Nickname = user.Nickname
+			recipient.Account = user.Account
+		}
+		recipients = append(recipients, recipient)
+	}
+	return recipients
+}
+
+// remindStartingOccurrences notifies the occurrences starting within the reminder minutes, each occurrence once.
+func (s *meetingServer) remindStartingOccurrences(ctx context.Context) {
+	minutes := s.config.Rpc.Notification.ReminderMinutes
+	if !s.notificationDispatcher.Enabled() || minutes <= 0 {
+		return
+	}
+	now := timeutil.GetCurrentTimestampBySecond()
+	occurrences, err := s.occurrenceStorageHandler.FindToRemind(ctx, now, now+int64(time.Duration(minutes)*time.Minute/time.Second))
+	if err != nil {
+		log.ZError(ctx, "find occurrences to remind failed", err)
+		return
+	}
+	for _, one := range occurrences {
+		info, err := s.meetingStorageHandler.TakeWithError(ctx, one.MeetingID)
+		if err != nil {
+			log.ZWarn(ctx, "get meeting of occurrence failed", err, "occurrenceID", one.OccurrenceID)
+			continue
+		}
+		// mark first, a missed reminder is better than a repeated one
+		if err := s.occurrenceStorageHandler.Update(ctx, one.OccurrenceID, map[string]any{"reminded": true}); err != nil {
+			log.ZError(ctx, "mark occurrence reminded failed", err, "occurrenceID", one.OccurrenceID)
+			continue
+		}
+		if override, ok := s.getMeetingOverrides(ctx, info)[one.OriginalStartTime]; ok {
+			info = s.getOverriddenInfo(info, override)
+		}
+		s.notifyMeetingEvent(ctx, notification.EventMeetingStarting, info, one, "")
+	}
+}
+
+// publishWebhookEvent hands the event to the outbound webhooks if they are enabled.
+func (s *meetingServer) publishWebhookEvent(ctx context.Context, event *webhook.Event) {
+	if s.webhookPublisher != nil {
+		s.webhookPublisher.Publish(ctx, event)
+	}
+}
@@ -0,0 +1,285 @@
+package meeting
+
+import (
+	"context"
+	"fmt"
+	"github.com/openimsdk/openmeeting-server/pkg/common/constant"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	pbmeetingext "github.com/openimsdk/openmeeting-server/pkg/protocol/meetingext"
+	pbmeeting "github.com/openimsdk/protocol/openmeeting/meeting"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/timeutil"
+	"time"
+)
+
+const (
+	defaultOccurrenceHorizonDays = 30
+	// maxGeneratedOccurrences protects the generation loop from a broken repeat rule
+	maxGeneratedOccurrences = 1000
+)
+
+// GetMeetingOccurrences get the occurrences of the user's meetings overlapping the time window, the meetings
+// the user is invited to included
+func (s *meetingServer) GetMeetingOccurrences(ctx context.Context, req *pbmeetingext.GetMeetingOccurrencesReq) (*pbmeetingext.GetMeetingOccurrencesResp, error) {
+	resp := &pbmeetingext.GetMeetingOccurrencesResp{}
+	startTime, endTime := req.StartTime, req.EndTime
+	if startTime == 0 {
+		startTime = timeutil.GetCurrentTimestampBySecond()
+	}
+	if endTime == 0 {
+		endTime = startTime + int64(s.occurrenceHorizon().Seconds())
+	}
+	if endTime <= startTime {
+		return resp, errs.ErrArgs.WrapMsg("end time should be later than start time")
+	}
+
+	var meetings []*model.MeetingInfo
+	if req.MeetingID != "" {
+		info, err := s.meetingStorageHandler.TakeWithError(ctx, req.MeetingID)
+		if err != nil {
+			return resp, errs.WrapMsg(err, "get meeting data failed")
+		}
+		if err := s.checkMeetingReader(ctx, info, req.UserID); err != nil {
+			return resp, err
+		}
+		meetings = []*model.MeetingInfo{info}
+	} else {
+		var err error
+		meetings, err = s.findUserMeetings(ctx, req.UserID, []string{constant.Scheduled, constant.InProgress, constant.Completed})
+		if err != nil {
+			return resp, err
+		}
+	}
+	meetingIDs := make([]string, 0, len(meetings))
+	for _, one := range meetings {
+		meetingIDs = append(meetingIDs, one.MeetingID)
+	}
+	occurrences, err := s.occurrenceStorageHandler.FindInWindow(ctx, meetingIDs, startTime, endTime, req.Status)
+	if err != nil {
+		return resp, err
+	}
+
+	overrides, err := s.occurrenceStorageHandler.FindOverrides(ctx, meetingIDs)
+	if err != nil {
+		return resp, err
+	}
+	overrideMap := make(map[string]*model.MeetingOverride)
+	for _, one := range overrides {
+		overrideMap[s.getOccurrenceID(one.MeetingID, one.OriginalStartTime)] = one
+	}
+
+	details := make(map[string]*pbmeeting.MeetingInfoSetting)
+	for _, one := range meetings {
+		detailSetting, err := s.getMeetingDetailSetting(ctx, one)
+		if err != nil {
+			continue
+		}
+		details[one.MeetingID] = detailSetting
+	}
+	for _, one := range occurrences {
+		detailSetting, ok := details[one.MeetingID]
+		if !ok {
+			continue
+		}
+		resp.Occurrences = append(resp.Occurrences, s.generateClientOccurrence(one, detailSetting, overrideMap[one.OccurrenceID]))
+	}
+	return resp, nil
+}
+
+func (s *meetingServer) occurrenceHorizon() time.Duration {
+	days := s.config.Rpc.Occurrence.HorizonDays
+	if days <= 0 {
+		days = defaultOccurrenceHorizonDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// occurrenceHorizonEnd the only occurrence of the one-off meeting is generated however far ahead it is booked
+func (s *meetingServer) occurrenceHorizonEnd(info *model.MeetingInfo, now time.Time) time.Time {
+	end := now.Add(s.occurrenceHorizon())
+	if !s.isRepeatMeeting(info) && info.StartTime >= end.Unix() {
+		end = time.Unix(info.StartTime+1, 0)
+	}
+	return end
+}
+
+func (s *meetingServer) getOccurrenceID(meetingID string, plannedStartTime int64) string {
+	return fmt.Sprintf("%s_%d", meetingID, plannedStartTime)
+}
+
+func (s *meetingServer) isRepeatMeeting(info *model.MeetingInfo) bool {
+	return info.RepeatType != "" && info.RepeatType != constant.NoneRepeat
+}
+
+func (s *meetingServer) newOccurrence(info *model.MeetingInfo, plannedStartTime int64) *model.MeetingOccurrence {
+	return &model.MeetingOccurrence{
+		OccurrenceID:       s.getOccurrenceID(info.MeetingID, plannedStartTime),
+		MeetingID:          info.MeetingID,
+		OriginalStartTime:  plannedStartTime,
+		PlannedStartTime:   plannedStartTime,
+		PlannedEndTime:     plannedStartTime + info.MeetingDuration,
+		Status:             constant.Scheduled,
+		ParticipantUserIDs: []string{},
+	}
+}
+
+// generateOccurrences expands the repeat rule of the meeting into the occurrences originally planned to start
+// in [from, to), the occurrence overrides are applied on them.
+func (s *meetingServer) generateOccurrences(ctx context.Context, info *model.MeetingInfo, from, to time.Time, overrides map[int64]*model.MeetingOverride) []*model.MeetingOccurrence {
+	if !s.isRepeatMeeting(info) {
+		if info.StartTime < from.Unix() || info.StartTime >= to.Unix() {
+			return nil
+		}
+		return []*model.MeetingOccurrence{s.newOccurrence(info, info.StartTime)}
+	}
+	series := s.meetingSeries(ctx, info)
+	var occurrences []*model.MeetingOccurrence
+	after := from.Add(-time.Second)
+	for len(occurrences) < maxGeneratedOccurrences {
+		next := s.nextTimestampInSeries(info, series, after)
+		if next == 0 || next >= to.Unix() {
+			break
+		}
+		occurrence := s.newOccurrence(info, next)
+		s.applyOverride(info, occurrence, overrides[next])
+		occurrences = append(occurrences, occurrence)
+		after = time.Unix(next, 0)
+	}
+	return occurrences
+}
+
+// syncMeetingOccurrences generates the missing occurrences of the meeting up to the configured horizon,
+// the overrides are the ones of the meeting keyed by the original start time.
+func (s *meetingServer) syncMeetingOccurrences(ctx context.Context, info *model.MeetingInfo, overrides map[int64]*model.MeetingOverride) error {
+	now := time.Now()
+	from := now.Add(-time.Duration(info.MeetingDuration) * time.Second)
+	if !s.isRepeatMeeting(info) {
+		from = time.Unix(info.StartTime, 0)
+	}
+	occurrences := s.generateOccurrences(ctx, info, from, s.occurrenceHorizonEnd(info, now), overrides)
+	if err := s.occurrenceStorageHandler.CreateIfNotExist(ctx, occurrences); err != nil {
+		return errs.WrapMsg(err, "create meeting occurrences failed", "meetingID", info.MeetingID)
+	}
+	return nil
+}
+
+// syncAllMeetingOccurrences tops up the occurrences of all the meetings not completed, the one-off meetings
+// created without their occurrence get it here.
+func (s *meetingServer) syncAllMeetingOccurrences(ctx context.Context) {
+	meetings, err := s.meetingStorageHandler.FindByStatus(ctx, []string{constant.InProgress, constant.Scheduled}, "")
+	if err != nil {
+		log.ZError(ctx, "find meetings failed", err)
+		return
+	}
+	overrides := s.findMeetingsOverrides(ctx, meetings)
+	for _, one := range meetings {
+		if err := s.syncMeetingOccurrences(ctx, one, overrides[one.MeetingID]); err != nil {
+			log.ZError(ctx, "sync meeting occurrences failed", err, "meetingID", one.MeetingID)
+		}
+	}
+}
+
+// regenerateMeetingOccurrences drops the occurrences which have not started yet and generates them again,
+// it is used after the schedule of the meeting changed.
+func (s *meetingServer) regenerateMeetingOccurrences(ctx context.Context, meetingID string) error {
+	info, err := s.meetingStorageHandler.TakeWithError(ctx, meetingID)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	occurrences := s.generateOccurrences(ctx, info, now, s.occurrenceHorizonEnd(info, now), s.getMeetingOverrides(ctx, info))
+	if err := s.occurrenceStorageHandler.Regenerate(ctx, meetingID, now.Unix(), occurrences); err != nil {
+		return errs.WrapMsg(err, "regenerate meeting occurrences failed", "meetingID", meetingID)
+	}
+	return nil
+}
+
+// isScheduleUpdated check whether the update changes the time of the occurrences.
+func (s *meetingServer) isScheduleUpdated(updateData map[string]any) bool {
+	for _, key := range []string{"scheduled_time", "meeting_duration", "time_zone", "repeat_type", "repeat_times", "end_date", "rrule", "ex_dates"} {
+		if _, ok := updateData[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// createImmediateOccurrence records the only occurrence of an immediate meeting which starts right away.
+func (s *meetingServer) createImmediateOccurrence(ctx context.Context, info *model.MeetingInfo) error {
+	occurrence := s.newOccurrence(info, info.StartTime)
+	occurrence.ActualStartTime = info.StartTime
+	occurrence.Status = constant.InProgress
+	occurrence.ParticipantUserIDs = []string{info.CreatorUserID}
+	return s.occurrenceStorageHandler.CreateIfNotExist(ctx, []*model.MeetingOccurrence{occurrence})
+}
+
+// startCurrentOccurrence marks the current occurrence of the meeting as started when its room is opened.
+func (s *meetingServer) startCurrentOccurrence(ctx context.Context, meetingID string) {
+	now := timeutil.GetCurrentTimestampBySecond()
+	occurrence, err := s.occurrenceStorageHandler.TakeCurrent(ctx, meetingID, now)
+	if err != nil {
+		log.ZWarn(ctx, "not found current occurrence to start", err, "meetingID", meetingID)
+		return
+	}
+	updateData := map[string]any{"status": constant.InProgress}
+	if occurrence.ActualStartTime == 0 {
+		updateData["actual_start_time"] = now
+	}
+	if err := s.occurrenceStorageHandler.Update(ctx, occurrence.OccurrenceID, updateData); err != nil {
+		log.ZError(ctx, "start occurrence failed", err, "occurrenceID", occurrence.OccurrenceID)
+	}
+}
+
+// addOccurrenceParticipant records the user in the attendee list of the current occurrence.
+func (s *meetingServer) addOccurrenceParticipant(ctx context.Context, meetingID, userID string) {
+	occurrence, err := s.occurrenceStorageHandler.TakeCurrent(ctx, meetingID, timeutil.GetCurrentTimestampBySecond())
+	if err != nil {
+		log.ZWarn(ctx, "not found current occurrence to join", err, "meetingID", meetingID, "userID", userID)
+		return
+	}
+	if err := s.occurrenceStorageHandler.AddParticipant(ctx, occurrence.OccurrenceID, userID); err != nil {
+		log.ZError(ctx, "add occurrence participant failed", err, "occurrenceID", occurrence.OccurrenceID, "userID", userID)
+	}
+}
+
+// endCurrentOccurrence marks the running occurrence of the meeting as completed.
+func (s *meetingServer) endCurrentOccurrence(ctx context.Context, meetingID string) {
+	now := timeutil.GetCurrentTimestampBySecond()
+	occurrence, err := s.occurrenceStorageHandler.TakeCurrent(ctx, meetingID, now)
+	if err != nil {
+		log.ZWarn(ctx, "not found current occurrence to end", err, "meetingID", meetingID)
+		return
+	}
+	s.completeOccurrence(ctx, occurrence, now)
+}
+
+func (s *meetingServer) completeOccurrence(ctx context.Context, occurrence *model.MeetingOccurrence, now int64) {
+	updateData := map[string]any{"status": constant.Completed}
+	if occurrence.ActualStartTime > 0 && occurrence.ActualEndTime == 0 {
+		updateData["actual_end_time"] = now
+	}
+	if err := s.occurrenceStorageHandler.Update(ctx, occurrence.OccurrenceID, updateData); err != nil {
+		log.ZError(ctx, "complete occurrence failed", err, "occurrenceID", occurrence.OccurrenceID)
+	}
+}
+
+// refreshOccurrenceStatus moves the occurrences between Scheduled, In-Progress and Completed by their planned time.
+func (s *meetingServer) refreshOccurrenceStatus(ctx context.Context) {
+	occurrences, err := s.occurrenceStorageHandler.FindByStatus(ctx, []string{constant.Scheduled, constant.InProgress})
+	if err != nil {
+		log.ZError(ctx, "find occurrences failed", err)
+		return
+	}
+	now := timeutil.GetCurrentTimestampBySecond()
+	for _, one := range occurrences {
+		switch {
+		case one.PlannedEndTime < now:
+			s.completeOccurrence(ctx, one, now)
+		case one.PlannedStartTime <= now && one.Status == constant.Scheduled:
+			if err := s.occurrenceStorageHandler.Update(ctx, one.OccurrenceID, map[string]any{"status": constant.InProgress}); err != nil {
+				log.ZError(ctx, "update occurrence status failed", err, "occurrenceID", one.OccurrenceID)
+			}
+		}
+	}
+}
@@ -0,0 +1,275 @@
+package meeting
+
+import (
+	"context"
+	"errors"
+	"github.com/openimsdk/openmeeting-server/pkg/common/constant"
+	"github.com/openimsdk/openmeeting-server/pkg/common/servererrs"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	"github.com/openimsdk/openmeeting-server/pkg/notification"
+	pbmeetingext "github.com/openimsdk/openmeeting-server/pkg/protocol/meetingext"
+	pbmeeting "github.com/openimsdk/protocol/openmeeting/meeting"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/timeutil"
+	"time"
+)
+
+// UpdateMeetingOccurrence reschedule one occurrence of the recurring meeting or change its title or password
+func (s *meetingServer) UpdateMeetingOccurrence(ctx context.Context, req *pbmeetingext.UpdateMeetingOccurrenceReq) (*pbmeetingext.UpdateMeetingOccurrenceResp, error) {
+	resp := &pbmeetingext.UpdateMeetingOccurrenceResp{}
+	info, override, err := s.takeOverridableOccurrence(ctx, req.MeetingID, req.UpdatingUserID, req.OriginalStartTime)
+	if err != nil {
+		return resp, err
+	}
+	if override.Cancelled {
+		return resp, errs.ErrArgs.WrapMsg("occurrence is already cancelled", "originalStartTime", req.OriginalStartTime)
+	}
+
+	if req.ScheduledTime != nil {
+		if req.ScheduledTime.Value <= timeutil.GetCurrentTimestampBySecond() {
+			return resp, errs.ErrArgs.WrapMsg("occurrence could not be rescheduled to the past")
+		}
+		override.ScheduledTime = req.ScheduledTime.Value
+	}
+	if req.MeetingDuration != nil {
+		if req.MeetingDuration.Value <= 0 {
+			return resp, errs.ErrArgs.WrapMsg("meeting duration should be positive")
+		}
+		override.MeetingDuration = req.MeetingDuration.Value
+	}
+	if req.Title != nil {
+		override.Title = &req.Title.Value
+	}
+	if req.Password != nil {
+		override.Password = &req.Password.Value
+	}
+	override.UpdateTime = timeutil.GetCurrentTimestampBySecond()
+
+	occurrence := s.newOccurrence(info, req.OriginalStartTime)
+	s.applyOverride(info, occurrence, override)
+	updateData := map[string]any{
+		"planned_start_time": occurrence.PlannedStartTime,
+		"planned_end_time":   occurrence.PlannedEndTime,
+	}
+	if err := s.occurrenceStorageHandler.Override(ctx, override, occurrence, updateData); err != nil {
+		return resp, err
+	}
+
+	occurrence, err = s.occurrenceStorageHandler.Take(ctx, occurrence.OccurrenceID)
+	if err != nil {
+		return resp, err
+	}
+	s.notifyMeetingEvent(ctx, notification.EventMeetingUpdated, s.getOverriddenInfo(info, override), occurrence, "")
+	detailSetting, err := s.getMeetingDetailSetting(ctx, info)
+	if err != nil {
+		return resp, err
+	}
+	resp.Occurrence = s.generateClientOccurrence(occurrence, detailSetting, override)
+	return resp, nil
+}
+
+// CancelMeetingOccurrence cancel one occurrence of the recurring meeting, the rest of the series is kept
+func (s *meetingServer) CancelMeetingOccurrence(ctx context.Context, req *pbmeetingext.CancelMeetingOccurrenceReq) (*pbmeetingext.CancelMeetingOccurrenceResp, error) {
+	resp := &pbmeetingext.CancelMeetingOccurrenceResp{}
+	info, override, err := s.takeOverridableOccurrence(ctx, req.MeetingID, req.UserID, req.OriginalStartTime)
+	if err != nil {
+		return resp, err
+	}
+	if override.Cancelled {
+		return resp, nil
+	}
+	override.Cancelled = true
+	override.UpdateTime = timeutil.GetCurrentTimestampBySecond()
+
+	occurrence := s.newOccurrence(info, req.OriginalStartTime)
+	s.applyOverride(info, occurrence, override)
+	if err := s.occurrenceStorageHandler.Override(ctx, override, occurrence, map[string]any{"status": constant.Cancelled}); err != nil {
+		return resp, err
+	}
+	s.notifyMeetingEvent(ctx, notification.EventMeetingCancelled, s.getOverriddenInfo(info, override), occurrence, "")
+	// the room opened for the cancelled occurrence is closed
+	if s.isCurrentOccurrenceCancelled(ctx, info) {
+		if err := s.closeRoomIfExist(ctx, info.MeetingID); err != nil {
+			return resp, err
+		}
+		s.endMeetingSession(ctx, info.MeetingID, constant.Scheduled)
+	}
+	return resp, nil
+}
+
+// takeOverridableOccurrence checks the user could override the occurrence which has not started yet,
+// the current override of the occurrence is returned, a new one if it is not overridden before.
+func (s *meetingServer) takeOverridableOccurrence(ctx context.Context, meetingID, userID string, originalStartTime int64) (*model.MeetingInfo, *model.MeetingOverride, error) {
+	info, err := s.meetingStorageHandler.TakeWithError(ctx, meetingID)
+	if err != nil {
+		return nil, nil, errs.WrapMsg(err, "get meeting data failed")
+	}
+	if info.Status == constant.Completed {
+		return nil, nil, servererrs.ErrMeetingAlreadyCompleted.WrapMsg("meeting is already completed, can not update anymore")
+	}
+	if !s.isRepeatMeeting(info) {
+		return nil, nil, errs.ErrArgs.WrapMsg("only the occurrence of a recurring meeting could be overridden", "meetingID", meetingID)
+	}
+
+	if !s.checkMeetingPermission(ctx, info, userID, constant.PermissionManageMeeting) {
+		return nil, nil, servererrs.ErrMeetingAuthCheck.WrapMsg("user did not have permission to update the occurrence of the meeting")
+	}
+
+	if s.nextSeriesTimestampAfter(ctx, info, time.Unix(originalStartTime-1, 0)) != originalStartTime {
+		return nil, nil, errs.ErrArgs.WrapMsg("not an occurrence of the meeting", "originalStartTime", originalStartTime)
+	}
+	occurrence, err := s.occurrenceStorageHandler.Take(ctx, s.getOccurrenceID(meetingID, originalStartTime))
+	if err == nil && (occurrence.Status == constant.InProgress || occurrence.Status == constant.Completed) {
+		return nil, nil, errs.ErrArgs.WrapMsg("occurrence already started", "occurrenceID", occurrence.OccurrenceID)
+	} else if err != nil && !errors.Is(err, errs.ErrRecordNotFound) {
+		return nil, nil, err
+	}
+
+	override, err := s.occurrenceStorageHandler.TakeOverride(ctx, meetingID, originalStartTime)
+	if err != nil {
+		if !errors.Is(err, errs.ErrRecordNotFound) {
+			return nil, nil, err
+		}
+		override = &model.MeetingOverride{MeetingID: meetingID, OriginalStartTime: originalStartTime}
+	}
+	return info, override, nil
+}
+
+// getMeetingOverrides returns the overrides of the recurring meeting keyed by the original start time.
+func (s *meetingServer) getMeetingOverrides(ctx context.Context, info *model.MeetingInfo) map[int64]*model.MeetingOverride {
+	return s.findMeetingsOverrides(ctx, []*model.MeetingInfo{info})[info.MeetingID]
+}
+
+// findMeetingsOverrides loads the overrides of the recurring meetings in one query,
+// they are grouped by the meeting id and keyed by the original start time.
+func (s *meetingServer) findMeetingsOverrides(ctx context.Context, meetings []*model.MeetingInfo) map[string]map[int64]*model.MeetingOverride {
+	var meetingIDs []string
+	for _, one := range meetings {
+		if s.isRepeatMeeting(one) {
+			meetingIDs = append(meetingIDs, one.MeetingID)
+		}
+	}
+	if len(meetingIDs) == 0 {
+		return nil
+	}
+	overrides, err := s.occurrenceStorageHandler.FindOverrides(ctx, meetingIDs)
+	if err != nil {
+		log.ZError(ctx, "find meeting overrides failed", err, "meetingIDs", meetingIDs)
+		return nil
+	}
+	overrideMap := make(map[string]map[int64]*model.MeetingOverride, len(meetingIDs))
+	for _, one := range overrides {
+		if overrideMap[one.MeetingID] == nil {
+			overrideMap[one.MeetingID] = make(map[int64]*model.MeetingOverride)
+		}
+		overrideMap[one.MeetingID][one.OriginalStartTime] = one
+	}
+	return overrideMap
+}
+
+func (s *meetingServer) getOverrideDuration(info *model.MeetingInfo, override *model.MeetingOverride) int64 {
+	if override != nil && override.MeetingDuration > 0 {
+		return override.MeetingDuration
+	}
+	return info.MeetingDuration
+}
+
+// isOccurrenceMoved check whether the occurrence does not start at the time generated by the repetition.
+func (s *meetingServer) isOccurrenceMoved(override *model.MeetingOverride) bool {
+	return override.Cancelled || (override.ScheduledTime > 0 && override.ScheduledTime != override.OriginalStartTime)
+}
+
+// nextOccurrenceAfter returns the start time and duration of the first occurrence later than the given time,
+// the cancelled occurrences are skipped and the rescheduled ones start at their new time.
+func (s *meetingServer) nextOccurrenceAfter(ctx context.Context, info *model.MeetingInfo, after time.Time, overrides map[int64]*model.MeetingOverride) (startTime int64, duration int64) {
+	for _, override := range overrides {
+		if override.Cancelled || override.ScheduledTime <= after.Unix() {
+			continue
+		}
+		if startTime == 0 || override.ScheduledTime < startTime {
+			startTime, duration = override.ScheduledTime, s.getOverrideDuration(info, override)
+		}
+	}
+	series := s.meetingSeries(ctx, info)
+	cursor := after
+	for i := 0; i < maxGeneratedOccurrences; i++ {
+		next := s.nextTimestampInSeries(info, series, cursor)
+		if next == 0 || (startTime > 0 && next >= startTime) {
+			break
+		}
+		override := overrides[next]
+		if override != nil && s.isOccurrenceMoved(override) {
+			cursor = time.Unix(next, 0)
+			continue
+		}
+		return next, s.getOverrideDuration(info, override)
+	}
+	return startTime, duration
+}
+
+// isCurrentOccurrenceCancelled check whether the occurrence of the recurring meeting planned at now is cancelled,
+// the meeting is still held if another occurrence is rescheduled into the time.
+func (s *meetingServer) isCurrentOccurrenceCancelled(ctx context.Context, info *model.MeetingInfo) bool {
+	if !s.isRepeatMeeting(info) {
+		return false
+	}
+	overrides := s.getMeetingOverrides(ctx, info)
+	now := time.Now()
+	for _, override := range overrides {
+		if !override.Cancelled {
+			continue
+		}
+		startTime := override.OriginalStartTime
+		if override.ScheduledTime > 0 {
+			startTime = override.ScheduledTime
+		}
+		if startTime <= now.Unix() && now.Unix() < startTime+s.getOverrideDuration(info, override) {
+			return s.liveOccurrenceStartTime(ctx, info, overrides, now) == 0
+		}
+	}
+	return false
+}
+
+// applyOverride changes the generated occurrence by the override of it.
+func (s *meetingServer) applyOverride(info *model.MeetingInfo, occurrence *model.MeetingOccurrence, override *model.MeetingOverride) {
+	if override == nil {
+		return
+	}
+	if override.Cancelled {
+		occurrence.Status = constant.Cancelled
+	}
+	if override.ScheduledTime > 0 {
+		occurrence.PlannedStartTime = override.ScheduledTime
+	}
+	occurrence.PlannedEndTime = occurrence.PlannedStartTime + s.getOverrideDuration(info, override)
+}
+
+func (s *meetingServer) applyOverrideDetail(detail *pbmeeting.MeetingInfoSetting, override *model.MeetingOverride) {
+	if override.Title != nil {
+		detail.Info.CreatorDefinedMeeting.Title = *override.Title
+	}
+	if override.Password != nil {
+		detail.Info.CreatorDefinedMeeting.Password = *override.Password
+	}
+}
+
+// applyCurrentOverrideDetail changes the meeting detail by the override of the current occurrence,
+// so the room of the occurrence uses its own title and password.
+func (s *meetingServer) applyCurrentOverrideDetail(ctx context.Context, info *model.MeetingInfo, detail *pbmeeting.MeetingInfoSetting) {
+	if !s.isRepeatMeeting(info) || detail == nil {
+		return
+	}
+	occurrence, err := s.occurrenceStorageHandler.TakeCurrent(ctx, info.MeetingID, timeutil.GetCurrentTimestampBySecond())
+	if err != nil {
+		return
+	}
+	override, err := s.occurrenceStorageHandler.TakeOverride(ctx, info.MeetingID, occurrence.OriginalStartTime)
+	if err != nil {
+		if !errors.Is(err, errs.ErrRecordNotFound) {
+			log.ZWarn(ctx, "take occurrence override failed", err, "occurrenceID", occurrence.OccurrenceID)
+		}
+		return
+	}
+	s.applyOverrideDetail(detail, override)
+}
@@ -0,0 +1,72 @@
+package meeting
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/constant"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/controller"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	"testing"
+	"time"
+)
+
+// memoryOccurrence keeps the overrides in memory, the other methods are not used by the override checks.
+type memoryOccurrence struct {
+	controller.Occurrence
+	overrides []*model.MeetingOverride
+}
+
+func (m *memoryOccurrence) FindOverrides(_ context.Context, _ []string) ([]*model.MeetingOverride, error) {
+	return m.overrides, nil
+}
+
+func TestIsCurrentOccurrenceCancelled(t *testing.T) {
+	now := time.Now().Unix()
+	// the daily occurrence of today started 10 minutes ago and lasts for an hour
+	current := now - 600
+	info := &model.MeetingInfo{
+		MeetingID:       "1001",
+		ScheduledTime:   current - 10*24*3600,
+		MeetingDuration: 3600,
+		TimeZone:        "UTC",
+		RepeatType:      constant.RepeatDaily,
+	}
+	for _, tt := range []struct {
+		name      string
+		info      *model.MeetingInfo
+		overrides []*model.MeetingOverride
+		want      bool
+	}{
+		{name: "not overridden", info: info},
+		{
+			name:      "current cancelled",
+			info:      info,
+			overrides: []*model.MeetingOverride{{MeetingID: "1001", OriginalStartTime: current, Cancelled: true}},
+			want:      true,
+		},
+		{
+			name:      "previous cancelled",
+			info:      info,
+			overrides: []*model.MeetingOverride{{MeetingID: "1001", OriginalStartTime: current - 24*3600, Cancelled: true}},
+		},
+		{
+			name: "rescheduled into the cancelled time",
+			info: info,
+			overrides: []*model.MeetingOverride{
+				{MeetingID: "1001", OriginalStartTime: current, Cancelled: true},
+				{MeetingID: "1001", OriginalStartTime: current + 24*3600, ScheduledTime: now - 60},
+			},
+		},
+		{
+			name:      "one-off meeting",
+			info:      &model.MeetingInfo{MeetingID: "1002", ScheduledTime: current, MeetingDuration: 3600},
+			overrides: []*model.MeetingOverride{{MeetingID: "1002", OriginalStartTime: current, Cancelled: true}},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &meetingServer{occurrenceStorageHandler: &memoryOccurrence{overrides: tt.overrides}}
+			if got := s.isCurrentOccurrenceCancelled(context.Background(), tt.info); got != tt.want {
+				t.Errorf("isCurrentOccurrenceCancelled() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
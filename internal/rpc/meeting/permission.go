@@ -2,12 +2,157 @@ package meeting
 
 import (
 	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/constant"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	pbmeetingext "github.com/openimsdk/openmeeting-server/pkg/protocol/meetingext"
+	"github.com/openimsdk/openmeeting-server/pkg/rtc"
 	pbmeeting "github.com/openimsdk/protocol/openmeeting/meeting"
 	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
 )
 
-func (s *meetingServer) checkAuthPermission(creatorUserID, hostUserID, requestUserID string) bool {
-	return hostUserID == requestUserID || creatorUserID == requestUserID
+// allPermissions is in the order of the permission matrix returned to the clients
+var allPermissions = []string{
+	constant.PermissionEndMeeting,
+	constant.PermissionMuteOthers,
+	constant.PermissionKick,
+	constant.PermissionRename,
+	constant.PermissionShareScreen,
+	constant.PermissionManageRoles,
+	constant.PermissionAdmitLobby,
+	constant.PermissionLockMeeting,
+	constant.PermissionManageMeeting,
+	constant.PermissionRecord,
+	constant.PermissionLiveStream,
+	constant.PermissionBreakout,
+}
+
+// allRoles is in the order of the permission matrix returned to the clients
+var allRoles = []string{
+	constant.RoleHost,
+	constant.RoleCoHost,
+	constant.RolePresenter,
+	constant.RoleAttendee,
+	constant.RoleViewer,
+}
+
+// rolePermissions is the permission matrix, the attendees share the screen if the meeting setting allows, see checkRoomPermission
+var rolePermissions = map[string][]string{
+	constant.RoleHost: allPermissions,
+	constant.RoleCoHost: {
+		constant.PermissionMuteOthers,
+		constant.PermissionKick,
+		constant.PermissionRename,
+		constant.PermissionShareScreen,
+		constant.PermissionManageRoles,
+		constant.PermissionAdmitLobby,
+		constant.PermissionLockMeeting,
+		constant.PermissionBreakout,
+	},
+	constant.RolePresenter: {constant.PermissionShareScreen},
+	constant.RoleAttendee:  {},
+	constant.RoleViewer:    {},
+}
+
+// getUserRole the host and the co-hosts are kept in the meeting metadata, the presenters and the viewers in the ext data
+func (s *meetingServer) getUserRole(metaData *pbmeeting.MeetingMetadata, ext *pbmeetingext.MeetingMetadataExt, userID string) string {
+	if userID == s.getHostUserID(metaData) || userID == s.getCreatorUserID(metaData) {
+		return constant.RoleHost
+	}
+	if datautil.Contain(userID, metaData.Detail.Info.CreatorDefinedMeeting.CoHostUSerID...) {
+		return constant.RoleCoHost
+	}
+	if role, ok := ext.GetRoles()[userID]; ok {
+		return role
+	}
+	return constant.RoleAttendee
+}
+
+// getHostsUserIDs get the users acting as the host or the co-hosts in the room
+func (s *meetingServer) getHostsUserIDs(metaData *pbmeeting.MeetingMetadata) []string {
+	userIDs := []string{s.getCreatorUserID(metaData), s.getHostUserID(metaData)}
+	return datautil.Distinct(append(userIDs, metaData.Detail.Info.CreatorDefinedMeeting.CoHostUSerID...))
+}
+
+// setUserRole set the role of the user other than the host
+func (s *meetingServer) setUserRole(metaData *pbmeeting.MeetingMetadata, ext *pbmeetingext.MeetingMetadataExt, userID, role string) {
+	coHostUserIDs := datautil.Filter(metaData.Detail.Info.CreatorDefinedMeeting.CoHostUSerID, func(e string) (string, bool) {
+		return e, e != userID
+	})
+	if role == constant.RoleCoHost {
+		coHostUserIDs = append(coHostUserIDs, userID)
+	}
+	metaData.Detail.Info.CreatorDefinedMeeting.CoHostUSerID = coHostUserIDs
+	if ext.Roles == nil {
+		ext.Roles = make(map[string]string)
+	}
+	delete(ext.Roles, userID)
+	if role == constant.RolePresenter || role == constant.RoleViewer {
+		ext.Roles[userID] = role
+	}
+}
+
+// checkRoomParticipant check the user joined the room, the hosts and the co-hosts are regarded as joined
+func (s *meetingServer) checkRoomParticipant(metaData *pbmeeting.MeetingMetadata, userID string) bool {
+	if datautil.Contain(userID, s.getHostsUserIDs(metaData)...) {
+		return true
+	}
+	for _, personalData := range metaData.PersonalData {
+		if personalData.UserID == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// checkRoomPermission check the user has the permission by the role in the room,
+// ext could be nil if the permission is only granted to the host and the co-hosts
+func (s *meetingServer) checkRoomPermission(metaData *pbmeeting.MeetingMetadata, ext *pbmeetingext.MeetingMetadataExt, userID, permission string) bool {
+	role := s.getUserRole(metaData, ext, userID)
+	if role == constant.RoleAttendee && permission == constant.PermissionShareScreen {
+		return metaData.Detail.Setting.GetCanParticipantsShareScreen()
+	}
+	return datautil.Contain(permission, rolePermissions[role]...)
+}
+
+// checkMeetingPermission check the user has the permission in the meeting,
+// only the creator has the permissions if the room of the meeting is not open
+func (s *meetingServer) checkMeetingPermission(ctx context.Context, info *model.MeetingInfo, userID, permission string) bool {
+	if userID == info.CreatorUserID {
+		return true
+	}
+	metaData, ext, err := s.meetingRtc.GetRoomDataExt(ctx, info.MeetingID)
+	if err != nil {
+		return false
+	}
+	return s.checkRoomPermission(metaData, ext, userID, permission)
+}
+
+// checkMeetingReader check the user is the creator, an invitee or a participant of the meeting,
+// the others could not read the schedule of the meeting
+func (s *meetingServer) checkMeetingReader(ctx context.Context, info *model.MeetingInfo, userID string) error {
+	if userID == info.CreatorUserID {
+		return nil
+	}
+	invitations, err := s.invitationStorageHandler.FindByMeetingID(ctx, info.MeetingID)
+	if err != nil {
+		return err
+	}
+	for _, invitation := range invitations {
+		if invitation.UserID == userID {
+			return nil
+		}
+	}
+	if userIDs, err := s.meetingRtc.GetParticipantUserIDs(ctx, info.MeetingID); err == nil && datautil.Contain(userID, userIDs...) {
+		return nil
+	}
+	return s.checkAttended(ctx, info, userID)
+}
+
+// checkOperateParticipant the host could not be operated by the other roles
+func (s *meetingServer) checkOperateParticipant(metaData *pbmeeting.MeetingMetadata, operatorUserID, participantUserID string) bool {
+	return s.getUserRole(metaData, nil, participantUserID) != constant.RoleHost ||
+		s.getUserRole(metaData, nil, operatorUserID) == constant.RoleHost
 }
 
 func (s *meetingServer) checkUserEnableCamera(setting *pbmeeting.MeetingSetting, personalData *pbmeeting.PersonalData) bool {
@@ -25,23 +170,45 @@ func (s *meetingServer) checkUserEnableMicrophone(setting *pbmeeting.MeetingSett
 	return false
 }
 
-func (s *meetingServer) checkUserInMeeting(ctx context.Context, userID string) (bool, error) {
-	rooms, err := s.meetingRtc.GetAllRooms(ctx)
+// checkUserInMeeting check the user is in another meeting by the presence index rather than the rtc server,
+// meetingID itself and its breakout rooms are not another meeting, e.g., reconnecting or returning to the main room
+func (s *meetingServer) checkUserInMeeting(ctx context.Context, userID, meetingID string) (bool, error) {
+	roomIDs, err := s.presenceStorageHandler.FindUserRooms(ctx, userID)
 	if err != nil {
-		return true, err
+		return true, errs.WrapMsg(err, "find user rooms failed")
 	}
 
-	for _, room := range rooms {
-		userIDs, err := s.meetingRtc.GetParticipantUserIDs(ctx, room.Name)
-		if err != nil {
-			return true, errs.WrapMsg(err, "get participants failed")
+	for _, roomID := range roomIDs {
+		if meetingID != "" && roomID == meetingID {
+			continue
 		}
-		//check if user is already in meeting
-		for _, userIdentity := range userIDs {
-			if userIdentity == userID {
-				return true, nil
-			}
+		if parentID, _, ok := rtc.ParseBreakoutRoomID(roomID); ok && parentID == meetingID {
+			continue
 		}
+		return true, nil
 	}
 	return false, nil
 }
+
+// checkUserPresent check the user is connected to the meeting or one of its breakout rooms,
+// the user has passed the admission checks of JoinMeeting then
+func (s *meetingServer) checkUserPresent(ctx context.Context, userID, meetingID string) (bool, error) {
+	roomIDs, err := s.presenceStorageHandler.FindUserRooms(ctx, userID)
+	if err != nil {
+		return false, errs.WrapMsg(err, "find user rooms failed")
+	}
+	for _, roomID := range roomIDs {
+		if roomID == meetingID {
+			return true, nil
+		}
+		if parentID, _, ok := rtc.ParseBreakoutRoomID(roomID); ok && parentID == meetingID {
+			return true, nil
+		}
+	}
+	// the presence index is fed by the webhook, which may not be configured or not arrive yet
+	userIDs, err := s.meetingRtc.GetParticipantUserIDs(ctx, meetingID)
+	if err != nil {
+		return false, errs.WrapMsg(err, "get participants failed", "meetingID", meetingID)
+	}
+	return datautil.Contain(userID, userIDs...), nil
+}
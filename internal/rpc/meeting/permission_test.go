@@ -0,0 +1,156 @@
+package meeting
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/constant"
+	"github.com/openimsdk/openmeeting-server/pkg/common/servererrs"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/controller"
+	pbmeetingext "github.com/openimsdk/openmeeting-server/pkg/protocol/meetingext"
+	"github.com/openimsdk/openmeeting-server/pkg/rtc"
+	pbmeeting "github.com/openimsdk/protocol/openmeeting/meeting"
+	"github.com/openimsdk/tools/errs"
+	"testing"
+)
+
+// memoryPresence keeps the rooms of the users in memory, the other methods are not used by the checks.
+type memoryPresence struct {
+	controller.Presence
+	userRooms map[string][]string
+}
+
+func (m *memoryPresence) FindUserRooms(_ context.Context, userID string) ([]string, error) {
+	return m.userRooms[userID], nil
+}
+
+func newTestRoleMetaData(shareScreen bool) (*pbmeeting.MeetingMetadata, *pbmeetingext.MeetingMetadataExt) {
+	metaData := &pbmeeting.MeetingMetadata{
+		Detail: &pbmeeting.MeetingInfoSetting{
+			Info: &pbmeeting.MeetingInfo{
+				SystemGenerated: &pbmeeting.SystemGeneratedMeetingInfo{CreatorUserID: "1"},
+				CreatorDefinedMeeting: &pbmeeting.CreatorDefinedMeetingInfo{
+					HostUserID:   "1",
+					CoHostUSerID: []string{"2"},
+				},
+			},
+			Setting: &pbmeeting.MeetingSetting{CanParticipantsShareScreen: shareScreen},
+		},
+	}
+	ext := &pbmeetingext.MeetingMetadataExt{Roles: map[string]string{"3": constant.RolePresenter, "5": constant.RoleViewer}}
+	return metaData, ext
+}
+
+func TestCheckRoomPermission(t *testing.T) {
+	s := &meetingServer{}
+	metaData, ext := newTestRoleMetaData(false)
+	for _, tt := range []struct {
+		userID     string
+		permission string
+		want       bool
+	}{
+		{userID: "1", permission: constant.PermissionEndMeeting, want: true},
+		{userID: "1", permission: constant.PermissionRecord, want: true},
+		{userID: "2", permission: constant.PermissionEndMeeting},
+		{userID: "2", permission: constant.PermissionManageRoles, want: true},
+		{userID: "2", permission: constant.PermissionRecord},
+		{userID: "3", permission: constant.PermissionShareScreen, want: true},
+		{userID: "3", permission: constant.PermissionMuteOthers},
+		{userID: "4", permission: constant.PermissionShareScreen},
+		{userID: "4", permission: constant.PermissionKick},
+		{userID: "5", permission: constant.PermissionShareScreen},
+	} {
+		if got := s.checkRoomPermission(metaData, ext, tt.userID, tt.permission); got != tt.want {
+			t.Errorf("checkRoomPermission(%s, %s) = %v, want %v", tt.userID, tt.permission, got, tt.want)
+		}
+	}
+
+	// the attendees share the screen by the meeting setting, the viewers never do
+	metaData, ext = newTestRoleMetaData(true)
+	if !s.checkRoomPermission(metaData, ext, "4", constant.PermissionShareScreen) {
+		t.Error("the attendee could not share the screen allowed by the setting")
+	}
+	if s.checkRoomPermission(metaData, ext, "5", constant.PermissionShareScreen) {
+		t.Error("the viewer shares the screen")
+	}
+}
+
+func TestGetUserRole(t *testing.T) {
+	s := &meetingServer{}
+	metaData, ext := newTestRoleMetaData(false)
+	for userID, want := range map[string]string{
+		"1": constant.RoleHost,
+		"2": constant.RoleCoHost,
+		"3": constant.RolePresenter,
+		"4": constant.RoleAttendee,
+		"5": constant.RoleViewer,
+	} {
+		if got := s.getUserRole(metaData, ext, userID); got != want {
+			t.Errorf("getUserRole(%s) = %s, want %s", userID, got, want)
+		}
+	}
+	// the presenters and the viewers are attendees without the ext data
+	if got := s.getUserRole(metaData, nil, "3"); got != constant.RoleAttendee {
+		t.Errorf("getUserRole() without ext = %s, want %s", got, constant.RoleAttendee)
+	}
+}
+
+func TestCheckSetMeetingRoles(t *testing.T) {
+	s := &meetingServer{}
+	metaData, ext := newTestRoleMetaData(false)
+	for _, tt := range []struct {
+		name    string
+		userIDs []string
+		role    string
+		isHost  bool
+		wantErr errs.CodeError
+	}{
+		{name: "host grants co-host", userIDs: []string{"4"}, role: constant.RoleCoHost, isHost: true},
+		{name: "host revokes co-host", userIDs: []string{"2"}, role: constant.RoleAttendee, isHost: true},
+		{name: "co-host grants presenter", userIDs: []string{"4", "5"}, role: constant.RolePresenter},
+		{name: "co-host keeps the same role", userIDs: []string{"2"}, role: constant.RoleCoHost},
+		{name: "co-host grants co-host", userIDs: []string{"4"}, role: constant.RoleCoHost, wantErr: servererrs.ErrMeetingAuthCheck},
+		{name: "co-host revokes co-host", userIDs: []string{"3", "2"}, role: constant.RoleViewer, wantErr: servererrs.ErrMeetingAuthCheck},
+		{name: "host changes the host", userIDs: []string{"1"}, role: constant.RoleCoHost, isHost: true, wantErr: errs.ErrArgs},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := s.checkSetMeetingRoles(metaData, ext, tt.userIDs, tt.role, tt.isHost)
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("checkSetMeetingRoles() error = %v", err)
+			}
+			if tt.wantErr != nil && !tt.wantErr.Is(err) {
+				t.Fatalf("checkSetMeetingRoles() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckUserInMeeting(t *testing.T) {
+	s := &meetingServer{presenceStorageHandler: &memoryPresence{userRooms: map[string][]string{
+		"1": {"1001"},
+		"2": {rtc.BreakoutRoomID("1001", "b1")},
+		"3": {"1002"},
+		"4": {rtc.BreakoutRoomID("1002", "b1")},
+	}}}
+	for _, tt := range []struct {
+		userID    string
+		meetingID string
+		want      bool
+	}{
+		// reconnecting to the same meeting
+		{userID: "1", meetingID: "1001"},
+		{userID: "2", meetingID: "1001"},
+		{userID: "3", meetingID: "1001", want: true},
+		{userID: "4", meetingID: "1001", want: true},
+		{userID: "5", meetingID: "1001"},
+		// creating a new meeting
+		{userID: "1", want: true},
+		{userID: "5"},
+	} {
+		got, err := s.checkUserInMeeting(context.Background(), tt.userID, tt.meetingID)
+		if err != nil {
+			t.Fatalf("checkUserInMeeting() error = %v", err)
+		}
+		if got != tt.want {
+			t.Errorf("checkUserInMeeting(%s, %q) = %v, want %v", tt.userID, tt.meetingID, got, tt.want)
+		}
+	}
+}
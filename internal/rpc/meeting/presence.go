@@ -0,0 +1,52 @@
+package meeting
+
+import (
+	"context"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/timeutil"
+)
+
+// joinPresence the user is indexed when joining or connected to the room, the user holding the token but never connected
+// is dropped by reconcilePresence
+func (s *meetingServer) joinPresence(ctx context.Context, roomID, userID string) {
+	if err := s.presenceStorageHandler.Join(ctx, roomID, userID); err != nil {
+		log.ZError(ctx, "join presence failed", err, "roomID", roomID, "userID", userID)
+	}
+}
+
+func (s *meetingServer) leavePresence(ctx context.Context, roomID string, userIDs []string) {
+	if err := s.presenceStorageHandler.Leave(ctx, roomID, userIDs); err != nil {
+		log.ZError(ctx, "leave presence failed", err, "roomID", roomID, "userIDs", userIDs)
+	}
+}
+
+func (s *meetingServer) closePresence(ctx context.Context, roomID string) {
+	if err := s.presenceStorageHandler.CloseRoom(ctx, roomID); err != nil {
+		log.ZError(ctx, "close presence failed", err, "roomID", roomID)
+	}
+}
+
+// reconcilePresence correct the presence index by the rooms of the rtc server, e.g., the webhook events lost
+func (s *meetingServer) reconcilePresence(ctx context.Context) {
+	since := timeutil.GetCurrentTimestampByMill()
+	rooms, err := s.meetingRtc.GetAllRooms(ctx)
+	if err != nil {
+		log.ZError(ctx, "get all rooms failed", err)
+		return
+	}
+	presence := make(map[string][]string, len(rooms))
+	var skippedRoomIDs []string
+	for _, room := range rooms {
+		userIDs, err := s.meetingRtc.GetParticipantUserIDs(ctx, room.Name)
+		if err != nil {
+			// the room is reconciled in the next round, the others go on
+			log.ZError(ctx, "get participants failed, skip the room", err, "roomID", room.Name)
+			skippedRoomIDs = append(skippedRoomIDs, room.Name)
+			continue
+		}
+		presence[room.Name] = userIDs
+	}
+	if err := s.presenceStorageHandler.Reconcile(ctx, presence, skippedRoomIDs, since); err != nil {
+		log.ZError(ctx, "reconcile presence failed", err)
+	}
+}
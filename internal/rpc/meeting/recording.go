@@ -0,0 +1,247 @@
+package meeting
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/constant"
+	"github.com/openimsdk/openmeeting-server/pkg/common/securetools"
+	"github.com/openimsdk/openmeeting-server/pkg/common/servererrs"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	pbmeetingext "github.com/openimsdk/openmeeting-server/pkg/protocol/meetingext"
+	"github.com/openimsdk/openmeeting-server/pkg/rtc"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/openimsdk/tools/utils/timeutil"
+	"time"
+)
+
+// recordingStartLockExpire is long enough for the rtc server to start the recording
+const recordingStartLockExpire = 30 * time.Second
+
+// StartMeetingRecording record the room of the meeting in progress, one recording at a time
+func (s *meetingServer) StartMeetingRecording(ctx context.Context, req *pbmeetingext.StartMeetingRecordingReq) (*pbmeetingext.StartMeetingRecordingResp, error) {
+	resp := &pbmeetingext.StartMeetingRecordingResp{}
+	if err := s.checkRecordPermission(ctx, req.MeetingID, req.UserID); err != nil {
+		return resp, err
+	}
+	owner, err := securetools.GenerateToken(16)
+	if err != nil {
+		return resp, err
+	}
+	locked, err := s.recordingStorageHandler.LockStart(ctx, req.MeetingID, owner, recordingStartLockExpire)
+	if err != nil {
+		return resp, err
+	}
+	if !locked {
+		return resp, servererrs.ErrMeetingConflict.WrapMsg("recording of the meeting is being started by others", "meetingID", req.MeetingID)
+	}
+	defer func() {
+		if err := s.recordingStorageHandler.UnlockStart(ctx, req.MeetingID, owner); err != nil {
+			log.ZWarn(ctx, "unlock recording failed", err, "meetingID", req.MeetingID)
+		}
+	}()
+	active, err := s.findLiveRecordings(ctx, req.MeetingID)
+	if err != nil {
+		return resp, err
+	}
+	if len(active) > 0 {
+		return resp, errs.ErrArgs.WrapMsg("meeting is already being recorded", "recordingID", active[0].RecordingID)
+	}
+	started, err := s.meetingRtc.StartRecording(ctx, req.MeetingID)
+	if err != nil {
+		return resp, err
+	}
+	now := timeutil.GetCurrentTimestampBySecond()
+	recording := &model.MeetingRecording{
+		RecordingID: started.RecordingID,
+		MeetingID:   req.MeetingID,
+		StartUserID: req.UserID,
+		Status:      started.Status,
+		StartTime:   now,
+	}
+	if occurrence, err := s.occurrenceStorageHandler.TakeCurrent(ctx, req.MeetingID, now); err == nil {
+		recording.OccurrenceID = occurrence.OccurrenceID
+	}
+	if err := s.recordingStorageHandler.Create(ctx, recording); err != nil {
+		// the recording could not be tracked, so it should not go on
+		if _, stopErr := s.meetingRtc.StopRecording(ctx, started.RecordingID); stopErr != nil {
+			log.ZWarn(ctx, "stop the untracked recording failed", stopErr, "recordingID", started.RecordingID)
+		}
+		return resp, err
+	}
+	resp.Recording = s.generateClientRecording(recording)
+	s.notifyMeetingRecording(ctx, req.MeetingID, req.UserID, resp.Recording)
+	return resp, nil
+}
+
+// StopMeetingRecording stop the recordings in progress, the files are tracked when the rtc server reports the recordings completed
+func (s *meetingServer) StopMeetingRecording(ctx context.Context, req *pbmeetingext.StopMeetingRecordingReq) (*pbmeetingext.StopMeetingRecordingResp, error) {
+	resp := &pbmeetingext.StopMeetingRecordingResp{}
+	if err := s.checkRecordPermission(ctx, req.MeetingID, req.UserID); err != nil {
+		return resp, err
+	}
+	active, err := s.recordingStorageHandler.FindActive(ctx, req.MeetingID)
+	if err != nil {
+		return resp, err
+	}
+	var recordings []*model.MeetingRecording
+	for _, recording := range active {
+		if req.RecordingID == "" || recording.RecordingID == req.RecordingID {
+			recordings = append(recordings, recording)
+		}
+	}
+	if req.RecordingID != "" && len(recordings) == 0 {
+		return resp, errs.ErrArgs.WrapMsg("recording is not in progress", "recordingID", req.RecordingID)
+	}
+	for _, recording := range recordings {
+		stopped, err := s.meetingRtc.StopRecording(ctx, recording.RecordingID)
+		if err != nil && !errs.ErrRecordNotFound.Is(err) {
+			return resp, err
+		}
+		update := map[string]any{"stop_user_id": req.UserID}
+		if stopped == nil {
+			// the rtc server has lost the recording, e.g., it restarted
+			stopped = &rtc.Recording{Status: constant.RecordingStatusFailed, EndTime: timeutil.GetCurrentTimestampBySecond(), Error: "recording not found"}
+		}
+		s.setRecordingUpdate(recording, update, stopped)
+		recording.StopUserID = req.UserID
+		if err := s.recordingStorageHandler.Update(ctx, recording.RecordingID, update); err != nil {
+			return resp, err
+		}
+		clientRecording := s.generateClientRecording(recording)
+		resp.Recordings = append(resp.Recordings, clientRecording)
+		s.notifyMeetingRecording(ctx, req.MeetingID, req.UserID, clientRecording)
+	}
+	return resp, nil
+}
+
+func (s *meetingServer) GetMeetingRecordings(ctx context.Context, req *pbmeetingext.GetMeetingRecordingsReq) (*pbmeetingext.GetMeetingRecordingsResp, error) {
+	resp := &pbmeetingext.GetMeetingRecordingsResp{}
+	info, err := s.meetingStorageHandler.TakeWithError(ctx, req.MeetingID)
+	if err != nil {
+		return resp, errs.WrapMsg(err, "get meeting data failed")
+	}
+	if err := s.checkAttended(ctx, info, req.UserID); err != nil {
+		return resp, err
+	}
+	if req.Pagination == nil {
+		req.Pagination = &sdkws.RequestPagination{PageNumber: 1, ShowNumber: 20}
+	}
+	total, recordings, err := s.recordingStorageHandler.Page(ctx, req.MeetingID, req.OccurrenceID, req.Pagination)
+	if err != nil {
+		return resp, err
+	}
+	resp.Total = total
+	resp.Recordings = datautil.Slice(recordings, s.generateClientRecording)
+	return resp, nil
+}
+
+// findLiveRecordings get the active recordings of the meeting, the ones the rtc server does not have any more are marked failed,
+// e.g., the rtc server restarted without reporting them
+func (s *meetingServer) findLiveRecordings(ctx context.Context, meetingID string) ([]*model.MeetingRecording, error) {
+	active, err := s.recordingStorageHandler.FindActive(ctx, meetingID)
+	if err != nil || len(active) == 0 {
+		return nil, err
+	}
+	live, err := s.meetingRtc.ListRecordings(ctx, meetingID, true)
+	if err != nil {
+		return nil, err
+	}
+	liveIDs := datautil.SliceSet(datautil.Slice(live, func(e *rtc.Recording) string {
+		return e.RecordingID
+	}))
+	var recordings []*model.MeetingRecording
+	for _, recording := range active {
+		if _, ok := liveIDs[recording.RecordingID]; ok {
+			recordings = append(recordings, recording)
+			continue
+		}
+		update := map[string]any{}
+		stale := &rtc.Recording{Status: constant.RecordingStatusFailed, EndTime: timeutil.GetCurrentTimestampBySecond(), Error: "recording not found"}
+		s.setRecordingUpdate(recording, update, stale)
+		if err := s.recordingStorageHandler.Update(ctx, recording.RecordingID, update); err != nil {
+			return nil, err
+		}
+		log.ZWarn(ctx, "recording is lost by the rtc server", nil, "meetingID", meetingID, "recordingID", recording.RecordingID)
+		s.notifyMeetingRecording(ctx, meetingID, "", s.generateClientRecording(recording))
+	}
+	return recordings, nil
+}
+
+// checkRecordPermission only the host records the meeting in progress
+func (s *meetingServer) checkRecordPermission(ctx context.Context, meetingID, userID string) error {
+	metaData, ext, err := s.meetingRtc.GetRoomDataExt(ctx, meetingID)
+	if err != nil {
+		return errs.WrapMsg(err, "get room data failed, only the meeting in progress could be recorded", "meetingID", meetingID)
+	}
+	if !s.checkRoomPermission(metaData, ext, userID, constant.PermissionRecord) {
+		return servererrs.ErrMeetingAuthCheck.WrapMsg("user did not have permission to record the meeting")
+	}
+	return nil
+}
+
+// updateRecording apply the recording reported by the webhook of the rtc server, the participants are notified if its status changed,
+// the egress not recorded by the meeting is ignored
+func (s *meetingServer) updateRecording(ctx context.Context, reported *rtc.Recording) {
+	recording, err := s.recordingStorageHandler.Take(ctx, reported.RecordingID)
+	if err != nil {
+		if !errs.ErrRecordNotFound.Is(err) {
+			log.ZError(ctx, "get recording failed", err, "recordingID", reported.RecordingID)
+		}
+		return
+	}
+	update := map[string]any{}
+	s.setRecordingUpdate(recording, update, reported)
+	if len(update) == 0 {
+		return
+	}
+	if err := s.recordingStorageHandler.Update(ctx, recording.RecordingID, update); err != nil {
+		log.ZError(ctx, "update recording failed", err, "recordingID", recording.RecordingID)
+		return
+	}
+	if _, ok := update["status"]; ok {
+		s.notifyMeetingRecording(ctx, recording.MeetingID, "", s.generateClientRecording(recording))
+	}
+}
+
+// setRecordingUpdate apply the state of the rtc server on the recording and collect the changed fields
+func (s *meetingServer) setRecordingUpdate(recording *model.MeetingRecording, update map[string]any, reported *rtc.Recording) {
+	if reported.Status != "" && reported.Status != recording.Status {
+		recording.Status = reported.Status
+		update["status"] = reported.Status
+	}
+	if reported.EndTime != 0 && recording.EndTime == 0 {
+		recording.EndTime = reported.EndTime
+		update["end_time"] = reported.EndTime
+	}
+	if reported.Error != "" {
+		recording.Error = reported.Error
+		update["error"] = reported.Error
+	}
+	if len(reported.Files) > 0 {
+		recording.Files = nil
+		for _, file := range reported.Files {
+			recording.Files = append(recording.Files, &model.MeetingRecordingFile{
+				Filename: file.Filename,
+				Location: file.Location,
+				Size:     file.Size,
+				Duration: file.Duration,
+			})
+		}
+		update["files"] = recording.Files
+	}
+}
+
+// notifyMeetingRecording send the started or stopped recording to all the participants in the room
+func (s *meetingServer) notifyMeetingRecording(ctx context.Context, roomID, operatorUserID string, recording *pbmeetingext.MeetingRecording) {
+	sendData := &pbmeetingext.NotifyMeetingExtData{
+		OperatorUserID: operatorUserID,
+		MessageType: &pbmeetingext.NotifyMeetingExtData_MeetingRecordingData{MeetingRecordingData: &pbmeetingext.MeetingRecordingData{
+			Recording: recording,
+		}},
+	}
+	if err := s.meetingRtc.SendRoomExtData(ctx, roomID, nil, sendData); err != nil {
+		log.ZWarn(ctx, "send meeting recording data failed", err, "roomID", roomID)
+	}
+}
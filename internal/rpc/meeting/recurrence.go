@@ -0,0 +1,152 @@
+package meeting
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/constant"
+	"github.com/openimsdk/openmeeting-server/pkg/common/recurrence"
+	"github.com/openimsdk/openmeeting-server/pkg/common/servererrs"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	pbmeetingext "github.com/openimsdk/openmeeting-server/pkg/protocol/meetingext"
+	pbmeeting "github.com/openimsdk/protocol/openmeeting/meeting"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// BookRecurringMeeting book a meeting which repeats by the RRULE instead of the repeat type
+func (s *meetingServer) BookRecurringMeeting(ctx context.Context, req *pbmeetingext.BookRecurringMeetingReq) (*pbmeeting.BookMeetingResp, error) {
+	resp := &pbmeeting.BookMeetingResp{}
+	if req.Meeting == nil || req.Meeting.CreatorDefinedMeetingInfo == nil {
+		return resp, errs.ErrArgs.WrapMsg("meeting info is required")
+	}
+	if req.Recurrence == nil {
+		return resp, errs.ErrArgs.WrapMsg("recurrence is required")
+	}
+	rule, err := recurrence.NormalizeRule(req.Recurrence.Rrule)
+	if err != nil {
+		return resp, err
+	}
+	meetingDBInfo, err := s.generateMeetingDBData4Booking(ctx, req.Meeting)
+	if err != nil {
+		return resp, errs.WrapMsg(err, "generate meeting data failed")
+	}
+	setMeetingRecurrence(meetingDBInfo, rule, req.Recurrence.ExDates)
+	if _, err := recurrence.NewSet(meetingDBInfo); err != nil {
+		return resp, err
+	}
+	return s.createBookedMeeting(ctx, meetingDBInfo)
+}
+
+// setMeetingRecurrence makes the meeting repeat by the rule and resets the repeat type based setting,
+// the changed fields are returned for updating the meeting in database.
+func setMeetingRecurrence(info *model.MeetingInfo, rule string, exDates []int64) map[string]any {
+	info.RepeatType = constant.RepeatRRule
+	info.RepeatTimes = 0
+	info.UintType = ""
+	info.Interval = 0
+	info.RepeatDayOfWeek = nil
+	info.RRule = rule
+	info.ExDates = datautil.Distinct(exDates)
+	return map[string]any{
+		"repeat_type":        info.RepeatType,
+		"repeat_times":       info.RepeatTimes,
+		"uint_type":          info.UintType,
+		"interval":           info.Interval,
+		"repeat_day_of_week": info.RepeatDayOfWeek,
+		"rrule":              info.RRule,
+		"ex_dates":           info.ExDates,
+	}
+}
+
+// setRepeatTypeRecurrence applies the recurrence carried by the repeat type of BookMeeting and UpdateMeeting,
+// the exception dates without TZID are in the time zone of the meeting.
+func setRepeatTypeRecurrence(info *model.MeetingInfo, repeatType string) (map[string]any, error) {
+	loc, err := recurrence.Location(info)
+	if err != nil {
+		return nil, err
+	}
+	rule, exDates, err := recurrence.Parse(repeatType, loc)
+	if err != nil {
+		return nil, err
+	}
+	updateData := setMeetingRecurrence(info, rule, exDates)
+	if _, err := recurrence.NewSet(info); err != nil {
+		return nil, err
+	}
+	return updateData, nil
+}
+
+// UpdateMeetingRecurrence replace the recurrence of the meeting, only the creator or the host could do it.
+// The exception dates could also be set on a meeting repeating by the repeat type with an empty rrule.
+func (s *meetingServer) UpdateMeetingRecurrence(ctx context.Context, req *pbmeetingext.UpdateMeetingRecurrenceReq) (*pbmeetingext.UpdateMeetingRecurrenceResp, error) {
+	resp := &pbmeetingext.UpdateMeetingRecurrenceResp{}
+	if req.Recurrence == nil {
+		return resp, errs.ErrArgs.WrapMsg("recurrence is required")
+	}
+	info, err := s.meetingStorageHandler.TakeWithError(ctx, req.MeetingID)
+	if err != nil {
+		return resp, errs.WrapMsg(err, "get meeting data failed")
+	}
+	if info.Status == constant.Completed {
+		return resp, servererrs.ErrMeetingAlreadyCompleted.WrapMsg("meeting is already completed, can not update anymore")
+	}
+
+	if !s.checkMeetingPermission(ctx, info, req.UpdatingUserID, constant.PermissionManageMeeting) {
+		return resp, servererrs.ErrMeetingAuthCheck.WrapMsg("user did not have permission to update the recurrence of the meeting")
+	}
+	metaData, err := s.meetingRtc.GetRoomData(ctx, req.MeetingID)
+	if err != nil {
+		log.ZDebug(ctx, "not found room info in livekit", "meetingID", req.MeetingID)
+	}
+
+	info.ExDates = datautil.Distinct(req.Recurrence.ExDates)
+	updateData := map[string]any{"ex_dates": info.ExDates}
+	if req.Recurrence.Rrule != "" {
+		rule, err := recurrence.NormalizeRule(req.Recurrence.Rrule)
+		if err != nil {
+			return resp, err
+		}
+		updateData = setMeetingRecurrence(info, rule, info.ExDates)
+	} else if !s.isRepeatMeeting(info) {
+		return resp, errs.ErrArgs.WrapMsg("meeting does not repeat", "meetingID", req.MeetingID)
+	}
+	if _, err := recurrence.NewSet(info); err != nil {
+		return resp, err
+	}
+
+	if err := s.meetingStorageHandler.Update(ctx, req.MeetingID, updateData); err != nil {
+		return resp, err
+	}
+	if err := s.regenerateMeetingOccurrences(ctx, req.MeetingID); err != nil {
+		log.ZError(ctx, "regenerate meeting occurrences failed", err, "meetingID", req.MeetingID)
+	}
+	s.notifyUpdatedMeeting(ctx, req.MeetingID, metaData)
+	if metaData == nil {
+		return resp, nil
+	}
+	if err := s.updateMeetingMetaData(ctx, req.MeetingID, metaData); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+// GetMeetingRecurrence get the effective RRULE of the meeting
+func (s *meetingServer) GetMeetingRecurrence(ctx context.Context, req *pbmeetingext.GetMeetingRecurrenceReq) (*pbmeetingext.GetMeetingRecurrenceResp, error) {
+	resp := &pbmeetingext.GetMeetingRecurrenceResp{}
+	info, err := s.meetingStorageHandler.TakeWithError(ctx, req.MeetingID)
+	if err != nil {
+		return resp, errs.WrapMsg(err, "get meeting data failed")
+	}
+	resp.Recurrence = s.generateClientRecurrence(info)
+	return resp, nil
+}
+
+func (s *meetingServer) generateClientRecurrence(info *model.MeetingInfo) *pbmeetingext.MeetingRecurrence {
+	if !s.isRepeatMeeting(info) {
+		return &pbmeetingext.MeetingRecurrence{}
+	}
+	return &pbmeetingext.MeetingRecurrence{
+		Rrule:   recurrence.Rule(info),
+		ExDates: info.ExDates,
+	}
+}
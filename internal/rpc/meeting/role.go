@@ -0,0 +1,156 @@
+package meeting
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/constant"
+	"github.com/openimsdk/openmeeting-server/pkg/common/servererrs"
+	pbmeetingext "github.com/openimsdk/openmeeting-server/pkg/protocol/meetingext"
+	"github.com/openimsdk/openmeeting-server/pkg/rtc"
+	pbmeeting "github.com/openimsdk/protocol/openmeeting/meeting"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// GrantMeetingRole grant the role to the participants, only the host could change the co-hosts
+func (s *meetingServer) GrantMeetingRole(ctx context.Context, req *pbmeetingext.GrantMeetingRoleReq) (*pbmeetingext.GrantMeetingRoleResp, error) {
+	resp := &pbmeetingext.GrantMeetingRoleResp{}
+	if !datautil.Contain(req.Role, constant.RoleCoHost, constant.RolePresenter, constant.RoleAttendee, constant.RoleViewer) {
+		return resp, errs.ErrArgs.WrapMsg("role should be CoHost, Presenter, Attendee or Viewer", "role", req.Role)
+	}
+	if err := s.setMeetingRoles(ctx, req.MeetingID, req.UserID, req.ParticipantUserIDs, req.Role); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+// RevokeMeetingRole the participants become attendees
+func (s *meetingServer) RevokeMeetingRole(ctx context.Context, req *pbmeetingext.RevokeMeetingRoleReq) (*pbmeetingext.RevokeMeetingRoleResp, error) {
+	resp := &pbmeetingext.RevokeMeetingRoleResp{}
+	if err := s.setMeetingRoles(ctx, req.MeetingID, req.UserID, req.ParticipantUserIDs, constant.RoleAttendee); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+// GetMeetingRoles get the roles of the participants, only the participants of the meeting could do it
+func (s *meetingServer) GetMeetingRoles(ctx context.Context, req *pbmeetingext.GetMeetingRolesReq) (*pbmeetingext.GetMeetingRolesResp, error) {
+	resp := &pbmeetingext.GetMeetingRolesResp{}
+	metaData, ext, err := s.meetingRtc.GetRoomDataExt(ctx, req.MeetingID)
+	if err != nil {
+		return resp, errs.WrapMsg(err, "get room data failed", "meetingID", req.MeetingID)
+	}
+	if !s.checkRoomParticipant(metaData, req.UserID) {
+		return resp, servererrs.ErrMeetingAuthCheck.WrapMsg("only the participants could get the roles of the meeting")
+	}
+	userIDs := []string{s.getCreatorUserID(metaData), s.getHostUserID(metaData)}
+	userIDs = append(userIDs, metaData.Detail.Info.CreatorDefinedMeeting.CoHostUSerID...)
+	for _, personalData := range metaData.PersonalData {
+		userIDs = append(userIDs, personalData.UserID)
+	}
+	for userID := range ext.GetRoles() {
+		userIDs = append(userIDs, userID)
+	}
+	for _, userID := range datautil.Distinct(userIDs) {
+		if userID == "" {
+			continue
+		}
+		resp.Roles = append(resp.Roles, &pbmeetingext.MeetingParticipantRole{
+			UserID: userID,
+			Role:   s.getUserRole(metaData, ext, userID),
+		})
+	}
+	for _, role := range allRoles {
+		resp.Matrix = append(resp.Matrix, &pbmeetingext.MeetingRolePermission{
+			Role:        role,
+			Permissions: rolePermissions[role],
+		})
+	}
+	resp.Permissions = datautil.Filter(allPermissions, func(e string) (string, bool) {
+		return e, s.checkRoomPermission(metaData, ext, req.UserID, e)
+	})
+	return resp, nil
+}
+
+// setMeetingRoles set the role of the participants, the host is handed over by SetMeetingHostInfo
+func (s *meetingServer) setMeetingRoles(ctx context.Context, meetingID, operatorUserID string, userIDs []string, role string) error {
+	if len(userIDs) == 0 {
+		return errs.ErrArgs.WrapMsg("participantUserIDs is empty")
+	}
+	metaData, ext, err := s.meetingRtc.GetRoomDataExt(ctx, meetingID)
+	if err != nil {
+		return errs.WrapMsg(err, "get room data failed, only the roles of the meeting in progress could be changed", "meetingID", meetingID)
+	}
+	if !s.checkRoomPermission(metaData, ext, operatorUserID, constant.PermissionManageRoles) {
+		return servererrs.ErrMeetingAuthCheck.WrapMsg("user did not have permission to manage the roles of the meeting")
+	}
+	isHost := s.getUserRole(metaData, ext, operatorUserID) == constant.RoleHost
+	if err := s.checkSetMeetingRoles(metaData, ext, userIDs, role, isHost); err != nil {
+		return err
+	}
+	var changed []*pbmeetingext.MeetingParticipantRole
+	err = s.meetingRtc.ModifyRoomData(ctx, meetingID, func(data *rtc.RoomData) (bool, error) {
+		changed = nil
+		if err := s.checkSetMeetingRoles(data.MetaData, data.Ext, userIDs, role, isHost); err != nil {
+			return false, err
+		}
+		for _, userID := range datautil.Distinct(userIDs) {
+			if s.getUserRole(data.MetaData, data.Ext, userID) == role {
+				continue
+			}
+			s.setUserRole(data.MetaData, data.Ext, userID, role)
+			changed = append(changed, &pbmeetingext.MeetingParticipantRole{UserID: userID, Role: role})
+		}
+		metaData, ext = data.MetaData, data.Ext
+		return len(changed) > 0, nil
+	})
+	if err != nil {
+		return errs.WrapMsg(err, "update meta data failed")
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+	changedUserIDs := datautil.Slice(changed, func(e *pbmeetingext.MeetingParticipantRole) string {
+		return e.UserID
+	})
+	// the roles are saved in the state, so they survive the room being created again
+	if role == constant.RoleCoHost {
+		s.saveCoHostsState(ctx, meetingID, changedUserIDs, nil)
+	} else {
+		s.saveCoHostsState(ctx, meetingID, nil, changedUserIDs)
+	}
+	s.saveRolesState(ctx, meetingID, changedUserIDs, role)
+	s.updatePublishPermission(ctx, meetingID, metaData, ext, changedUserIDs...)
+	s.notifyMeetingRoles(ctx, meetingID, operatorUserID, changed)
+	return nil
+}
+
+// checkSetMeetingRoles the host could not be changed, and only the host could change the co-hosts
+func (s *meetingServer) checkSetMeetingRoles(metaData *pbmeeting.MeetingMetadata, ext *pbmeetingext.MeetingMetadataExt, userIDs []string, role string, isHost bool) error {
+	for _, userID := range userIDs {
+		current := s.getUserRole(metaData, ext, userID)
+		if current == role {
+			continue
+		}
+		if current == constant.RoleHost {
+			return errs.ErrArgs.WrapMsg("the role of the host could not be changed, hand over the host first", "userID", userID)
+		}
+		if !isHost && (current == constant.RoleCoHost || role == constant.RoleCoHost) {
+			return servererrs.ErrMeetingAuthCheck.WrapMsg("only the host could change the co-hosts", "userID", userID)
+		}
+	}
+	return nil
+}
+
+// notifyMeetingRoles send the changed roles to all the participants in the room
+func (s *meetingServer) notifyMeetingRoles(ctx context.Context, roomID, operatorUserID string, roles []*pbmeetingext.MeetingParticipantRole) {
+	sendData := &pbmeetingext.NotifyMeetingExtData{
+		OperatorUserID: operatorUserID,
+		MessageType: &pbmeetingext.NotifyMeetingExtData_MeetingRoleData{MeetingRoleData: &pbmeetingext.MeetingRoleData{
+			Roles: roles,
+		}},
+	}
+	if err := s.meetingRtc.SendRoomExtData(ctx, roomID, nil, sendData); err != nil {
+		log.ZWarn(ctx, "send meeting role data failed", err, "roomID", roomID)
+	}
+}
@@ -0,0 +1,130 @@
+package meeting
+
+import (
+	"context"
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/webhook"
+	"github.com/openimsdk/openmeeting-server/pkg/common/constant"
+	pbmeetingext "github.com/openimsdk/openmeeting-server/pkg/protocol/meetingext"
+	"github.com/openimsdk/openmeeting-server/pkg/rtc"
+	"github.com/openimsdk/tools/log"
+)
+
+// HandleLiveKitWebhook verify the webhook of the LiveKit server and route the event into the room callback
+func (s *meetingServer) HandleLiveKitWebhook(ctx context.Context, req *pbmeetingext.HandleLiveKitWebhookReq) (*pbmeetingext.HandleLiveKitWebhookResp, error) {
+	resp := &pbmeetingext.HandleLiveKitWebhookResp{}
+	event, err := s.meetingRtc.ReceiveWebhook(ctx, req.Body, req.AuthToken)
+	if err != nil {
+		return resp, err
+	}
+	roomID := event.Room.GetName()
+	if roomID == "" {
+		// the egress events carry the room in the egress info
+		roomID = event.EgressInfo.GetRoomName()
+	}
+	if roomID == "" {
+		log.ZDebug(ctx, "livekit webhook without room, ignore", "event", event.Event)
+		return resp, nil
+	}
+	if meetingID, _, ok := rtc.ParseBreakoutRoomID(roomID); ok {
+		s.handleBreakoutWebhook(ctx, meetingID, event)
+		return resp, nil
+	}
+	callback := s.getRoomCallback(roomID)
+	switch event.Event {
+	case webhook.EventRoomStarted:
+		callback.OnRoomStarted(ctx)
+	case webhook.EventRoomFinished:
+		callback.OnRoomDisconnected(ctx)
+		callback.OnMeetingDisconnected(ctx, roomID)
+	case webhook.EventParticipantJoined:
+		phone := event.Participant.GetKind() == livekit.ParticipantInfo_SIP
+		if phone && !s.admitPhoneParticipant(ctx, roomID, event.Participant.GetIdentity()) {
+			break
+		}
+		callback.OnRoomParticipantConnected(ctx, event.Participant.GetIdentity())
+		if phone {
+			s.setPhoneParticipantData(ctx, roomID, event.Participant)
+		}
+	case webhook.EventParticipantLeft:
+		callback.OnRoomParticipantDisconnected(ctx, event.Participant.GetIdentity())
+	case webhook.EventTrackPublished:
+		streamType := video
+		if event.Track.GetType() == livekit.TrackType_AUDIO {
+			streamType = audio
+		}
+		callback.OnMeetingUnmute(ctx, roomID, streamType, false, []string{event.Participant.GetIdentity()})
+	case webhook.EventEgressStarted, webhook.EventEgressUpdated, webhook.EventEgressEnded:
+		if rtc.IsStream(event.EgressInfo) {
+			s.updateLiveStream(ctx, roomID, rtc.NewStream(event.EgressInfo))
+		} else {
+			s.updateRecording(ctx, rtc.NewRecording(event.EgressInfo))
+		}
+	default:
+		log.ZDebug(ctx, "livekit webhook event not handled", "event", event.Event, "roomID", roomID)
+	}
+	return resp, nil
+}
+
+// meetingRoomCallback applies the room events on the stored meeting after the rtc handled them.
+type meetingRoomCallback struct {
+	rtc.CallbackInterface
+	roomID string
+	server *meetingServer
+}
+
+func (s *meetingServer) getRoomCallback(roomID string) rtc.CallbackInterface {
+	m := &meetingRoomCallback{roomID: roomID, server: s}
+	m.CallbackInterface = s.meetingRtc.GetRoomCallback(roomID, m.recordHostHandedOver)
+	return m
+}
+
+func (m *meetingRoomCallback) OnRoomStarted(ctx context.Context) {
+	m.CallbackInterface.OnRoomStarted(ctx)
+	m.server.openDialIn(ctx, m.roomID)
+}
+
+func (m *meetingRoomCallback) OnRoomParticipantConnected(ctx context.Context, userID string) {
+	m.CallbackInterface.OnRoomParticipantConnected(ctx, userID)
+	m.server.joinPresence(ctx, m.roomID, userID)
+	m.server.recordJoin(ctx, m.roomID, userID)
+	m.server.updatePublishPermission(ctx, m.roomID, nil, nil, userID)
+}
+
+func (m *meetingRoomCallback) OnRoomParticipantDisconnected(ctx context.Context, userID string) {
+	m.CallbackInterface.OnRoomParticipantDisconnected(ctx, userID)
+	m.server.leavePresence(ctx, m.roomID, []string{userID})
+	// no-op if the leave is already recorded with the reason, e.g., kicked by the host
+	m.server.recordLeave(ctx, m.roomID, []string{userID}, constant.LeaveReasonLeft)
+	m.server.lowerHandOnLeave(ctx, m.roomID, userID)
+}
+
+// OnMeetingDisconnected completes the meeting whose room finished without EndMeeting, e.g., the room is empty for too long.
+func (m *meetingRoomCallback) OnMeetingDisconnected(ctx context.Context, roomID string) {
+	m.CallbackInterface.OnMeetingDisconnected(ctx, roomID)
+	m.server.closePresence(ctx, roomID)
+	m.server.recordMeetingEnded(ctx, roomID)
+	m.server.closeDialIn(ctx, roomID, false)
+	if err := m.server.closeBreakoutRooms(ctx, roomID, ""); err != nil {
+		log.ZWarn(ctx, "close breakout rooms of finished room failed", err, "roomID", roomID)
+	}
+	info, err := m.server.meetingStorageHandler.TakeWithError(ctx, roomID)
+	if err != nil {
+		// the room of a cancelled meeting finishes after the meeting is deleted
+		log.ZWarn(ctx, "get meeting of finished room failed", err, "roomID", roomID)
+		return
+	}
+	// EndMeeting has completed it already
+	if info.Status != constant.InProgress {
+		return
+	}
+	if err := m.server.completeMeeting(ctx, info); err != nil {
+		log.ZError(ctx, "complete meeting of finished room failed", err, "meetingID", roomID)
+	}
+}
+
+// recordHostHandedOver records the host change the rtc made while handling the event.
+func (m *meetingRoomCallback) recordHostHandedOver(ctx context.Context, previousHostUserID, hostUserID string) {
+	m.server.saveHostState(ctx, m.roomID, hostUserID)
+	m.server.recordHostChange(ctx, m.roomID, previousHostUserID, hostUserID, "")
+}
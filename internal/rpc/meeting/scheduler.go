@@ -0,0 +1,128 @@
+package meeting
+
+import (
+	"context"
+	"fmt"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"os"
+	"time"
+)
+
+const (
+	defaultSchedulerInterval = 30 * time.Second
+	defaultSchedulerLeaseTTL = 90 * time.Second
+	// occurrenceSyncInterval is how often the occurrences of recurring meetings are generated ahead
+	occurrenceSyncInterval = time.Hour
+	// presenceReconcileInterval is how often the presence index is checked against the rooms of the rtc server
+	presenceReconcileInterval = 5 * time.Minute
+)
+
+// meetingScheduler moves meetings between Scheduled, In-Progress and Completed in the background.
+// Only the replica holding the redis leader lease refreshes the status at a time.
+type meetingScheduler struct {
+	server   *meetingServer
+	owner    string
+	interval time.Duration
+	leaseTTL time.Duration
+	// lastOccurrenceSync is zero until this replica generated the occurrences as leader
+	lastOccurrenceSync time.Time
+	// lastPresenceReconcile is zero until this replica reconciled the presence index as leader
+	lastPresenceReconcile time.Time
+}
+
+func newMeetingScheduler(server *meetingServer) *meetingScheduler {
+	interval := time.Duration(server.config.Rpc.Scheduler.Interval) * time.Second
+	if interval <= 0 {
+		interval = defaultSchedulerInterval
+	}
+	leaseTTL := time.Duration(server.config.Rpc.Scheduler.LeaseTTL) * time.Second
+	if leaseTTL <= interval {
+		leaseTTL = max(defaultSchedulerLeaseTTL, interval*3)
+	}
+	hostname, _ := os.Hostname()
+	return &meetingScheduler{
+		server:   server,
+		owner:    fmt.Sprintf("%s_%d_%d", hostname, os.Getpid(), time.Now().UnixNano()),
+		interval: interval,
+		leaseTTL: leaseTTL,
+	}
+}
+
+func (m *meetingScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			releaseCtx := mcontext.NewCtx("meeting_scheduler_release")
+			if err := m.server.meetingStorageHandler.ReleaseSchedulerLease(releaseCtx, m.owner); err != nil {
+				log.ZWarn(releaseCtx, "release scheduler lease failed", err, "owner", m.owner)
+			}
+			return
+		case <-ticker.C:
+			m.runOnce()
+		}
+	}
+}
+
+func (m *meetingScheduler) runOnce() {
+	ctx, cancel := context.WithCancel(mcontext.NewCtx(fmt.Sprintf("meeting_scheduler_%d", time.Now().UnixMilli())))
+	defer cancel()
+	leader, err := m.server.meetingStorageHandler.AcquireSchedulerLease(ctx, m.owner, m.leaseTTL)
+	if err != nil {
+		log.ZError(ctx, "acquire scheduler lease failed", err, "owner", m.owner)
+		return
+	}
+	if !leader {
+		log.ZDebug(ctx, "scheduler lease held by another replica", "owner", m.owner)
+		return
+	}
+	go m.keepLease(ctx, cancel)
+	steps := []func(ctx context.Context){
+		m.server.refreshMeetingStatus,
+		m.server.refreshOccurrenceStatus,
+		m.server.remindStartingOccurrences,
+		m.server.endOverdueMeetings,
+		m.server.closeOverdueBreakouts,
+	}
+	if time.Since(m.lastOccurrenceSync) >= occurrenceSyncInterval {
+		steps = append(steps, func(ctx context.Context) {
+			m.server.syncAllMeetingOccurrences(ctx)
+			m.lastOccurrenceSync = time.Now()
+		})
+	}
+	if time.Since(m.lastPresenceReconcile) >= presenceReconcileInterval {
+		steps = append(steps, func(ctx context.Context) {
+			m.server.reconcilePresence(ctx)
+			m.lastPresenceReconcile = time.Now()
+		})
+	}
+	for _, step := range steps {
+		if ctx.Err() != nil {
+			log.ZWarn(ctx, "scheduler lease lost, the round is left to the new leader", ctx.Err(), "owner", m.owner)
+			return
+		}
+		step(ctx)
+	}
+}
+
+// keepLease renew the lease while the round is running, ctx is cancelled once the lease is lost,
+// so a long round never overlaps with the one of the new leader
+func (m *meetingScheduler) keepLease(ctx context.Context, cancel context.CancelFunc) {
+	ticker := time.NewTicker(m.leaseTTL / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			leader, err := m.server.meetingStorageHandler.AcquireSchedulerLease(ctx, m.owner, m.leaseTTL)
+			if err != nil || !leader {
+				log.ZWarn(ctx, "renew scheduler lease failed", err, "owner", m.owner, "leader", leader)
+				cancel()
+				return
+			}
+		}
+	}
+}
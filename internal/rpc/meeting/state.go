@@ -0,0 +1,174 @@
+package meeting
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/constant"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	pbmeetingext "github.com/openimsdk/openmeeting-server/pkg/protocol/meetingext"
+	"github.com/openimsdk/openmeeting-server/pkg/rtc"
+	pbmeeting "github.com/openimsdk/protocol/openmeeting/meeting"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// the state is saved after the room data is updated, so it never gets ahead of the room even if the update fails,
+// the change failed to save only lives in the room then, which is logged
+
+// saveHostState hand the host over in the state of the meeting, the host is not one of the co-hosts any more
+func (s *meetingServer) saveHostState(ctx context.Context, meetingID, hostUserID string) {
+	if err := s.stateStorageHandler.SetHost(ctx, meetingID, hostUserID); err != nil {
+		log.ZError(ctx, "save host state failed", err, "meetingID", meetingID, "hostUserID", hostUserID)
+	}
+}
+
+// saveCoHostsState add and remove the co-hosts in the state of the meeting
+func (s *meetingServer) saveCoHostsState(ctx context.Context, meetingID string, addUserIDs, removeUserIDs []string) {
+	if err := s.stateStorageHandler.UpdateCoHosts(ctx, meetingID, addUserIDs, removeUserIDs); err != nil {
+		log.ZError(ctx, "save co-hosts state failed", err, "meetingID", meetingID, "addUserIDs", addUserIDs, "removeUserIDs", removeUserIDs)
+	}
+}
+
+// saveRolesState set the presenters and the viewers in the state of the meeting, the users of the other roles are removed from them
+func (s *meetingServer) saveRolesState(ctx context.Context, meetingID string, userIDs []string, role string) {
+	if role != constant.RolePresenter && role != constant.RoleViewer {
+		role = ""
+	}
+	if err := s.stateStorageHandler.UpdateRoles(ctx, meetingID, userIDs, role); err != nil {
+		log.ZError(ctx, "save roles state failed", err, "meetingID", meetingID, "userIDs", userIDs, "role", role)
+	}
+}
+
+// saveParticipantState update the personal data of the participant in the state, the keys of updateData are the fields of
+// model.MeetingParticipantState, the participant without the saved state starts from personalData in the room
+func (s *meetingServer) saveParticipantState(ctx context.Context, meetingID string, personalData *pbmeeting.PersonalData, updateData map[string]any) {
+	defaultData := s.generateParticipantState(meetingID, personalData)
+	if err := s.stateStorageHandler.UpdateParticipant(ctx, defaultData, updateData); err != nil {
+		log.ZError(ctx, "save participant state failed", err, "meetingID", meetingID, "userID", personalData.UserID)
+	}
+}
+
+// getRoomPersonalData get the personal data of the user in the room, the default one if the user has none
+func (s *meetingServer) getRoomPersonalData(metaData *pbmeeting.MeetingMetadata, userID string) *pbmeeting.PersonalData {
+	for _, one := range metaData.PersonalData {
+		if one.GetUserID() == userID {
+			return one
+		}
+	}
+	return s.generateDefaultPersonalData(userID)
+}
+
+// clearMeetingState the next session of the meeting starts without the state of the ended one
+func (s *meetingServer) clearMeetingState(ctx context.Context, meetingID string) {
+	if err := s.stateStorageHandler.Delete(ctx, meetingID); err != nil {
+		log.ZError(ctx, "delete meeting state failed", err, "meetingID", meetingID)
+	}
+}
+
+// applyMeetingState project the saved state onto the metadata of the room, e.g., the room is created again
+// while the meeting is in progress, the metadata is kept if no state is saved
+func (s *meetingServer) applyMeetingState(ctx context.Context, meetingID string, metaData *pbmeeting.MeetingMetadata) error {
+	state, err := s.stateStorageHandler.Take(ctx, meetingID)
+	if err != nil && !errs.ErrRecordNotFound.Is(err) {
+		return errs.WrapMsg(err, "get meeting state failed", "meetingID", meetingID)
+	}
+	if state != nil {
+		s.applyHostState(metaData.Detail.Info.CreatorDefinedMeeting, state)
+	}
+	participants, err := s.stateStorageHandler.FindParticipants(ctx, meetingID)
+	if err != nil {
+		return errs.WrapMsg(err, "find participant states failed", "meetingID", meetingID)
+	}
+	for _, participant := range participants {
+		personalData := s.generatePersonalData(participant)
+		var found bool
+		for i, one := range metaData.PersonalData {
+			if one.GetUserID() == participant.UserID {
+				metaData.PersonalData[i] = personalData
+				found = true
+				break
+			}
+		}
+		if !found {
+			metaData.PersonalData = append(metaData.PersonalData, personalData)
+		}
+	}
+	return nil
+}
+
+// applyHostState the host saved empty is not handed over, and the co-hosts are kept if they are never saved
+func (s *meetingServer) applyHostState(info *pbmeeting.CreatorDefinedMeetingInfo, state *model.MeetingState) {
+	if state.CoHostsSaved {
+		info.CoHostUSerID = state.CoHostUserIDs
+	}
+	if state.HostUserID != "" {
+		info.HostUserID = state.HostUserID
+		info.CoHostUSerID = datautil.Filter(info.CoHostUSerID, func(e string) (string, bool) {
+			return e, e != state.HostUserID
+		})
+	}
+}
+
+// applyRolesState project the saved presenters and viewers onto the ext data of the room created again,
+// which is not part of the metadata the room is created with
+func (s *meetingServer) applyRolesState(ctx context.Context, meetingID string) {
+	state, err := s.stateStorageHandler.Take(ctx, meetingID)
+	if err != nil {
+		if !errs.ErrRecordNotFound.Is(err) {
+			log.ZError(ctx, "get meeting state failed", err, "meetingID", meetingID)
+		}
+		return
+	}
+	if len(state.Roles) == 0 {
+		return
+	}
+	err = s.meetingRtc.ModifyRoomData(ctx, meetingID, func(data *rtc.RoomData) (bool, error) {
+		if data.Ext == nil {
+			data.Ext = &pbmeetingext.MeetingMetadataExt{}
+		}
+		return s.applyRoles(data.Ext, state.Roles), nil
+	})
+	if err != nil {
+		log.ZError(ctx, "apply roles state failed", err, "meetingID", meetingID)
+	}
+}
+
+// applyRoles the roles changed in the room meanwhile are overwritten by the saved ones, which are saved after the room
+func (s *meetingServer) applyRoles(ext *pbmeetingext.MeetingMetadataExt, roles []*model.MeetingUserRole) bool {
+	if ext.Roles == nil {
+		ext.Roles = make(map[string]string)
+	}
+	var changed bool
+	for _, one := range roles {
+		if ext.Roles[one.UserID] != one.Role {
+			ext.Roles[one.UserID] = one.Role
+			changed = true
+		}
+	}
+	return changed
+}
+
+func (s *meetingServer) generateParticipantState(meetingID string, personalData *pbmeeting.PersonalData) *model.MeetingParticipantState {
+	return &model.MeetingParticipantState{
+		MeetingID:              meetingID,
+		UserID:                 personalData.UserID,
+		CameraOnEntry:          personalData.PersonalSetting.GetCameraOnEntry(),
+		MicrophoneOnEntry:      personalData.PersonalSetting.GetMicrophoneOnEntry(),
+		LimitCameraOnEntry:     personalData.LimitSetting == nil || personalData.LimitSetting.CameraOnEntry,
+		LimitMicrophoneOnEntry: personalData.LimitSetting == nil || personalData.LimitSetting.MicrophoneOnEntry,
+	}
+}
+
+func (s *meetingServer) generatePersonalData(participant *model.MeetingParticipantState) *pbmeeting.PersonalData {
+	return &pbmeeting.PersonalData{
+		UserID: participant.UserID,
+		PersonalSetting: &pbmeeting.PersonalMeetingSetting{
+			CameraOnEntry:     participant.CameraOnEntry,
+			MicrophoneOnEntry: participant.MicrophoneOnEntry,
+		},
+		LimitSetting: &pbmeeting.PersonalMeetingSetting{
+			CameraOnEntry:     participant.LimitCameraOnEntry,
+			MicrophoneOnEntry: participant.LimitMicrophoneOnEntry,
+		},
+	}
+}
@@ -0,0 +1,196 @@
+package meeting
+
+import (
+	"context"
+	"fmt"
+	"github.com/openimsdk/openmeeting-server/pkg/common/constant"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/controller"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	pbmeetingext "github.com/openimsdk/openmeeting-server/pkg/protocol/meetingext"
+	pbmeeting "github.com/openimsdk/protocol/openmeeting/meeting"
+	"github.com/openimsdk/tools/errs"
+	"google.golang.org/protobuf/proto"
+	"reflect"
+	"testing"
+)
+
+// memoryState keeps the saved state in memory, the other methods are not used by the projection.
+type memoryState struct {
+	controller.MeetingState
+	state        *model.MeetingState
+	participants []*model.MeetingParticipantState
+}
+
+func (m *memoryState) Take(_ context.Context, meetingID string) (*model.MeetingState, error) {
+	if m.state == nil {
+		return nil, errs.ErrRecordNotFound.WrapMsg("meeting state not found", "meetingID", meetingID)
+	}
+	return m.state, nil
+}
+
+func (m *memoryState) FindParticipants(_ context.Context, _ string) ([]*model.MeetingParticipantState, error) {
+	return m.participants, nil
+}
+
+func newTestMetaData(personalData ...*pbmeeting.PersonalData) *pbmeeting.MeetingMetadata {
+	return &pbmeeting.MeetingMetadata{
+		Detail: &pbmeeting.MeetingInfoSetting{
+			Info: &pbmeeting.MeetingInfo{
+				CreatorDefinedMeeting: &pbmeeting.CreatorDefinedMeetingInfo{
+					HostUserID:   "1",
+					CoHostUSerID: []string{"2"},
+				},
+			},
+		},
+		PersonalData: personalData,
+	}
+}
+
+func newTestPersonalData(userID string, camera, microphone, limitCamera, limitMicrophone bool) *pbmeeting.PersonalData {
+	return &pbmeeting.PersonalData{
+		UserID:          userID,
+		PersonalSetting: &pbmeeting.PersonalMeetingSetting{CameraOnEntry: camera, MicrophoneOnEntry: microphone},
+		LimitSetting:    &pbmeeting.PersonalMeetingSetting{CameraOnEntry: limitCamera, MicrophoneOnEntry: limitMicrophone},
+	}
+}
+
+func TestApplyMeetingState(t *testing.T) {
+	s := &meetingServer{stateStorageHandler: &memoryState{
+		state: &model.MeetingState{MeetingID: "1001", HostUserID: "3", CoHostUserIDs: []string{"4", "5"}, CoHostsSaved: true},
+		participants: []*model.MeetingParticipantState{
+			{MeetingID: "1001", UserID: "2", CameraOnEntry: true, LimitCameraOnEntry: true},
+			{MeetingID: "1001", UserID: "6", MicrophoneOnEntry: true, LimitMicrophoneOnEntry: true},
+		},
+	}}
+	metaData := newTestMetaData(
+		newTestPersonalData("1", true, true, true, true),
+		newTestPersonalData("2", false, false, true, true),
+	)
+	if err := s.applyMeetingState(context.Background(), "1001", metaData); err != nil {
+		t.Fatalf("applyMeetingState() error = %v", err)
+	}
+
+	want := newTestMetaData(
+		// the participant without the saved state is kept
+		newTestPersonalData("1", true, true, true, true),
+		newTestPersonalData("2", true, false, true, false),
+		newTestPersonalData("6", false, true, false, true),
+	)
+	want.Detail.Info.CreatorDefinedMeeting.HostUserID = "3"
+	want.Detail.Info.CreatorDefinedMeeting.CoHostUSerID = []string{"4", "5"}
+	if !proto.Equal(metaData, want) {
+		t.Errorf("applyMeetingState() =\n%v\nwant\n%v", metaData, want)
+	}
+}
+
+func TestApplyMeetingStateWithoutState(t *testing.T) {
+	s := &meetingServer{stateStorageHandler: &memoryState{}}
+	metaData := newTestMetaData(newTestPersonalData("1", true, false, true, true))
+	want := proto.Clone(metaData)
+	if err := s.applyMeetingState(context.Background(), "1001", metaData); err != nil {
+		t.Fatalf("applyMeetingState() error = %v", err)
+	}
+	if !proto.Equal(metaData, want) {
+		t.Errorf("applyMeetingState() =\n%v\nwant the metadata kept\n%v", metaData, want)
+	}
+}
+
+func TestApplyMeetingStateEmptyHost(t *testing.T) {
+	// the state created by the co-hosts only does not hand the host over
+	s := &meetingServer{stateStorageHandler: &memoryState{
+		state: &model.MeetingState{MeetingID: "1001", CoHostsSaved: true},
+	}}
+	metaData := newTestMetaData()
+	if err := s.applyMeetingState(context.Background(), "1001", metaData); err != nil {
+		t.Fatalf("applyMeetingState() error = %v", err)
+	}
+	info := metaData.Detail.Info.CreatorDefinedMeeting
+	if info.HostUserID != "1" || len(info.CoHostUSerID) != 0 {
+		t.Errorf("host = %q, co-hosts = %v, want host 1 without co-hosts", info.HostUserID, info.CoHostUSerID)
+	}
+}
+
+func TestApplyMeetingStateCoHostsNotSaved(t *testing.T) {
+	// the state created by the host only does not know the co-hosts
+	for _, tt := range []struct {
+		hostUserID  string
+		wantCoHosts []string
+	}{
+		{hostUserID: "3", wantCoHosts: []string{"2"}},
+		// the co-host handed the host over is not one of the co-hosts any more
+		{hostUserID: "2"},
+	} {
+		s := &meetingServer{stateStorageHandler: &memoryState{
+			state: &model.MeetingState{MeetingID: "1001", HostUserID: tt.hostUserID},
+		}}
+		metaData := newTestMetaData()
+		if err := s.applyMeetingState(context.Background(), "1001", metaData); err != nil {
+			t.Fatalf("applyMeetingState() error = %v", err)
+		}
+		info := metaData.Detail.Info.CreatorDefinedMeeting
+		if info.HostUserID != tt.hostUserID || fmt.Sprint(info.CoHostUSerID) != fmt.Sprint(tt.wantCoHosts) {
+			t.Errorf("host = %q, co-hosts = %v, want host %s with co-hosts %v", info.HostUserID, info.CoHostUSerID, tt.hostUserID, tt.wantCoHosts)
+		}
+	}
+}
+
+func TestApplyRoles(t *testing.T) {
+	s := &meetingServer{}
+	ext := &pbmeetingext.MeetingMetadataExt{}
+	roles := []*model.MeetingUserRole{
+		{UserID: "3", Role: constant.RolePresenter},
+		{UserID: "5", Role: constant.RoleViewer},
+	}
+	if !s.applyRoles(ext, roles) {
+		t.Error("applyRoles() = false, want the roles changed")
+	}
+	want := map[string]string{"3": constant.RolePresenter, "5": constant.RoleViewer}
+	if !reflect.DeepEqual(ext.Roles, want) {
+		t.Errorf("roles = %v, want %v", ext.Roles, want)
+	}
+	// the room having the saved roles is not written again
+	if s.applyRoles(ext, roles) {
+		t.Error("applyRoles() = true, want the roles not changed")
+	}
+}
+
+func TestGenerateParticipantState(t *testing.T) {
+	s := &meetingServer{}
+	got := s.generateParticipantState("1001", newTestPersonalData("2", true, false, false, true))
+	want := &model.MeetingParticipantState{
+		MeetingID:              "1001",
+		UserID:                 "2",
+		CameraOnEntry:          true,
+		LimitMicrophoneOnEntry: true,
+	}
+	if *got != *want {
+		t.Errorf("generateParticipantState() = %+v, want %+v", got, want)
+	}
+
+	// the participant without the limit is not limited
+	got = s.generateParticipantState("1001", &pbmeeting.PersonalData{UserID: "3"})
+	want = &model.MeetingParticipantState{
+		MeetingID:              "1001",
+		UserID:                 "3",
+		LimitCameraOnEntry:     true,
+		LimitMicrophoneOnEntry: true,
+	}
+	if *got != *want {
+		t.Errorf("generateParticipantState() = %+v, want %+v", got, want)
+	}
+}
+
+func TestGeneratePersonalData(t *testing.T) {
+	s := &meetingServer{}
+	for _, personalData := range []*pbmeeting.PersonalData{
+		newTestPersonalData("2", true, false, false, true),
+		newTestPersonalData("3", false, true, true, false),
+		s.generateDefaultPersonalData("4"),
+	} {
+		// the personal data survives the round trip through the state
+		got := s.generatePersonalData(s.generateParticipantState("1001", personalData))
+		if !proto.Equal(got, personalData) {
+			t.Errorf("generatePersonalData() = %v, want %v", got, personalData)
+		}
+	}
+}
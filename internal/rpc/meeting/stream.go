@@ -0,0 +1,253 @@
+package meeting
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/openimsdk/openmeeting-server/pkg/common/constant"
+	"github.com/openimsdk/openmeeting-server/pkg/common/servererrs"
+	pbmeetingext "github.com/openimsdk/openmeeting-server/pkg/protocol/meetingext"
+	"github.com/openimsdk/openmeeting-server/pkg/rtc"
+	pbmeeting "github.com/openimsdk/protocol/openmeeting/meeting"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/openimsdk/tools/utils/timeutil"
+	"net/url"
+	"strings"
+)
+
+// AddMeetingStreamOutputs push the meeting in progress to the rtmp urls, the live stream starts with the first outputs
+func (s *meetingServer) AddMeetingStreamOutputs(ctx context.Context, req *pbmeetingext.AddMeetingStreamOutputsReq) (*pbmeetingext.AddMeetingStreamOutputsResp, error) {
+	resp := &pbmeetingext.AddMeetingStreamOutputsResp{}
+	if len(req.Urls) == 0 {
+		return resp, errs.ErrArgs.WrapMsg("urls is empty")
+	}
+	_, ext, err := s.getLiveStreamRoomData(ctx, req.MeetingID, req.UserID)
+	if err != nil {
+		return resp, err
+	}
+	var (
+		urls    []string
+		outputs []*pbmeetingext.MeetingStreamOutput
+	)
+	now := timeutil.GetCurrentTimestampBySecond()
+	for _, rawURL := range datautil.Distinct(req.Urls) {
+		maskedURL, err := maskStreamURL(rawURL)
+		if err != nil {
+			return resp, err
+		}
+		outputID := streamOutputID(rawURL)
+		if s.findStreamOutput(ext.LiveStream, outputID) >= 0 {
+			continue
+		}
+		urls = append(urls, rawURL)
+		outputs = append(outputs, &pbmeetingext.MeetingStreamOutput{
+			OutputID:  outputID,
+			Url:       maskedURL,
+			AddUserID: req.UserID,
+			AddTime:   now,
+		})
+	}
+	if len(urls) == 0 {
+		resp.LiveStream = ext.LiveStream
+		return resp, nil
+	}
+	var started *rtc.Stream
+	if ext.LiveStream == nil {
+		if started, err = s.meetingRtc.StartStream(ctx, req.MeetingID, urls); err != nil {
+			return resp, err
+		}
+	} else if _, err := s.meetingRtc.UpdateStream(ctx, ext.LiveStream.StreamID, urls, nil); err != nil {
+		return resp, err
+	}
+	err = s.meetingRtc.ModifyRoomData(ctx, req.MeetingID, func(data *rtc.RoomData) (bool, error) {
+		if started != nil {
+			if data.Ext.LiveStream != nil {
+				return false, errs.ErrArgs.WrapMsg("live stream is started by others at the same time", "meetingID", req.MeetingID)
+			}
+			data.Ext.LiveStream = &pbmeetingext.MeetingLiveStream{
+				StreamID:    started.StreamID,
+				Status:      started.Status,
+				StartUserID: req.UserID,
+				StartTime:   now,
+			}
+		} else if data.Ext.LiveStream.GetStreamID() != ext.LiveStream.StreamID {
+			return false, errs.ErrArgs.WrapMsg("live stream is stopped at the same time", "meetingID", req.MeetingID)
+		}
+		for _, output := range outputs {
+			if s.findStreamOutput(data.Ext.LiveStream, output.OutputID) < 0 {
+				data.Ext.LiveStream.Outputs = append(data.Ext.LiveStream.Outputs, output)
+			}
+		}
+		resp.LiveStream = data.Ext.LiveStream
+		return true, nil
+	})
+	if err != nil {
+		// the stream could not be tracked, so it should not go on
+		if started != nil {
+			if stopErr := s.meetingRtc.StopStream(ctx, started.StreamID); stopErr != nil {
+				log.ZWarn(ctx, "stop the untracked stream failed", stopErr, "streamID", started.StreamID)
+			}
+		}
+		return resp, errs.WrapMsg(err, "update meta data failed")
+	}
+	s.notifyMeetingLiveStream(ctx, req.MeetingID, req.UserID, resp.LiveStream)
+	return resp, nil
+}
+
+// RemoveMeetingStreamOutputs stop pushing the meeting to the rtmp urls, the live stream stops without outputs
+func (s *meetingServer) RemoveMeetingStreamOutputs(ctx context.Context, req *pbmeetingext.RemoveMeetingStreamOutputsReq) (*pbmeetingext.RemoveMeetingStreamOutputsResp, error) {
+	resp := &pbmeetingext.RemoveMeetingStreamOutputsResp{}
+	_, ext, err := s.getLiveStreamRoomData(ctx, req.MeetingID, req.UserID)
+	if err != nil {
+		return resp, err
+	}
+	stream := ext.LiveStream
+	if stream == nil {
+		return resp, errs.ErrArgs.WrapMsg("meeting is not streamed", "meetingID", req.MeetingID)
+	}
+	removed := make(map[string]struct{})
+	for _, rawURL := range req.Urls {
+		outputID := streamOutputID(rawURL)
+		if s.findStreamOutput(stream, outputID) < 0 {
+			return resp, errs.ErrArgs.WrapMsg("url is not an output of the live stream", "meetingID", req.MeetingID)
+		}
+		removed[outputID] = struct{}{}
+	}
+	outputs := datautil.Filter(stream.Outputs, func(e *pbmeetingext.MeetingStreamOutput) (*pbmeetingext.MeetingStreamOutput, bool) {
+		_, ok := removed[e.OutputID]
+		return e, len(req.Urls) > 0 && !ok
+	})
+	stopped := len(outputs) == 0
+	if stopped {
+		if err := s.meetingRtc.StopStream(ctx, stream.StreamID); err != nil && !errs.ErrRecordNotFound.Is(err) {
+			return resp, err
+		}
+	} else if _, err := s.meetingRtc.UpdateStream(ctx, stream.StreamID, nil, datautil.Distinct(req.Urls)); err != nil {
+		return resp, err
+	}
+	err = s.meetingRtc.ModifyRoomData(ctx, req.MeetingID, func(data *rtc.RoomData) (bool, error) {
+		current := data.Ext.LiveStream
+		if current.GetStreamID() != stream.StreamID {
+			// the stream ended meanwhile
+			return false, nil
+		}
+		current.Outputs = datautil.Filter(current.Outputs, func(e *pbmeetingext.MeetingStreamOutput) (*pbmeetingext.MeetingStreamOutput, bool) {
+			_, ok := removed[e.OutputID]
+			return e, !stopped && !ok
+		})
+		if stopped {
+			data.Ext.LiveStream = nil
+			current.Status = constant.RecordingStatusEnding
+		}
+		stream = current
+		return true, nil
+	})
+	if err != nil {
+		return resp, errs.WrapMsg(err, "update meta data failed")
+	}
+	s.notifyMeetingLiveStream(ctx, req.MeetingID, req.UserID, stream)
+	if !stopped {
+		resp.LiveStream = stream
+	}
+	return resp, nil
+}
+
+// getLiveStreamRoomData only the host streams the meeting in progress
+func (s *meetingServer) getLiveStreamRoomData(ctx context.Context, meetingID, userID string) (*pbmeeting.MeetingMetadata, *pbmeetingext.MeetingMetadataExt, error) {
+	metaData, ext, err := s.meetingRtc.GetRoomDataExt(ctx, meetingID)
+	if err != nil {
+		return nil, nil, errs.WrapMsg(err, "get room data failed, only the meeting in progress could be streamed", "meetingID", meetingID)
+	}
+	if !s.checkRoomPermission(metaData, ext, userID, constant.PermissionLiveStream) {
+		return nil, nil, servererrs.ErrMeetingAuthCheck.WrapMsg("user did not have permission to stream the meeting")
+	}
+	return metaData, ext, nil
+}
+
+func (s *meetingServer) findStreamOutput(stream *pbmeetingext.MeetingLiveStream, outputID string) int {
+	for i, output := range stream.GetOutputs() {
+		if output.OutputID == outputID {
+			return i
+		}
+	}
+	return -1
+}
+
+// updateLiveStream apply the stream reported by the webhook of the rtc server, the ended stream is removed from the room
+func (s *meetingServer) updateLiveStream(ctx context.Context, roomID string, reported *rtc.Stream) {
+	var stream *pbmeetingext.MeetingLiveStream
+	err := s.meetingRtc.ModifyRoomData(ctx, roomID, func(data *rtc.RoomData) (bool, error) {
+		stream = nil
+		current := data.Ext.LiveStream
+		if current == nil || current.StreamID != reported.StreamID {
+			return false, nil
+		}
+		if current.Status == reported.Status && current.Error == reported.Error {
+			return false, nil
+		}
+		current.Status = reported.Status
+		current.Error = reported.Error
+		if reported.Status == constant.RecordingStatusComplete || reported.Status == constant.RecordingStatusFailed {
+			data.Ext.LiveStream = nil
+		}
+		stream = current
+		return true, nil
+	})
+	if err != nil {
+		if errs.ErrRecordNotFound.Is(err) {
+			log.ZDebug(ctx, "room is closed, no need to update the live stream", "roomID", roomID, "streamID", reported.StreamID)
+			return
+		}
+		log.ZWarn(ctx, "update the live stream failed", err, "roomID", roomID, "streamID", reported.StreamID)
+		return
+	}
+	if stream != nil {
+		s.notifyMeetingLiveStream(ctx, roomID, "", stream)
+	}
+}
+
+// stopLiveStream stop the live stream before the room is closed, the metadata is gone with the room
+func (s *meetingServer) stopLiveStream(ctx context.Context, roomID string) {
+	_, ext, err := s.meetingRtc.GetRoomDataExt(ctx, roomID)
+	if err != nil || ext.LiveStream == nil {
+		return
+	}
+	if err := s.meetingRtc.StopStream(ctx, ext.LiveStream.StreamID); err != nil && !errs.ErrRecordNotFound.Is(err) {
+		log.ZWarn(ctx, "stop the live stream failed", err, "roomID", roomID, "streamID", ext.LiveStream.StreamID)
+	}
+}
+
+// notifyMeetingLiveStream send the live stream to all the participants in the room
+func (s *meetingServer) notifyMeetingLiveStream(ctx context.Context, roomID, operatorUserID string, stream *pbmeetingext.MeetingLiveStream) {
+	sendData := &pbmeetingext.NotifyMeetingExtData{
+		OperatorUserID: operatorUserID,
+		MessageType: &pbmeetingext.NotifyMeetingExtData_MeetingLiveStreamData{MeetingLiveStreamData: &pbmeetingext.MeetingLiveStreamData{
+			LiveStream: stream,
+		}},
+	}
+	if err := s.meetingRtc.SendRoomExtData(ctx, roomID, nil, sendData); err != nil {
+		log.ZWarn(ctx, "send meeting live stream data failed", err, "roomID", roomID)
+	}
+}
+
+// streamOutputID identifies the rtmp url without keeping its stream key
+func streamOutputID(rawURL string) string {
+	sum := sha256.Sum256([]byte(rawURL))
+	return hex.EncodeToString(sum[:8])
+}
+
+// maskStreamURL check the url is rtmp and mask its stream key, which is the last segment of the path or the query
+func maskStreamURL(rawURL string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" || (u.Scheme != "rtmp" && u.Scheme != "rtmps") {
+		// the url is not logged with its stream key
+		return "", errs.ErrArgs.WrapMsg("url should be rtmp:// or rtmps:// with the host")
+	}
+	path := strings.TrimSuffix(u.Path, "/")
+	if i := strings.LastIndex(path, "/"); i >= 0 && i < len(path)-1 {
+		path = path[:i] + "/****"
+	}
+	return u.Scheme + "://" + u.Host + path, nil
+}
@@ -0,0 +1,226 @@
+package meeting
+
+import (
+	"context"
+	"errors"
+	"github.com/openimsdk/openmeeting-server/pkg/common/constant"
+	"github.com/openimsdk/openmeeting-server/pkg/common/servererrs"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	pbmeetingext "github.com/openimsdk/openmeeting-server/pkg/protocol/meetingext"
+	pbmeeting "github.com/openimsdk/protocol/openmeeting/meeting"
+	pbuser "github.com/openimsdk/protocol/openmeeting/user"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/openimsdk/tools/utils/timeutil"
+	"time"
+)
+
+const (
+	// maxWaitingSeconds limits how long GetWaitingStatus blocks
+	maxWaitingSeconds   = 30
+	waitingPollInterval = time.Second
+)
+
+// SetMeetingWaitingRoom turn the waiting room on or off, only the creator or the host could do it
+func (s *meetingServer) SetMeetingWaitingRoom(ctx context.Context, req *pbmeetingext.SetMeetingWaitingRoomReq) (*pbmeetingext.SetMeetingWaitingRoomResp, error) {
+	resp := &pbmeetingext.SetMeetingWaitingRoomResp{}
+	info, err := s.meetingStorageHandler.TakeWithError(ctx, req.MeetingID)
+	if err != nil {
+		return resp, errs.WrapMsg(err, "get meeting data failed")
+	}
+	if !s.checkMeetingPermission(ctx, info, req.UserID, constant.PermissionManageMeeting) {
+		return resp, servererrs.ErrMeetingAuthCheck.WrapMsg("user did not have permission to set the waiting room of the meeting")
+	}
+	if info.WaitingRoom == req.Enable {
+		return resp, nil
+	}
+	if err := s.meetingStorageHandler.Update(ctx, req.MeetingID, map[string]any{"waiting_room": req.Enable}); err != nil {
+		return resp, err
+	}
+	if !req.Enable {
+		// nobody could admit the users still waiting once the waiting room is off
+		if _, err := s.respondWaitingUsers(ctx, req.MeetingID, req.UserID, nil, true, constant.WaitingStatusAdmitted); err != nil {
+			return resp, err
+		}
+	}
+	return resp, nil
+}
+
+// GetWaitingRoom get the users in the waiting room, only the creator, the host or the co-hosts could do it
+func (s *meetingServer) GetWaitingRoom(ctx context.Context, req *pbmeetingext.GetWaitingRoomReq) (*pbmeetingext.GetWaitingRoomResp, error) {
+	resp := &pbmeetingext.GetWaitingRoomResp{}
+	info, err := s.takeWaitingRoomMeeting(ctx, req.MeetingID, req.UserID)
+	if err != nil {
+		return resp, err
+	}
+	users, err := s.waitingRoomStorageHandler.FindUsers(ctx, req.MeetingID)
+	if err != nil {
+		return resp, err
+	}
+	resp.Enable = info.WaitingRoom
+	resp.Users = datautil.Slice(users, s.generateClientWaitingUser)
+	return resp, nil
+}
+
+func (s *meetingServer) AdmitWaitingUsers(ctx context.Context, req *pbmeetingext.RespondWaitingUsersReq) (*pbmeetingext.RespondWaitingUsersResp, error) {
+	resp := &pbmeetingext.RespondWaitingUsersResp{}
+	if _, err := s.takeWaitingRoomMeeting(ctx, req.MeetingID, req.UserID); err != nil {
+		return resp, err
+	}
+	userIDs, err := s.respondWaitingUsers(ctx, req.MeetingID, req.UserID, req.WaitingUserIDs, req.All, constant.WaitingStatusAdmitted)
+	if err != nil {
+		return resp, err
+	}
+	resp.UserIDs = userIDs
+	return resp, nil
+}
+
+func (s *meetingServer) DenyWaitingUsers(ctx context.Context, req *pbmeetingext.RespondWaitingUsersReq) (*pbmeetingext.RespondWaitingUsersResp, error) {
+	resp := &pbmeetingext.RespondWaitingUsersResp{}
+	if _, err := s.takeWaitingRoomMeeting(ctx, req.MeetingID, req.UserID); err != nil {
+		return resp, err
+	}
+	userIDs, err := s.respondWaitingUsers(ctx, req.MeetingID, req.UserID, req.WaitingUserIDs, req.All, constant.WaitingStatusDenied)
+	if err != nil {
+		return resp, err
+	}
+	resp.UserIDs = userIDs
+	return resp, nil
+}
+
+// GetWaitingStatus block while the user is still waiting, the user joins the meeting once admitted
+func (s *meetingServer) GetWaitingStatus(ctx context.Context, req *pbmeetingext.GetWaitingStatusReq) (*pbmeetingext.GetWaitingStatusResp, error) {
+	resp := &pbmeetingext.GetWaitingStatusResp{}
+	deadline := time.Now().Add(time.Duration(min(max(req.WaitSeconds, 0), maxWaitingSeconds)) * time.Second)
+	for {
+		user, err := s.waitingRoomStorageHandler.TakeUser(ctx, req.MeetingID, req.UserID)
+		if err != nil {
+			return resp, err
+		}
+		resp.Status = user.Status
+		switch user.Status {
+		case constant.WaitingStatusAdmitted:
+			joinResp, err := s.JoinMeeting(ctx, &pbmeeting.JoinMeetingReq{MeetingID: req.MeetingID, UserID: req.UserID})
+			if err != nil {
+				return resp, err
+			}
+			resp.LiveKit = joinResp.LiveKit
+			return resp, nil
+		case constant.WaitingStatusDenied:
+			// the user could ask to join again
+			if err := s.waitingRoomStorageHandler.Remove(ctx, req.MeetingID, []string{req.UserID}); err != nil {
+				return resp, err
+			}
+			return resp, nil
+		}
+		if !time.Now().Before(deadline) {
+			return resp, nil
+		}
+		select {
+		case <-ctx.Done():
+			return resp, errs.Wrap(ctx.Err())
+		case <-time.After(waitingPollInterval):
+		}
+	}
+}
+
+// takeWaitingRoomMeeting get the meeting whose waiting room is managed by the user
+func (s *meetingServer) takeWaitingRoomMeeting(ctx context.Context, meetingID, userID string) (*model.MeetingInfo, error) {
+	info, err := s.meetingStorageHandler.TakeWithError(ctx, meetingID)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "get meeting data failed")
+	}
+	if !s.checkMeetingPermission(ctx, info, userID, constant.PermissionAdmitLobby) {
+		return nil, servererrs.ErrMeetingAuthCheck.WrapMsg("user did not have permission to manage the waiting room of the meeting")
+	}
+	return info, nil
+}
+
+// respondWaitingUsers set the status of the users still waiting, all of them if all is true
+func (s *meetingServer) respondWaitingUsers(ctx context.Context, meetingID, operatorUserID string, userIDs []string, all bool, status string) ([]string, error) {
+	users, err := s.waitingRoomStorageHandler.FindUsers(ctx, meetingID)
+	if err != nil {
+		return nil, err
+	}
+	now := timeutil.GetCurrentTimestampBySecond()
+	var responded []*model.WaitingUser
+	for _, user := range users {
+		if user.Status != constant.WaitingStatusWaiting {
+			continue
+		}
+		if !all && !datautil.Contain(user.UserID, userIDs...) {
+			continue
+		}
+		user.Status = status
+		user.ResponseTime = now
+		user.OperatorUserID = operatorUserID
+		responded = append(responded, user)
+	}
+	if len(responded) == 0 {
+		return nil, nil
+	}
+	if err := s.waitingRoomStorageHandler.Respond(ctx, responded); err != nil {
+		return nil, err
+	}
+	s.notifyWaitingRoom(ctx, meetingID, operatorUserID)
+	return datautil.Slice(responded, func(e *model.WaitingUser) string {
+		return e.UserID
+	}), nil
+}
+
+// checkAdmitted check the user is admitted by the hosts, the password is not checked again for the admitted user
+func (s *meetingServer) checkAdmitted(ctx context.Context, info *model.MeetingInfo, userID string) bool {
+	if !info.WaitingRoom {
+		return false
+	}
+	user, err := s.waitingRoomStorageHandler.TakeUser(ctx, info.MeetingID, userID)
+	if err != nil {
+		return false
+	}
+	return user.Status == constant.WaitingStatusAdmitted
+}
+
+// enterWaitingRoom put the user into the waiting room and return servererrs.ErrMeetingWaiting,
+// nil is returned if the user could join at once, e.g., the waiting room is off or the user is one of the hosts.
+func (s *meetingServer) enterWaitingRoom(ctx context.Context, info *model.MeetingInfo, metaData *pbmeeting.MeetingMetadata, userInfo *pbuser.UserInfo) error {
+	if !info.WaitingRoom || s.checkRoomPermission(metaData, nil, userInfo.UserID, constant.PermissionAdmitLobby) {
+		return nil
+	}
+	user, err := s.waitingRoomStorageHandler.TakeUser(ctx, info.MeetingID, userInfo.UserID)
+	if err != nil && !errors.Is(err, errs.ErrRecordNotFound) {
+		return err
+	}
+	// joining again while waiting does not notify the hosts again
+	if user == nil || user.Status != constant.WaitingStatusWaiting {
+		user = &model.WaitingUser{
+			MeetingID:   info.MeetingID,
+			UserID:      userInfo.UserID,
+			Nickname:    userInfo.Nickname,
+			Status:      constant.WaitingStatusWaiting,
+			RequestTime: timeutil.GetCurrentTimestampBySecond(),
+		}
+		if err := s.waitingRoomStorageHandler.Wait(ctx, user); err != nil {
+			return err
+		}
+		s.notifyWaitingRoom(ctx, info.MeetingID, userInfo.UserID)
+	}
+	return servererrs.ErrMeetingWaiting.WrapMsg("waiting for the hosts to admit", "meetingID", info.MeetingID)
+}
+
+// leaveWaitingRoom take the user out of the waiting room after joining the meeting
+func (s *meetingServer) leaveWaitingRoom(ctx context.Context, info *model.MeetingInfo, userID string) {
+	if !info.WaitingRoom {
+		return
+	}
+	if err := s.waitingRoomStorageHandler.Remove(ctx, info.MeetingID, []string{userID}); err != nil {
+		log.ZWarn(ctx, "remove user from waiting room failed", err, "meetingID", info.MeetingID, "userID", userID)
+	}
+}
+
+// clearWaitingRoom drop the users still waiting when the meeting ends
+func (s *meetingServer) clearWaitingRoom(ctx context.Context, meetingID string) {
+	if err := s.waitingRoomStorageHandler.Clear(ctx, meetingID); err != nil {
+		log.ZWarn(ctx, "clear waiting room failed", err, "meetingID", meetingID)
+	}
+}
@@ -1,7 +1,81 @@
 package apistruct
 
+import "github.com/openimsdk/protocol/sdkws"
+
 type AdminLoginResp struct {
 	AdminAccount string `json:"adminAccount"`
 	AdminToken   string `json:"adminToken"`
 	Nickname     string `json:"nickname"`
 }
+
+type WebhookSubscription struct {
+	SubscriptionID string   `json:"subscriptionID"`
+	URL            string   `json:"url"`
+	Secret         string   `json:"secret"`
+	Events         []string `json:"events"`
+	Enable         bool     `json:"enable"`
+	CreateTime     int64    `json:"createTime"`
+	UpdateTime     int64    `json:"updateTime"`
+}
+
+type CreateWebhookSubscriptionReq struct {
+	URL    string   `json:"url"`
+	Secret string   `json:"secret"` // a random secret is generated if empty
+	Events []string `json:"events"` // all the events if empty
+	Enable *bool    `json:"enable"` // enabled if not set
+}
+
+type UpdateWebhookSubscriptionReq struct {
+	SubscriptionID string    `json:"subscriptionID"`
+	URL            *string   `json:"url"`
+	Secret         *string   `json:"secret"`
+	Events         *[]string `json:"events"`
+	Enable         *bool     `json:"enable"`
+}
+
+type DeleteWebhookSubscriptionReq struct {
+	SubscriptionID string `json:"subscriptionID"`
+}
+
+type GetWebhookSubscriptionsResp struct {
+	Subscriptions []*WebhookSubscription `json:"subscriptions"`
+}
+
+type WebhookDeadLetter struct {
+	DeliveryID     string `json:"deliveryID"`
+	SubscriptionID string `json:"subscriptionID"`
+	URL            string `json:"url"`
+	EventType      string `json:"eventType"`
+	Payload        string `json:"payload"`
+	Attempts       int    `json:"attempts"`
+	LastError      string `json:"lastError"`
+	CreateTime     int64  `json:"createTime"`
+}
+
+type GetWebhookDeadLettersReq struct {
+	SubscriptionID string                   `json:"subscriptionID"` // dead letters of all the subscriptions if empty
+	Pagination     *sdkws.RequestPagination `json:"pagination"`
+}
+
+type GetWebhookDeadLettersResp struct {
+	Total       int64                `json:"total"`
+	DeadLetters []*WebhookDeadLetter `json:"deadLetters"`
+}
+
+type RedeliverWebhookDeadLetterReq struct {
+	DeliveryID string `json:"deliveryID"`
+}
+
+type DeleteWebhookDeadLettersReq struct {
+	DeliveryIDs []string `json:"deliveryIDs"`
+}
+
+type ExportMeetingReportReq struct {
+	MeetingID    string `json:"meetingID"`    // export the meeting if set, otherwise the meetings within the time range
+	OccurrenceID string `json:"occurrenceID"` // only the occurrence of the meeting if set
+	StartTime    int64  `json:"startTime"`    // in seconds, inclusive
+	EndTime      int64  `json:"endTime"`      // in seconds, exclusive
+	Format       string `json:"format"`       // xlsx or csv, xlsx if empty
+	Report       string `json:"report"`       // attendance or host_change, csv holds only one of them, attendance if empty
+	TimeZone     string `json:"timeZone"`     // IANA time zone of the exported times, UTC if empty
+}
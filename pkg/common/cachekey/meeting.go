@@ -3,8 +3,48 @@ package cachekey
 const (
 	MeetingInfoKey       = "MEETING_INFO:"
 	GenerateMeetingIDKey = "GENERATE_MEETING_ID_KEY"
+	MeetingSchedulerKey  = "MEETING_SCHEDULER_LEASE"
+	WaitingRoomKey       = "MEETING_WAITING_ROOM:"
+	PresenceRoomKey      = "MEETING_PRESENCE_ROOM:"
+	PresenceUserKey      = "MEETING_PRESENCE_USER:"
+	PresenceRoomsKey     = "MEETING_PRESENCE_ROOMS"
+	RoomLockKey          = "MEETING_ROOM_LOCK:"
+	RecordingLockKey     = "MEETING_RECORDING_LOCK:"
+	BreakoutEndTimeKey   = "MEETING_BREAKOUT_END_TIME"
 )
 
 func GetMeetingInfoKey(meetingID string) string {
 	return MeetingInfoKey + meetingID
 }
+
+func GetMeetingSchedulerKey() string {
+	return MeetingSchedulerKey
+}
+
+func GetWaitingRoomKey(meetingID string) string {
+	return WaitingRoomKey + meetingID
+}
+
+func GetPresenceRoomKey(roomID string) string {
+	return PresenceRoomKey + roomID
+}
+
+func GetPresenceUserKey(userID string) string {
+	return PresenceUserKey + userID
+}
+
+func GetPresenceRoomsKey() string {
+	return PresenceRoomsKey
+}
+
+func GetRoomLockKey(roomID string) string {
+	return RoomLockKey + roomID
+}
+
+func GetRecordingLockKey(meetingID string) string {
+	return RecordingLockKey + meetingID
+}
+
+func GetBreakoutEndTimeKey() string {
+	return BreakoutEndTimeKey
+}
@@ -88,13 +88,78 @@ type Meeting struct {
 		Ports      []int  `mapstructure:"ports"`
 	} `mapstructure:"rpc"`
 	Prometheus Prometheus `mapstructure:"prometheus"`
+	Scheduler  struct {
+		Enable   bool `mapstructure:"enable"`
+		Interval int  `mapstructure:"interval"`
+		LeaseTTL int  `mapstructure:"leaseTTL"`
+	} `mapstructure:"scheduler"`
+	Occurrence struct {
+		HorizonDays int `mapstructure:"horizonDays"`
+	} `mapstructure:"occurrence"`
+	Calendar struct {
+		JoinURL string `mapstructure:"joinURL"`
+	} `mapstructure:"calendar"`
+	Notification Notification `mapstructure:"notification"`
+	Webhook      Webhook      `mapstructure:"webhook"`
+}
+
+type Notification struct {
+	Enable          bool                `mapstructure:"enable"`
+	Workers         int                 `mapstructure:"workers"`
+	QueueSize       int                 `mapstructure:"queueSize"`
+	ReminderMinutes int                 `mapstructure:"reminderMinutes"`
+	SMTP            SMTP                `mapstructure:"smtp"`
+	Webhook         NotificationWebhook `mapstructure:"webhook"`
+}
+
+type SMTP struct {
+	Enable   bool   `mapstructure:"enable"`
+	Host     string `mapstructure:"host"`
+	Port     int    `mapstructure:"port"`
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+	From     string `mapstructure:"from"`
+}
+
+type Webhook struct {
+	Enable           bool `mapstructure:"enable"`
+	Workers          int  `mapstructure:"workers"`
+	QueueSize        int  `mapstructure:"queueSize"`
+	Timeout          int  `mapstructure:"timeout"`
+	MaxRetries       int  `mapstructure:"maxRetries"`
+	RetryInterval    int  `mapstructure:"retryInterval"`
+	MaxRetryInterval int  `mapstructure:"maxRetryInterval"`
+}
+
+type NotificationWebhook struct {
+	Enable  bool     `mapstructure:"enable"`
+	URLs    []string `mapstructure:"urls"`
+	Timeout int      `mapstructure:"timeout"`
 }
 
 type RTC struct {
-	URL       []string `mapstructure:"url"`
-	ApiKey    string   `mapstructure:"apiKey"`
-	ApiSecret string   `mapstructure:"apiSecret"`
-	InnerURL  string   `mapstructure:"innerURL"`
+	URL             []string `mapstructure:"url"`
+	ApiKey          string   `mapstructure:"apiKey"`
+	ApiSecret       string   `mapstructure:"apiSecret"`
+	InnerURL        string   `mapstructure:"innerURL"`
+	MaxParticipants uint32   `mapstructure:"maxParticipants"`
+	EmptyTimeout    uint32   `mapstructure:"emptyTimeout"`
+	MaxDuration     int64    `mapstructure:"maxDuration"`
+	Recording       struct {
+		FilePath  string `mapstructure:"filePath"`
+		Layout    string `mapstructure:"layout"`
+		AudioOnly bool   `mapstructure:"audioOnly"`
+	} `mapstructure:"recording"`
+	SIP struct {
+		Enable           bool     `mapstructure:"enable"`
+		TrunkID          string   `mapstructure:"trunkID"`
+		Numbers          []string `mapstructure:"numbers"`
+		InboundAddresses []string `mapstructure:"inboundAddresses"`
+		InboundUsername  string   `mapstructure:"inboundUsername"`
+		InboundPassword  string   `mapstructure:"inboundPassword"`
+		PinLength        int      `mapstructure:"pinLength"`
+		HidePhoneNumber  bool     `mapstructure:"hidePhoneNumber"`
+	} `mapstructure:"sip"`
 }
 
 type Redis struct {
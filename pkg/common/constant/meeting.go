@@ -4,6 +4,7 @@ const (
 	Scheduled  = "Scheduled"
 	InProgress = "In-Progress"
 	Completed  = "Completed"
+	Cancelled  = "Cancelled" // only used by the occurrences of recurring meetings
 )
 
 const (
@@ -13,6 +14,7 @@ const (
 	RepeatWeekDay = "WeekDay" // repeat every week day
 	RepeatMonth   = "Monthly" // repeat every month
 	RepeatCustom  = "Custom"  // repeat custom
+	RepeatRRule   = "RRule"   // repeat by the RFC 5545 RRULE of the meeting
 )
 
 const (
@@ -21,11 +23,86 @@ const (
 	UnitTypeMonth = "Month" // month
 )
 
+const (
+	InvitationPending   = "Pending"
+	InvitationAccepted  = "Accepted"
+	InvitationDeclined  = "Declined"
+	InvitationTentative = "Tentative"
+)
+
+// leave reasons of the attendance, a kicked off participant leaves with the name of pbmeeting.KickOffReason
+const (
+	LeaveReasonLeft         = "Left"
+	LeaveReasonKicked       = "Kicked"
+	LeaveReasonMeetingEnded = "MeetingEnded"
+)
+
+// status of the users in the waiting room
+const (
+	WaitingStatusWaiting  = "Waiting"
+	WaitingStatusAdmitted = "Admitted"
+	WaitingStatusDenied   = "Denied"
+)
+
 const (
 	HostTypeHost   = "Host"
 	HostTypeCoHost = "CoHost"
 )
 
+// receivers of the chat messages in the meeting, the sender always receives the own message
+const (
+	ChatRecvPublic  = "Public"  // all the participants
+	ChatRecvPrivate = "Private" // the user of recvUserID
+	ChatRecvHosts   = "Hosts"   // the host and the co-hosts
+)
+
+// kinds of the participants in the room, kept in the participant metadata beside the user info
+const (
+	ParticipantKindStandard = "Standard" // joined by the app
+	ParticipantKindSIP      = "SIP"      // dialed in by phone
+)
+
+// roles of the participants in the meeting, the creator of the meeting always acts as a host
+const (
+	RoleHost      = "Host"
+	RoleCoHost    = "CoHost"
+	RolePresenter = "Presenter"
+	RoleAttendee  = "Attendee"
+	RoleViewer    = "Viewer"
+)
+
+// permissions granted to the roles
+const (
+	PermissionEndMeeting    = "EndMeeting"
+	PermissionMuteOthers    = "MuteOthers"
+	PermissionKick          = "Kick"
+	PermissionRename        = "Rename"
+	PermissionShareScreen   = "ShareScreen"
+	PermissionManageRoles   = "ManageRoles"
+	PermissionAdmitLobby    = "AdmitLobby"
+	PermissionLockMeeting   = "LockMeeting"
+	PermissionManageMeeting = "ManageMeeting" // update the meeting, its recurrence, invitees and limits, and view the attendance
+	PermissionRecord        = "Record"        // start and stop the recording
+	PermissionLiveStream    = "LiveStream"    // add and remove the outputs of the live stream
+	PermissionBreakout      = "Breakout"      // start, assign, broadcast to and close the breakout rooms
+)
+
+// modes of assigning the participants to the breakout rooms
+const (
+	BreakoutModeManual     = "Manual"     // assigned by the hosts
+	BreakoutModeRandom     = "Random"     // the participants in the meeting room are spread evenly
+	BreakoutModeSelfSelect = "SelfSelect" // the participants join the rooms of their choice
+)
+
+// status of the recordings and the live streams of the meeting
+const (
+	RecordingStatusStarting = "Starting"
+	RecordingStatusActive   = "Active"
+	RecordingStatusEnding   = "Ending"
+	RecordingStatusComplete = "Complete"
+	RecordingStatusFailed   = "Failed" // failed, aborted or reached the limit of the rtc server
+)
+
 const (
 	KickOffDuplicatedLogin = "Duplicated Login"
 	KickOffOffline         = "Offline"
@@ -0,0 +1,187 @@
+package ical
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	ProdID      = "-//OpenIM//OpenMeeting//EN"
+	ContentType = "text/calendar; charset=utf-8"
+
+	dateTimeFormat    = "20060102T150405"
+	utcDateTimeFormat = "20060102T150405Z"
+	// maxLineOctets is the line length limit of RFC 5545, longer content lines are folded
+	maxLineOctets = 75
+)
+
+const (
+	StatusConfirmed = "CONFIRMED"
+	StatusCancelled = "CANCELLED"
+)
+
+// Event is one VEVENT, an event with RecurrenceID set overrides one instance of the recurring event with the same UID.
+type Event struct {
+	UID          string
+	Summary      string
+	Description  string
+	URL          string
+	Status       string
+	Start        time.Time // the location of Start is used as TZID
+	Duration     time.Duration
+	RRule        string
+	ExDates      []time.Time
+	RecurrenceID time.Time
+	LastModified time.Time
+}
+
+// Calendar is one VCALENDAR holding the events.
+type Calendar struct {
+	Name   string
+	Events []*Event
+}
+
+// now is replaced in tests for a fixed DTSTAMP.
+var now = time.Now
+
+// Bytes encodes the calendar as an RFC 5545 iCalendar stream, every TZID referenced by the events
+// is defined by a VTIMEZONE.
+func (c *Calendar) Bytes() []byte {
+	events := &writer{}
+	stamp := now().UTC().Format(utcDateTimeFormat)
+	for _, event := range c.Events {
+		event.write(events, stamp)
+	}
+
+	w := &writer{}
+	w.line("BEGIN", "VCALENDAR")
+	w.line("VERSION", "2.0")
+	w.line("PRODID", ProdID)
+	w.line("CALSCALE", "GREGORIAN")
+	w.line("METHOD", "PUBLISH")
+	if c.Name != "" {
+		w.line("X-WR-CALNAME", escapeText(c.Name))
+	}
+	names := make([]string, 0, len(events.zones))
+	for name := range events.zones {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		events.zones[name].write(w)
+	}
+	w.WriteString(events.String())
+	w.line("END", "VCALENDAR")
+	return []byte(w.String())
+}
+
+func (e *Event) write(w *writer, stamp string) {
+	w.line("BEGIN", "VEVENT")
+	w.line("UID", e.UID)
+	w.line("DTSTAMP", stamp)
+	w.timeLine("DTSTART", e.Start)
+	w.line("DURATION", formatDuration(e.Duration))
+	if !e.RecurrenceID.IsZero() {
+		w.timeLine("RECURRENCE-ID", e.RecurrenceID)
+	}
+	if e.RRule != "" {
+		w.line("RRULE", e.RRule)
+	}
+	for _, exDate := range e.ExDates {
+		w.timeLine("EXDATE", exDate)
+	}
+	w.line("SUMMARY", escapeText(e.Summary))
+	if e.Description != "" {
+		w.line("DESCRIPTION", escapeText(e.Description))
+	}
+	if e.URL != "" {
+		w.line("URL", e.URL)
+	}
+	if e.Status != "" {
+		w.line("STATUS", e.Status)
+	}
+	if !e.LastModified.IsZero() {
+		w.line("LAST-MODIFIED", e.LastModified.UTC().Format(utcDateTimeFormat))
+	}
+	w.line("END", "VEVENT")
+}
+
+// FormatUTC formats the time in the UTC form, e.g., for UNTIL of RRULE.
+func FormatUTC(t time.Time) string {
+	return t.UTC().Format(utcDateTimeFormat)
+}
+
+type writer struct {
+	strings.Builder
+	// zones are the time zones referenced by TZID, keyed by the name
+	zones map[string]*zoneSpan
+}
+
+// timeLine writes the time in the local time of its location, UTC time is written in the UTC form.
+func (w *writer) timeLine(name string, t time.Time) {
+	if loc := t.Location().String(); loc == "UTC" || loc == "Local" {
+		w.line(name, t.UTC().Format(utcDateTimeFormat))
+		return
+	}
+	w.useZone(t)
+	w.line(name+";TZID="+t.Location().String(), t.Format(dateTimeFormat))
+}
+
+// line writes one content line, folded by the octet limit without splitting a UTF-8 character.
+func (w *writer) line(name, value string) {
+	content := name + ":" + value
+	limit := maxLineOctets
+	for len(content) > limit {
+		cut := limit
+		for cut > 0 && !isRuneStart(content[cut]) {
+			cut--
+		}
+		w.WriteString(content[:cut])
+		w.WriteString("\r\n ")
+		content = content[cut:]
+		// the leading space of the continuation line is counted in the limit
+		limit = maxLineOctets - 1
+	}
+	w.WriteString(content)
+	w.WriteString("\r\n")
+}
+
+func isRuneStart(b byte) bool {
+	return b&0xC0 != 0x80
+}
+
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+func escapeText(text string) string {
+	return textEscaper.Replace(text)
+}
+
+func formatDuration(d time.Duration) string {
+	if d <= 0 {
+		return "PT0S"
+	}
+	seconds := int64(d / time.Second)
+	days, seconds := seconds/86400, seconds%86400
+	hours, seconds := seconds/3600, seconds%3600
+	minutes, seconds := seconds/60, seconds%60
+	var b strings.Builder
+	b.WriteString("P")
+	if days > 0 {
+		fmt.Fprintf(&b, "%dD", days)
+	}
+	if hours > 0 || minutes > 0 || seconds > 0 {
+		b.WriteString("T")
+		if hours > 0 {
+			fmt.Fprintf(&b, "%dH", hours)
+		}
+		if minutes > 0 {
+			fmt.Fprintf(&b, "%dM", minutes)
+		}
+		if seconds > 0 {
+			fmt.Fprintf(&b, "%dS", seconds)
+		}
+	}
+	return b.String()
+}
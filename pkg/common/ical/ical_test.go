@@ -0,0 +1,91 @@
+package ical
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+func TestCalendarBytes(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("time zone Europe/Berlin is not available: %v", err)
+	}
+	now = func() time.Time { return time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC) }
+	defer func() { now = time.Now }()
+
+	start := time.Date(2026, 10, 19, 9, 0, 0, 0, berlin)
+	calendar := &Calendar{
+		Name: "Weekly, sync; team",
+		Events: []*Event{
+			{
+				UID:          "1001@openmeeting",
+				Summary:      "Weekly sync",
+				Description:  "Agenda:\n1. Status, blockers; next steps\n2. 议程讨论，请提前准备材料并在会议开始前上传到共享目录中",
+				URL:          "https://meeting.example.com/join/1001",
+				Status:       StatusConfirmed,
+				Start:        start,
+				Duration:     90 * time.Minute,
+				RRule:        "FREQ=WEEKLY;BYDAY=MO;UNTIL=" + FormatUTC(time.Date(2027, 3, 29, 9, 0, 0, 0, berlin)),
+				ExDates:      []time.Time{time.Date(2026, 11, 2, 9, 0, 0, 0, berlin)},
+				LastModified: time.Date(2026, 10, 1, 8, 30, 0, 0, time.UTC),
+			},
+			{
+				UID:          "1001@openmeeting",
+				Summary:      "Weekly sync (moved)",
+				Status:       StatusConfirmed,
+				Start:        time.Date(2026, 11, 10, 14, 0, 0, 0, berlin),
+				Duration:     time.Hour,
+				RecurrenceID: time.Date(2026, 11, 9, 9, 0, 0, 0, berlin),
+			},
+			{
+				UID:      "1002@openmeeting",
+				Summary:  "All hands",
+				Status:   StatusCancelled,
+				Start:    time.Date(2026, 12, 1, 16, 0, 0, 0, time.UTC),
+				Duration: 26*time.Hour + 30*time.Second,
+			},
+		},
+	}
+	got := calendar.Bytes()
+
+	golden := filepath.Join("testdata", "calendar.ics")
+	if *update {
+		if err := os.WriteFile(golden, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("calendar does not match %s, run the test with -update to inspect the difference\n%s", golden, got)
+	}
+	for _, line := range strings.Split(string(got), "\r\n") {
+		if len(line) > maxLineOctets {
+			t.Errorf("line longer than %d octets: %q", maxLineOctets, line)
+		}
+	}
+}
+
+func TestFormatOffset(t *testing.T) {
+	tests := map[int]string{
+		0:      "+0000",
+		3600:   "+0100",
+		-34200: "-0930",
+		20700:  "+0545",
+		-17762: "-045602",
+	}
+	for offset, want := range tests {
+		if got := formatOffset(offset); got != want {
+			t.Errorf("formatOffset(%d) = %q, want %q", offset, got, want)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package ical
+
+import (
+	"fmt"
+	"time"
+)
+
+// zoneYearsAhead is how many years the VTIMEZONE still covers after the latest referenced time,
+// a recurring event only references the time of its first occurrence.
+const zoneYearsAhead = 2
+
+// zoneSpan is the span of the times referenced in a time zone.
+type zoneSpan struct {
+	loc        *time.Location
+	start, end time.Time
+}
+
+func (w *writer) useZone(t time.Time) {
+	if w.zones == nil {
+		w.zones = make(map[string]*zoneSpan)
+	}
+	span, ok := w.zones[t.Location().String()]
+	if !ok {
+		w.zones[t.Location().String()] = &zoneSpan{loc: t.Location(), start: t, end: t}
+		return
+	}
+	if t.Before(span.start) {
+		span.start = t
+	}
+	if t.After(span.end) {
+		span.end = t
+	}
+}
+
+// write writes the VTIMEZONE observing the offsets from the beginning of the year of the earliest time
+// to the end of zoneYearsAhead years after the latest time.
+func (z *zoneSpan) write(w *writer) {
+	start := time.Date(z.start.Year(), time.January, 1, 0, 0, 0, 0, z.loc)
+	end := time.Date(z.end.Year()+zoneYearsAhead+1, time.January, 1, 0, 0, 0, 0, z.loc)
+	w.line("BEGIN", "VTIMEZONE")
+	w.line("TZID", z.loc.String())
+	_, offset := start.Zone()
+	writeObservance(w, start, offset)
+	for t := start; ; {
+		next, ok := nextTransition(t, end)
+		if !ok {
+			break
+		}
+		writeObservance(w, next, offset)
+		_, offset = next.Zone()
+		t = next
+	}
+	w.line("END", "VTIMEZONE")
+}
+
+// writeObservance writes the observance starting at t, its onset is in the local time of the offset before it.
+func writeObservance(w *writer, t time.Time, offsetFrom int) {
+	name, offsetTo := t.Zone()
+	component := "STANDARD"
+	if t.IsDST() {
+		component = "DAYLIGHT"
+	}
+	w.line("BEGIN", component)
+	w.line("DTSTART", t.UTC().Add(time.Duration(offsetFrom)*time.Second).Format(dateTimeFormat))
+	w.line("TZOFFSETFROM", formatOffset(offsetFrom))
+	w.line("TZOFFSETTO", formatOffset(offsetTo))
+	w.line("TZNAME", name)
+	w.line("END", component)
+}
+
+// nextTransition finds the first change of the offset after t and before end,
+// the offset is checked day by day and the change is located to the second by bisection.
+func nextTransition(t, end time.Time) (time.Time, bool) {
+	_, offset := t.Zone()
+	for day := t; day.Before(end); {
+		next := day.Add(24 * time.Hour)
+		if _, nextOffset := next.Zone(); nextOffset != offset {
+			lo, hi := day, next
+			for hi.Sub(lo) > time.Second {
+				mid := lo.Add(hi.Sub(lo) / 2).Truncate(time.Second)
+				if _, midOffset := mid.Zone(); midOffset == offset {
+					lo = mid
+				} else {
+					hi = mid
+				}
+			}
+			return hi, true
+		}
+		day = next
+	}
+	return time.Time{}, false
+}
+
+// formatOffset formats the UTC offset in seconds as TZOFFSETFROM and TZOFFSETTO, e.g., "+0100" or "-0930".
+func formatOffset(offset int) string {
+	sign := "+"
+	if offset < 0 {
+		sign, offset = "-", -offset
+	}
+	if seconds := offset % 60; seconds != 0 {
+		return fmt.Sprintf("%s%02d%02d%02d", sign, offset/3600, offset%3600/60, seconds)
+	}
+	return fmt.Sprintf("%s%02d%02d", sign, offset/3600, offset%3600/60)
+}
@@ -0,0 +1,193 @@
+package recurrence
+
+import (
+	"fmt"
+	"github.com/openimsdk/openmeeting-server/pkg/common/constant"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/teambition/rrule-go"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	rrulePrefix = "RRULE:"
+	exDateName  = "EXDATE"
+)
+
+var weekdays = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// NormalizeRule trims the optional "RRULE:" prefix and checks the rule can be evaluated.
+// DTSTART is not accepted in the rule, the scheduled time of the meeting is used instead.
+func NormalizeRule(rule string) (string, error) {
+	rule = strings.ToUpper(strings.TrimSpace(rule))
+	if len(rule) >= len(rrulePrefix) && rule[:len(rrulePrefix)] == rrulePrefix {
+		rule = rule[len(rrulePrefix):]
+	}
+	if rule == "" {
+		return "", errs.ErrArgs.WrapMsg("rrule is empty")
+	}
+	if strings.ContainsAny(rule, "\r\n") {
+		return "", errs.ErrArgs.WrapMsg("rrule should be a single RRULE line", "rrule", rule)
+	}
+	if _, err := rrule.StrToROption(rule); err != nil {
+		return "", errs.ErrArgs.WrapMsg("invalid rrule", "rrule", rule, "err", err.Error())
+	}
+	return rule, nil
+}
+
+// LegacyRule maps the repeat type based setting of the meeting onto the equivalent RRULE,
+// an empty string is returned for a meeting which does not repeat.
+func LegacyRule(info *model.MeetingInfo) string {
+	var parts []string
+	switch info.RepeatType {
+	case constant.RepeatDaily:
+		parts = append(parts, "FREQ=DAILY")
+	case constant.RepeatWeekly:
+		parts = append(parts, "FREQ=WEEKLY")
+	case constant.RepeatWeekDay:
+		parts = append(parts, "FREQ=WEEKLY", "BYDAY=MO,TU,WE,TH,FR")
+	case constant.RepeatMonth:
+		parts = append(parts, "FREQ=MONTHLY")
+	case constant.RepeatCustom:
+		switch info.UintType {
+		case constant.UnitTypeDay:
+			parts = append(parts, "FREQ=DAILY")
+		case constant.UnitTypeWeek:
+			parts = append(parts, "FREQ=WEEKLY")
+		case constant.UnitTypeMonth:
+			parts = append(parts, "FREQ=MONTHLY")
+		default:
+			return ""
+		}
+		if info.Interval > 1 {
+			parts = append(parts, fmt.Sprintf("INTERVAL=%d", info.Interval))
+		}
+		if part := legacyDays(info); part != "" {
+			parts = append(parts, part)
+		}
+	default:
+		return ""
+	}
+	if info.RepeatTimes > 0 {
+		parts = append(parts, fmt.Sprintf("COUNT=%d", info.RepeatTimes))
+	}
+	return strings.Join(parts, ";")
+}
+
+// legacyDays maps the repeat days of the custom setting, they are the days of the week except for the monthly
+// repetition, whose days are the days of the month. The days out of range are skipped.
+func legacyDays(info *model.MeetingInfo) string {
+	var days []string
+	for _, day := range info.RepeatDayOfWeek {
+		if info.UintType == constant.UnitTypeMonth {
+			if day >= 1 && day <= 31 {
+				days = append(days, strconv.Itoa(int(day)))
+			}
+		} else if day >= 0 && int(day) < len(weekdays) {
+			days = append(days, weekdays[day])
+		}
+	}
+	if len(days) == 0 {
+		return ""
+	}
+	if info.UintType == constant.UnitTypeMonth {
+		return "BYMONTHDAY=" + strings.Join(days, ",")
+	}
+	return "BYDAY=" + strings.Join(days, ",")
+}
+
+// IsRecurrence reports whether the repeat type carries the recurrence itself instead of naming a repeat type,
+// e.g., "RRULE:FREQ=MONTHLY;BYDAY=-1FR" optionally followed by the "EXDATE:" lines.
+func IsRecurrence(repeatType string) bool {
+	return strings.HasPrefix(strings.ToUpper(strings.TrimSpace(repeatType)), rrulePrefix)
+}
+
+// Parse splits the recurrence into the RRULE and the exception dates, one property per line.
+// The exception dates without TZID are in loc.
+func Parse(recurrence string, loc *time.Location) (string, []int64, error) {
+	var (
+		rule    string
+		exDates []int64
+	)
+	for _, line := range strings.Split(strings.ReplaceAll(recurrence, "\r\n", "\n"), "\n") {
+		line = strings.TrimSpace(line)
+		upper := strings.ToUpper(line)
+		switch {
+		case line == "":
+		case strings.HasPrefix(upper, rrulePrefix):
+			if rule != "" {
+				return "", nil, errs.ErrArgs.WrapMsg("recurrence should have only one RRULE", "recurrence", recurrence)
+			}
+			var err error
+			if rule, err = NormalizeRule(line); err != nil {
+				return "", nil, err
+			}
+		case strings.HasPrefix(upper, exDateName+":") || strings.HasPrefix(upper, exDateName+";"):
+			dates, err := rrule.StrToDatesInLoc(line[len(exDateName)+1:], loc)
+			if err != nil {
+				return "", nil, errs.ErrArgs.WrapMsg("invalid exdate", "exdate", line, "err", err.Error())
+			}
+			for _, date := range dates {
+				exDates = append(exDates, date.Unix())
+			}
+		default:
+			return "", nil, errs.ErrArgs.WrapMsg("recurrence only supports RRULE and EXDATE", "line", line)
+		}
+	}
+	if rule == "" {
+		return "", nil, errs.ErrArgs.WrapMsg("rrule is empty")
+	}
+	return rule, datautil.Distinct(exDates), nil
+}
+
+// Rule returns the RRULE the meeting repeats by, no matter it is stored directly or mapped from the repeat type.
+func Rule(info *model.MeetingInfo) string {
+	if info.RepeatType == constant.RepeatRRule {
+		return info.RRule
+	}
+	return LegacyRule(info)
+}
+
+// Location loads the time zone of the meeting, UTC is used when the meeting does not specify one.
+func Location(info *model.MeetingInfo) (*time.Location, error) {
+	loc, err := time.LoadLocation(info.TimeZone)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "load location failed", "timezone", info.TimeZone)
+	}
+	return loc, nil
+}
+
+// NewSet builds the recurrence set of the meeting evaluated in the meeting's time zone,
+// the exception dates are excluded from the set.
+func NewSet(info *model.MeetingInfo) (*rrule.Set, error) {
+	rule := Rule(info)
+	if rule == "" {
+		return nil, errs.ErrArgs.WrapMsg("meeting does not repeat", "meetingID", info.MeetingID)
+	}
+	loc, err := Location(info)
+	if err != nil {
+		return nil, err
+	}
+	option, err := rrule.StrToROptionInLocation(rule, loc)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "parse rrule failed", "rrule", rule)
+	}
+	option.Dtstart = time.Unix(info.ScheduledTime, 0).In(loc)
+	// the end date of the meeting bounds the rule as well
+	if endDate := time.Unix(info.EndDate, 0).In(loc); info.EndDate > 0 && (option.Until.IsZero() || option.Until.After(endDate)) {
+		option.Until = endDate
+	}
+	r, err := rrule.NewRRule(*option)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "build rrule failed", "rrule", rule)
+	}
+	set := &rrule.Set{}
+	set.RRule(r)
+	for _, exDate := range info.ExDates {
+		set.ExDate(time.Unix(exDate, 0).In(loc))
+	}
+	return set, nil
+}
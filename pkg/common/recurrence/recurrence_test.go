@@ -0,0 +1,139 @@
+package recurrence
+
+import (
+	"github.com/openimsdk/openmeeting-server/pkg/common/constant"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestLegacyRule(t *testing.T) {
+	tests := []struct {
+		name string
+		info *model.MeetingInfo
+		rule string
+	}{
+		{"once", &model.MeetingInfo{RepeatType: constant.NoneRepeat}, ""},
+		{"daily", &model.MeetingInfo{RepeatType: constant.RepeatDaily, RepeatTimes: 3}, "FREQ=DAILY;COUNT=3"},
+		{"weekday", &model.MeetingInfo{RepeatType: constant.RepeatWeekDay}, "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR"},
+		{"custom week", &model.MeetingInfo{RepeatType: constant.RepeatCustom, UintType: constant.UnitTypeWeek, Interval: 2, RepeatDayOfWeek: []int32{1, 4}}, "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH"},
+		{"custom week out of range", &model.MeetingInfo{RepeatType: constant.RepeatCustom, UintType: constant.UnitTypeWeek, RepeatDayOfWeek: []int32{7, -1}}, "FREQ=WEEKLY"},
+		{"custom month", &model.MeetingInfo{RepeatType: constant.RepeatCustom, UintType: constant.UnitTypeMonth, RepeatDayOfWeek: []int32{1, 15, 32}}, "FREQ=MONTHLY;BYMONTHDAY=1,15"},
+		{"custom unknown unit", &model.MeetingInfo{RepeatType: constant.RepeatCustom, UintType: "year"}, ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if rule := LegacyRule(test.info); rule != test.rule {
+				t.Errorf("LegacyRule() = %q, want %q", rule, test.rule)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	berlin := mustLoadLocation(t, "Europe/Berlin")
+	rule, exDates, err := Parse("RRULE:FREQ=WEEKLY;BYDAY=MO\r\nEXDATE;TZID=America/New_York:20261102T030000\nEXDATE:20261109T090000,20261102T090000", berlin)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if rule != "FREQ=WEEKLY;BYDAY=MO" {
+		t.Errorf("rule = %q", rule)
+	}
+	want := []int64{
+		time.Date(2026, 11, 2, 9, 0, 0, 0, berlin).Unix(),
+		time.Date(2026, 11, 9, 9, 0, 0, 0, berlin).Unix(),
+	}
+	if !reflect.DeepEqual(exDates, want) {
+		t.Errorf("exDates = %v, want %v", exDates, want)
+	}
+
+	for _, recurrence := range []string{
+		"",
+		"EXDATE:20261109T090000",
+		"RRULE:FREQ=DAILY\nRRULE:FREQ=WEEKLY",
+		"RRULE:FREQ=DAILY\nRDATE:20261109T090000",
+		"RRULE:FREQ=SOMETIMES",
+		"RRULE:FREQ=DAILY\nEXDATE:tomorrow",
+	} {
+		if _, _, err := Parse(recurrence, berlin); err == nil {
+			t.Errorf("Parse(%q) should fail", recurrence)
+		}
+	}
+}
+
+func TestNewSet(t *testing.T) {
+	berlin := mustLoadLocation(t, "Europe/Berlin")
+	info := &model.MeetingInfo{
+		MeetingID:     "1",
+		TimeZone:      berlin.String(),
+		ScheduledTime: time.Date(2026, 10, 19, 9, 0, 0, 0, berlin).Unix(),
+		EndDate:       time.Date(2026, 11, 16, 9, 0, 0, 0, berlin).Unix(),
+		RepeatType:    constant.RepeatRRule,
+		RRule:         "FREQ=WEEKLY;BYDAY=MO",
+		ExDates:       []int64{time.Date(2026, 11, 2, 9, 0, 0, 0, berlin).Unix()},
+	}
+	set, err := NewSet(info)
+	if err != nil {
+		t.Fatalf("NewSet() error = %v", err)
+	}
+	// the wall time stays at 9:00 across the end of the daylight saving time on 2026-10-25
+	want := []time.Time{
+		time.Date(2026, 10, 19, 7, 0, 0, 0, time.UTC),
+		time.Date(2026, 10, 26, 8, 0, 0, 0, time.UTC),
+		time.Date(2026, 11, 9, 8, 0, 0, 0, time.UTC),
+		time.Date(2026, 11, 16, 8, 0, 0, 0, time.UTC),
+	}
+	got := set.All()
+	if len(got) != len(want) {
+		t.Fatalf("occurrences = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("occurrence %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	info.RepeatType = constant.NoneRepeat
+	if _, err := NewSet(info); err == nil {
+		t.Error("NewSet() should fail for a meeting which does not repeat")
+	}
+}
+
+func TestNewSetLegacyMonthly(t *testing.T) {
+	info := &model.MeetingInfo{
+		ScheduledTime:   time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC).Unix(),
+		RepeatType:      constant.RepeatCustom,
+		UintType:        constant.UnitTypeMonth,
+		RepeatDayOfWeek: []int32{1, 15},
+		RepeatTimes:     4,
+	}
+	set, err := NewSet(info)
+	if err != nil {
+		t.Fatalf("NewSet() error = %v", err)
+	}
+	want := []time.Time{
+		time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC),
+		time.Date(2026, 10, 15, 10, 0, 0, 0, time.UTC),
+		time.Date(2026, 11, 1, 10, 0, 0, 0, time.UTC),
+		time.Date(2026, 11, 15, 10, 0, 0, 0, time.UTC),
+	}
+	got := set.All()
+	if len(got) != len(want) {
+		t.Fatalf("occurrences = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("occurrence %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("time zone %s is not available: %v", name, err)
+	}
+	return loc
+}
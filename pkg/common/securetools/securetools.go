@@ -2,7 +2,10 @@ package securetools
 
 import (
 	"crypto/md5"
+	crand "crypto/rand"
 	"encoding/hex"
+	"github.com/openimsdk/tools/errs"
+	"math/big"
 	"math/rand"
 	"time"
 )
@@ -29,3 +32,25 @@ func VerifyPassword(password, salt string) string {
 	hashed := md5.Sum([]byte(password + salt))
 	return hex.EncodeToString(hashed[:])
 }
+
+// GenerateToken returns a random hex token of the given byte length, it is safe to be used as a secret.
+func GenerateToken(size int) (string, error) {
+	buf := make([]byte, size)
+	if _, err := crand.Read(buf); err != nil {
+		return "", errs.WrapMsg(err, "generate random token failed")
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// GenerateDigits returns a random numeric code of the given length, e.g., a PIN.
+func GenerateDigits(length int) (string, error) {
+	buf := make([]byte, length)
+	for i := range buf {
+		n, err := crand.Int(crand.Reader, big.NewInt(10))
+		if err != nil {
+			return "", errs.WrapMsg(err, "generate random digits failed")
+		}
+		buf[i] = byte('0' + n.Int64())
+	}
+	return string(buf), nil
+}
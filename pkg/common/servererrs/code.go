@@ -29,6 +29,11 @@ const (
 	MeetingPasswordError  = 200002 // password not match error
 	MeetingAuthCheckError = 200003 // meeting auth check permission error
 	MeetingCompleteError  = 200004 // meeting update check error
+	MeetingWaitingError   = 200005 // joiner is waiting in the waiting room for the admission of the hosts
+	MeetingLockedError    = 200006 // meeting is locked, new participants could not join
+	MeetingFullError      = 200007 // meeting reaches its participant cap
+	MeetingConflictError  = 200008 // room data is modified by others at the same time, retry later
+	MeetingCancelledError = 200009 // the current occurrence of the meeting is cancelled
 )
 
 // General error codes.
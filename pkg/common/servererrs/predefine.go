@@ -33,4 +33,9 @@ var (
 	ErrMeetingPasswordNotMatch = errs.NewCodeError(MeetingPasswordError, "MeetingPasswordError")
 	ErrMeetingAuthCheck        = errs.NewCodeError(MeetingAuthCheckError, "MeetingAuthCheckError")
 	ErrMeetingAlreadyCompleted = errs.NewCodeError(MeetingCompleteError, "MeetingCompleteError")
+	ErrMeetingWaiting          = errs.NewCodeError(MeetingWaitingError, "MeetingWaitingError")
+	ErrMeetingLocked           = errs.NewCodeError(MeetingLockedError, "MeetingLockedError")
+	ErrMeetingFull             = errs.NewCodeError(MeetingFullError, "MeetingFullError")
+	ErrMeetingConflict         = errs.NewCodeError(MeetingConflictError, "MeetingConflictError")
+	ErrMeetingCancelled        = errs.NewCodeError(MeetingCancelledError, "MeetingCancelledError")
 )
@@ -0,0 +1,13 @@
+package cache
+
+import (
+	"context"
+)
+
+type Breakout interface {
+	// SetBreakoutEndTime set the time the breakout rooms of the meeting are closed at, in seconds
+	SetBreakoutEndTime(ctx context.Context, meetingID string, endTime int64) error
+	// GetOverdueBreakouts get the meetings whose breakout rooms end no later than now
+	GetOverdueBreakouts(ctx context.Context, now int64) ([]string, error)
+	DelBreakoutEndTime(ctx context.Context, meetingID string) error
+}
@@ -3,6 +3,7 @@ package cache
 import (
 	"context"
 	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	"time"
 )
 
 type Meeting interface {
@@ -11,4 +12,8 @@ type Meeting interface {
 	GetMeetingByID(ctx context.Context, meetingID string) (*model.MeetingInfo, error)
 	DelMeeting(meetingIDs ...string) Meeting
 	GenerateMeetingID(ctx context.Context) (string, error)
+	// AcquireSchedulerLease acquires or renews the scheduler leader lease for the owner
+	AcquireSchedulerLease(ctx context.Context, owner string, expire time.Duration) (bool, error)
+	// ReleaseSchedulerLease releases the scheduler leader lease if it is held by the owner
+	ReleaseSchedulerLease(ctx context.Context, owner string) error
 }
@@ -0,0 +1,20 @@
+package cache
+
+import (
+	"context"
+)
+
+// Presence indexes the users connected to the rtc rooms both by the room and by the user
+type Presence interface {
+	// AddPresence the user is in the room since joinTime, in milliseconds
+	AddPresence(ctx context.Context, roomID, userID string, joinTime int64) error
+	// DelPresence the room is dropped from the index with its last user
+	DelPresence(ctx context.Context, roomID string, userIDs []string) error
+	// GetRoomPresence get the users in the room keyed by the userID with their join time
+	GetRoomPresence(ctx context.Context, roomID string) (map[string]int64, error)
+	GetUserRooms(ctx context.Context, userID string) ([]string, error)
+	// GetPresenceRooms get all the rooms with users in the index
+	GetPresenceRooms(ctx context.Context) ([]string, error)
+	// DelRoomPresence remove the room and all the users in it
+	DelRoomPresence(ctx context.Context, roomID string) error
+}
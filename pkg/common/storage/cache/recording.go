@@ -0,0 +1,13 @@
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+type Recording interface {
+	// LockStart serializes starting the recordings of the meeting across the replicas, false if it is locked by another owner
+	LockStart(ctx context.Context, meetingID, owner string, expire time.Duration) (bool, error)
+	// UnlockStart release the lock if it is held by the owner
+	UnlockStart(ctx context.Context, meetingID, owner string) error
+}
@@ -0,0 +1,43 @@
+package redis
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/cachekey"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/cache"
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+	"strconv"
+)
+
+// Breakout keeps the end time of the breakout rooms in a sorted set, so the timer only looks at the overdue ones
+type Breakout struct {
+	rdb redis.UniversalClient
+}
+
+func NewBreakout(rdb redis.UniversalClient) cache.Breakout {
+	return &Breakout{rdb: rdb}
+}
+
+func (b *Breakout) SetBreakoutEndTime(ctx context.Context, meetingID string, endTime int64) error {
+	member := redis.Z{Score: float64(endTime), Member: meetingID}
+	if err := b.rdb.ZAdd(ctx, cachekey.GetBreakoutEndTimeKey(), member).Err(); err != nil {
+		return errs.WrapMsg(err, "set breakout end time failed", "meetingID", meetingID)
+	}
+	return nil
+}
+
+func (b *Breakout) GetOverdueBreakouts(ctx context.Context, now int64) ([]string, error) {
+	opt := &redis.ZRangeBy{Min: "-inf", Max: strconv.FormatInt(now, 10)}
+	meetingIDs, err := b.rdb.ZRangeByScore(ctx, cachekey.GetBreakoutEndTimeKey(), opt).Result()
+	if err != nil {
+		return nil, errs.WrapMsg(err, "get overdue breakouts failed")
+	}
+	return meetingIDs, nil
+}
+
+func (b *Breakout) DelBreakoutEndTime(ctx context.Context, meetingID string) error {
+	if err := b.rdb.ZRem(ctx, cachekey.GetBreakoutEndTimeKey(), meetingID).Err(); err != nil {
+		return errs.WrapMsg(err, "delete breakout end time failed", "meetingID", meetingID)
+	}
+	return nil
+}
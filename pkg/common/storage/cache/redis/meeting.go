@@ -76,3 +76,40 @@ func (m *Meeting) GenerateMeetingID(ctx context.Context) (string, error) {
 	}
 	return fmt.Sprintf("%09d", index), nil
 }
+
+// acquireLeaseScript sets the lease when it is free, or extends it when it is already held by the same owner.
+var acquireLeaseScript = redis.NewScript(`
+local current = redis.call("GET", KEYS[1])
+if current == false then
+	redis.call("SET", KEYS[1], ARGV[1], "PX", ARGV[2])
+	return 1
+end
+if current == ARGV[1] then
+	redis.call("PEXPIRE", KEYS[1], ARGV[2])
+	return 1
+end
+return 0
+`)
+
+// releaseLeaseScript deletes the lease only when it is held by the given owner.
+var releaseLeaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+func (m *Meeting) AcquireSchedulerLease(ctx context.Context, owner string, expire time.Duration) (bool, error) {
+	res, err := acquireLeaseScript.Run(ctx, m.rdb, []string{cachekey.GetMeetingSchedulerKey()}, owner, expire.Milliseconds()).Int()
+	if err != nil {
+		return false, errs.WrapMsg(err, "acquire scheduler lease failed", "owner", owner)
+	}
+	return res == 1, nil
+}
+
+func (m *Meeting) ReleaseSchedulerLease(ctx context.Context, owner string) error {
+	if err := releaseLeaseScript.Run(ctx, m.rdb, []string{cachekey.GetMeetingSchedulerKey()}, owner).Err(); err != nil {
+		return errs.WrapMsg(err, "release scheduler lease failed", "owner", owner)
+	}
+	return nil
+}
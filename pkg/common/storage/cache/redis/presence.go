@@ -0,0 +1,116 @@
+package redis
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/cachekey"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/cache"
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+	"strconv"
+	"time"
+)
+
+const (
+	// presenceExpireTime drops the users of the room which is missed by the events, the reconciliation adds them back if they are still in it
+	presenceExpireTime = time.Hour * 24
+)
+
+type Presence struct {
+	rdb        redis.UniversalClient
+	expireTime time.Duration
+}
+
+func NewPresence(rdb redis.UniversalClient) cache.Presence {
+	return &Presence{rdb: rdb, expireTime: presenceExpireTime}
+}
+
+// AddPresence the keys of the room and the user could be in different slots, so they are not written in a transaction
+func (p *Presence) AddPresence(ctx context.Context, roomID, userID string, joinTime int64) error {
+	roomKey := cachekey.GetPresenceRoomKey(roomID)
+	userKey := cachekey.GetPresenceUserKey(userID)
+	pipe := p.rdb.Pipeline()
+	pipe.HSet(ctx, roomKey, userID, joinTime)
+	pipe.Expire(ctx, roomKey, p.expireTime)
+	pipe.HSet(ctx, userKey, roomID, joinTime)
+	pipe.Expire(ctx, userKey, p.expireTime)
+	pipe.SAdd(ctx, cachekey.GetPresenceRoomsKey(), roomID)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errs.WrapMsg(err, "add presence failed", "roomID", roomID, "userID", userID)
+	}
+	return nil
+}
+
+func (p *Presence) DelPresence(ctx context.Context, roomID string, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	roomKey := cachekey.GetPresenceRoomKey(roomID)
+	pipe := p.rdb.Pipeline()
+	pipe.HDel(ctx, roomKey, userIDs...)
+	for _, userID := range userIDs {
+		pipe.HDel(ctx, cachekey.GetPresenceUserKey(userID), roomID)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errs.WrapMsg(err, "delete presence failed", "roomID", roomID, "userIDs", userIDs)
+	}
+	count, err := p.rdb.HLen(ctx, roomKey).Result()
+	if err != nil {
+		return errs.WrapMsg(err, "get presence count failed", "roomID", roomID)
+	}
+	if count == 0 {
+		if err := p.rdb.SRem(ctx, cachekey.GetPresenceRoomsKey(), roomID).Err(); err != nil {
+			return errs.WrapMsg(err, "delete presence room failed", "roomID", roomID)
+		}
+	}
+	return nil
+}
+
+func (p *Presence) GetRoomPresence(ctx context.Context, roomID string) (map[string]int64, error) {
+	values, err := p.rdb.HGetAll(ctx, cachekey.GetPresenceRoomKey(roomID)).Result()
+	if err != nil {
+		return nil, errs.WrapMsg(err, "get room presence failed", "roomID", roomID)
+	}
+	users := make(map[string]int64, len(values))
+	for userID, value := range values {
+		joinTime, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, errs.WrapMsg(err, "parse join time failed", "roomID", roomID, "userID", userID)
+		}
+		users[userID] = joinTime
+	}
+	return users, nil
+}
+
+func (p *Presence) GetUserRooms(ctx context.Context, userID string) ([]string, error) {
+	roomIDs, err := p.rdb.HKeys(ctx, cachekey.GetPresenceUserKey(userID)).Result()
+	if err != nil {
+		return nil, errs.WrapMsg(err, "get user rooms failed", "userID", userID)
+	}
+	return roomIDs, nil
+}
+
+func (p *Presence) GetPresenceRooms(ctx context.Context) ([]string, error) {
+	roomIDs, err := p.rdb.SMembers(ctx, cachekey.GetPresenceRoomsKey()).Result()
+	if err != nil {
+		return nil, errs.WrapMsg(err, "get presence rooms failed")
+	}
+	return roomIDs, nil
+}
+
+func (p *Presence) DelRoomPresence(ctx context.Context, roomID string) error {
+	roomKey := cachekey.GetPresenceRoomKey(roomID)
+	userIDs, err := p.rdb.HKeys(ctx, roomKey).Result()
+	if err != nil {
+		return errs.WrapMsg(err, "get room presence failed", "roomID", roomID)
+	}
+	pipe := p.rdb.Pipeline()
+	for _, userID := range userIDs {
+		pipe.HDel(ctx, cachekey.GetPresenceUserKey(userID), roomID)
+	}
+	pipe.Del(ctx, roomKey)
+	pipe.SRem(ctx, cachekey.GetPresenceRoomsKey(), roomID)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errs.WrapMsg(err, "delete room presence failed", "roomID", roomID)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package redis
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/cachekey"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/cache"
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+	"time"
+)
+
+type Recording struct {
+	rdb redis.UniversalClient
+}
+
+func NewRecording(rdb redis.UniversalClient) cache.Recording {
+	return &Recording{rdb: rdb}
+}
+
+func (r *Recording) LockStart(ctx context.Context, meetingID, owner string, expire time.Duration) (bool, error) {
+	ok, err := r.rdb.SetNX(ctx, cachekey.GetRecordingLockKey(meetingID), owner, expire).Result()
+	if err != nil {
+		return false, errs.WrapMsg(err, "lock recording failed", "meetingID", meetingID)
+	}
+	return ok, nil
+}
+
+func (r *Recording) UnlockStart(ctx context.Context, meetingID, owner string) error {
+	if err := releaseLeaseScript.Run(ctx, r.rdb, []string{cachekey.GetRecordingLockKey(meetingID)}, owner).Err(); err != nil {
+		return errs.WrapMsg(err, "unlock recording failed", "meetingID", meetingID)
+	}
+	return nil
+}
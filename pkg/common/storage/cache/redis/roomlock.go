@@ -0,0 +1,33 @@
+package redis
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/cachekey"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/cache"
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+	"time"
+)
+
+type RoomLock struct {
+	rdb redis.UniversalClient
+}
+
+func NewRoomLock(rdb redis.UniversalClient) cache.RoomLock {
+	return &RoomLock{rdb: rdb}
+}
+
+func (r *RoomLock) LockRoom(ctx context.Context, roomID, owner string, expire time.Duration) (bool, error) {
+	ok, err := r.rdb.SetNX(ctx, cachekey.GetRoomLockKey(roomID), owner, expire).Result()
+	if err != nil {
+		return false, errs.WrapMsg(err, "lock room failed", "roomID", roomID)
+	}
+	return ok, nil
+}
+
+func (r *RoomLock) UnlockRoom(ctx context.Context, roomID, owner string) error {
+	if err := releaseLeaseScript.Run(ctx, r.rdb, []string{cachekey.GetRoomLockKey(roomID)}, owner).Err(); err != nil {
+		return errs.WrapMsg(err, "unlock room failed", "roomID", roomID)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/openimsdk/openmeeting-server/pkg/common/cachekey"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/cache"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+	"sort"
+	"time"
+)
+
+const (
+	// waitingRoomExpireTime drops the waiting room of the meeting which never ends properly
+	waitingRoomExpireTime = time.Hour * 24
+)
+
+type WaitingRoom struct {
+	rdb        redis.UniversalClient
+	expireTime time.Duration
+}
+
+func NewWaitingRoom(rdb redis.UniversalClient) cache.WaitingRoom {
+	return &WaitingRoom{rdb: rdb, expireTime: waitingRoomExpireTime}
+}
+
+func (w *WaitingRoom) SetWaitingUsers(ctx context.Context, meetingID string, users []*model.WaitingUser) error {
+	if len(users) == 0 {
+		return nil
+	}
+	values := make(map[string]any, len(users))
+	for _, user := range users {
+		data, err := json.Marshal(user)
+		if err != nil {
+			return errs.WrapMsg(err, "marshal waiting user failed", "userID", user.UserID)
+		}
+		values[user.UserID] = string(data)
+	}
+	key := cachekey.GetWaitingRoomKey(meetingID)
+	pipe := w.rdb.TxPipeline()
+	pipe.HSet(ctx, key, values)
+	pipe.Expire(ctx, key, w.expireTime)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errs.WrapMsg(err, "set waiting users failed", "meetingID", meetingID)
+	}
+	return nil
+}
+
+func (w *WaitingRoom) GetWaitingUser(ctx context.Context, meetingID, userID string) (*model.WaitingUser, error) {
+	data, err := w.rdb.HGet(ctx, cachekey.GetWaitingRoomKey(meetingID), userID).Result()
+	if err != nil {
+		if errs.Unwrap(err) == redis.Nil {
+			return nil, errs.ErrRecordNotFound.WrapMsg("user is not in the waiting room", "meetingID", meetingID, "userID", userID)
+		}
+		return nil, errs.WrapMsg(err, "get waiting user failed", "meetingID", meetingID, "userID", userID)
+	}
+	user := &model.WaitingUser{}
+	if err := json.Unmarshal([]byte(data), user); err != nil {
+		return nil, errs.WrapMsg(err, "unmarshal waiting user failed", "meetingID", meetingID, "userID", userID)
+	}
+	return user, nil
+}
+
+// GetWaitingUsers the users are ordered by the time they started waiting
+func (w *WaitingRoom) GetWaitingUsers(ctx context.Context, meetingID string) ([]*model.WaitingUser, error) {
+	values, err := w.rdb.HGetAll(ctx, cachekey.GetWaitingRoomKey(meetingID)).Result()
+	if err != nil {
+		return nil, errs.WrapMsg(err, "get waiting users failed", "meetingID", meetingID)
+	}
+	users := make([]*model.WaitingUser, 0, len(values))
+	for userID, data := range values {
+		user := &model.WaitingUser{}
+		if err := json.Unmarshal([]byte(data), user); err != nil {
+			return nil, errs.WrapMsg(err, "unmarshal waiting user failed", "meetingID", meetingID, "userID", userID)
+		}
+		users = append(users, user)
+	}
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].RequestTime < users[j].RequestTime
+	})
+	return users, nil
+}
+
+func (w *WaitingRoom) DelWaitingUsers(ctx context.Context, meetingID string, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	if err := w.rdb.HDel(ctx, cachekey.GetWaitingRoomKey(meetingID), userIDs...).Err(); err != nil {
+		return errs.WrapMsg(err, "delete waiting users failed", "meetingID", meetingID)
+	}
+	return nil
+}
+
+func (w *WaitingRoom) DelWaitingRoom(ctx context.Context, meetingID string) error {
+	if err := w.rdb.Del(ctx, cachekey.GetWaitingRoomKey(meetingID)).Err(); err != nil {
+		return errs.WrapMsg(err, "delete waiting room failed", "meetingID", meetingID)
+	}
+	return nil
+}
@@ -0,0 +1,14 @@
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// RoomLock serializes the writes of the room metadata across the replicas
+type RoomLock interface {
+	// LockRoom false if the room is locked by another owner, the lock is released after expire anyway
+	LockRoom(ctx context.Context, roomID, owner string, expire time.Duration) (bool, error)
+	// UnlockRoom release the lock if it is held by the owner
+	UnlockRoom(ctx context.Context, roomID, owner string) error
+}
@@ -0,0 +1,17 @@
+package cache
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+)
+
+type WaitingRoom interface {
+	// SetWaitingUsers insert or replace the users in the waiting room of the meeting
+	SetWaitingUsers(ctx context.Context, meetingID string, users []*model.WaitingUser) error
+	// GetWaitingUser get the user in the waiting room, errs.ErrRecordNotFound if the user is not in it
+	GetWaitingUser(ctx context.Context, meetingID, userID string) (*model.WaitingUser, error)
+	GetWaitingUsers(ctx context.Context, meetingID string) ([]*model.WaitingUser, error)
+	DelWaitingUsers(ctx context.Context, meetingID string, userIDs []string) error
+	// DelWaitingRoom remove all the users in the waiting room of the meeting
+	DelWaitingRoom(ctx context.Context, meetingID string) error
+}
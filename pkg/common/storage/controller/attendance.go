@@ -0,0 +1,68 @@
+package controller
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/database"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+)
+
+type Attendance interface {
+	// Join Record the user joined the meeting, no-op if the user is already in it
+	Join(ctx context.Context, attendance *model.MeetingAttendance) error
+	// Leave Record the users left the meeting, no-op for the users not in it
+	Leave(ctx context.Context, meetingID string, userIDs []string, leaveTime int64, reason string) error
+	// LeaveAll Record all the users in the meeting left, e.g., when the meeting ends
+	LeaveAll(ctx context.Context, meetingID string, leaveTime int64, reason string) error
+	Find(ctx context.Context, meetingID, occurrenceID string) ([]*model.MeetingAttendance, error)
+	// FindByJoinTime Get the attendances of all the meetings joined within [start, end)
+	FindByJoinTime(ctx context.Context, start, end int64) ([]*model.MeetingAttendance, error)
+	// RecordHostChange Record the host of the meeting was handed over
+	RecordHostChange(ctx context.Context, change *model.MeetingHostChange) error
+	FindHostChanges(ctx context.Context, meetingID, occurrenceID string) ([]*model.MeetingHostChange, error)
+	// FindHostChangesByTime Get the host changes of all the meetings within [start, end)
+	FindHostChangesByTime(ctx context.Context, start, end int64) ([]*model.MeetingHostChange, error)
+}
+
+type AttendanceStorageManager struct {
+	db           database.Attendance
+	hostChangeDB database.HostChange
+}
+
+func NewAttendance(db database.Attendance, hostChangeDB database.HostChange) Attendance {
+	return &AttendanceStorageManager{db: db, hostChangeDB: hostChangeDB}
+}
+
+func (a *AttendanceStorageManager) Join(ctx context.Context, attendance *model.MeetingAttendance) error {
+	return a.db.Join(ctx, attendance)
+}
+
+func (a *AttendanceStorageManager) Leave(ctx context.Context, meetingID string, userIDs []string, leaveTime int64, reason string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return a.db.Leave(ctx, meetingID, userIDs, leaveTime, reason)
+}
+
+func (a *AttendanceStorageManager) LeaveAll(ctx context.Context, meetingID string, leaveTime int64, reason string) error {
+	return a.db.LeaveAll(ctx, meetingID, leaveTime, reason)
+}
+
+func (a *AttendanceStorageManager) Find(ctx context.Context, meetingID, occurrenceID string) ([]*model.MeetingAttendance, error) {
+	return a.db.Find(ctx, meetingID, occurrenceID)
+}
+
+func (a *AttendanceStorageManager) FindByJoinTime(ctx context.Context, start, end int64) ([]*model.MeetingAttendance, error) {
+	return a.db.FindByJoinTime(ctx, start, end)
+}
+
+func (a *AttendanceStorageManager) RecordHostChange(ctx context.Context, change *model.MeetingHostChange) error {
+	return a.hostChangeDB.Create(ctx, change)
+}
+
+func (a *AttendanceStorageManager) FindHostChanges(ctx context.Context, meetingID, occurrenceID string) ([]*model.MeetingHostChange, error) {
+	return a.hostChangeDB.Find(ctx, meetingID, occurrenceID)
+}
+
+func (a *AttendanceStorageManager) FindHostChangesByTime(ctx context.Context, start, end int64) ([]*model.MeetingHostChange, error) {
+	return a.hostChangeDB.FindByChangeTime(ctx, start, end)
+}
@@ -0,0 +1,35 @@
+package controller
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/cache"
+)
+
+type Breakout interface {
+	// SetEndTime Record the time the breakout rooms of the meeting are closed at, in seconds
+	SetEndTime(ctx context.Context, meetingID string, endTime int64) error
+	// FindOverdue Get the meetings whose breakout rooms reach their end time
+	FindOverdue(ctx context.Context, now int64) ([]string, error)
+	// DelEndTime Forget the end time once the breakout rooms are closed
+	DelEndTime(ctx context.Context, meetingID string) error
+}
+
+type BreakoutStorageManager struct {
+	cache cache.Breakout
+}
+
+func NewBreakout(cache cache.Breakout) Breakout {
+	return &BreakoutStorageManager{cache: cache}
+}
+
+func (b *BreakoutStorageManager) SetEndTime(ctx context.Context, meetingID string, endTime int64) error {
+	return b.cache.SetBreakoutEndTime(ctx, meetingID, endTime)
+}
+
+func (b *BreakoutStorageManager) FindOverdue(ctx context.Context, now int64) ([]string, error) {
+	return b.cache.GetOverdueBreakouts(ctx, now)
+}
+
+func (b *BreakoutStorageManager) DelEndTime(ctx context.Context, meetingID string) error {
+	return b.cache.DelBreakoutEndTime(ctx, meetingID)
+}
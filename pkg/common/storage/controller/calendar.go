@@ -0,0 +1,34 @@
+package controller
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/database"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+)
+
+type CalendarFeed interface {
+	Take(ctx context.Context, userID string) (*model.CalendarFeed, error)
+	TakeByToken(ctx context.Context, token string) (*model.CalendarFeed, error)
+	// Save Replace the feed token of the user, the old token stops working
+	Save(ctx context.Context, feed *model.CalendarFeed) error
+}
+
+type CalendarFeedStorageManager struct {
+	db database.CalendarFeed
+}
+
+func NewCalendarFeed(db database.CalendarFeed) CalendarFeed {
+	return &CalendarFeedStorageManager{db: db}
+}
+
+func (c *CalendarFeedStorageManager) Take(ctx context.Context, userID string) (*model.CalendarFeed, error) {
+	return c.db.Take(ctx, userID)
+}
+
+func (c *CalendarFeedStorageManager) TakeByToken(ctx context.Context, token string) (*model.CalendarFeed, error) {
+	return c.db.TakeByToken(ctx, token)
+}
+
+func (c *CalendarFeedStorageManager) Save(ctx context.Context, feed *model.CalendarFeed) error {
+	return c.db.Upsert(ctx, feed)
+}
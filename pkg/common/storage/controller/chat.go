@@ -0,0 +1,36 @@
+package controller
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/database"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+type Chat interface {
+	Create(ctx context.Context, message *model.MeetingChatMessage) error
+	// Page Get the messages of the meeting visible to the user, the newest first
+	Page(ctx context.Context, meetingID, occurrenceID, userID string, withHosts bool, pagination pagination.Pagination) (int64, []*model.MeetingChatMessage, error)
+	// DeleteByMeetingID Delete the messages of the meeting, e.g., when the meeting is cancelled
+	DeleteByMeetingID(ctx context.Context, meetingID string) error
+}
+
+type ChatStorageManager struct {
+	db database.Chat
+}
+
+func NewChat(db database.Chat) Chat {
+	return &ChatStorageManager{db: db}
+}
+
+func (c *ChatStorageManager) Create(ctx context.Context, message *model.MeetingChatMessage) error {
+	return c.db.Create(ctx, message)
+}
+
+func (c *ChatStorageManager) Page(ctx context.Context, meetingID, occurrenceID, userID string, withHosts bool, pagination pagination.Pagination) (int64, []*model.MeetingChatMessage, error) {
+	return c.db.Page(ctx, meetingID, occurrenceID, userID, withHosts, pagination)
+}
+
+func (c *ChatStorageManager) DeleteByMeetingID(ctx context.Context, meetingID string) error {
+	return c.db.DeleteByMeetingID(ctx, meetingID)
+}
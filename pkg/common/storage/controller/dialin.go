@@ -0,0 +1,42 @@
+package controller
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/database"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+)
+
+type DialIn interface {
+	// Create Allocate the PIN to the meeting, errs.ErrDuplicateKey if the meeting has one or the PIN is taken
+	Create(ctx context.Context, dialIn *model.MeetingDialIn) error
+	// Take Get the dial-in of the meeting, errs.ErrRecordNotFound if no PIN is allocated
+	Take(ctx context.Context, meetingID string) (*model.MeetingDialIn, error)
+	// UpdateRuleID Set the dispatch rule of the open room, empty after the room is closed
+	UpdateRuleID(ctx context.Context, meetingID, ruleID string) error
+	// Delete Release the PIN of the meeting
+	Delete(ctx context.Context, meetingID string) error
+}
+
+type DialInStorageManager struct {
+	db database.DialIn
+}
+
+func NewDialIn(db database.DialIn) DialIn {
+	return &DialInStorageManager{db: db}
+}
+
+func (d *DialInStorageManager) Create(ctx context.Context, dialIn *model.MeetingDialIn) error {
+	return d.db.Create(ctx, dialIn)
+}
+
+func (d *DialInStorageManager) Take(ctx context.Context, meetingID string) (*model.MeetingDialIn, error) {
+	return d.db.Take(ctx, meetingID)
+}
+
+func (d *DialInStorageManager) UpdateRuleID(ctx context.Context, meetingID, ruleID string) error {
+	return d.db.UpdateRuleID(ctx, meetingID, ruleID)
+}
+
+func (d *DialInStorageManager) Delete(ctx context.Context, meetingID string) error {
+	return d.db.Delete(ctx, meetingID)
+}
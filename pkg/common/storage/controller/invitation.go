@@ -0,0 +1,53 @@
+package controller
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/database"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+)
+
+type Invitation interface {
+	// CreateIfNotExist Invite the users, the ones already invited keep their response
+	CreateIfNotExist(ctx context.Context, invitations []*model.MeetingInvitation) error
+	Delete(ctx context.Context, meetingID string, userIDs []string) error
+	// UpdateResponse Set the RSVP of the invited user, an error is returned if the user is not invited
+	UpdateResponse(ctx context.Context, meetingID, userID, response string, responseTime int64) error
+	FindByMeetingID(ctx context.Context, meetingID string) ([]*model.MeetingInvitation, error)
+	FindByUserID(ctx context.Context, userID string, responses []string) ([]*model.MeetingInvitation, error)
+	DeleteByMeetingID(ctx context.Context, meetingID string) error
+}
+
+type InvitationStorageManager struct {
+	db database.Invitation
+}
+
+func NewInvitation(db database.Invitation) Invitation {
+	return &InvitationStorageManager{db: db}
+}
+
+func (i *InvitationStorageManager) CreateIfNotExist(ctx context.Context, invitations []*model.MeetingInvitation) error {
+	return i.db.CreateIfNotExist(ctx, invitations)
+}
+
+func (i *InvitationStorageManager) Delete(ctx context.Context, meetingID string, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return i.db.Delete(ctx, meetingID, userIDs)
+}
+
+func (i *InvitationStorageManager) UpdateResponse(ctx context.Context, meetingID, userID, response string, responseTime int64) error {
+	return i.db.UpdateResponse(ctx, meetingID, userID, response, responseTime)
+}
+
+func (i *InvitationStorageManager) FindByMeetingID(ctx context.Context, meetingID string) ([]*model.MeetingInvitation, error) {
+	return i.db.FindByMeetingID(ctx, meetingID)
+}
+
+func (i *InvitationStorageManager) FindByUserID(ctx context.Context, userID string, responses []string) ([]*model.MeetingInvitation, error) {
+	return i.db.FindByUserID(ctx, userID, responses)
+}
+
+func (i *InvitationStorageManager) DeleteByMeetingID(ctx context.Context, meetingID string) error {
+	return i.db.DeleteByMeetingID(ctx, meetingID)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/openimsdk/tools/db/tx"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/utils/datautil"
+	"time"
 )
 
 type Meeting interface {
@@ -18,7 +19,16 @@ type Meeting interface {
 	Update(ctx context.Context, meetingID string, updateData map[string]any) (err error)
 	Delete(ctx context.Context, meetingID string) (err error)
 	FindByStatus(ctx context.Context, status []string, userID string) ([]*model.MeetingInfo, error)
+	// FindByMeetingIDs Get the meetings in the status, all the status are matched if status is empty
+	FindByMeetingIDs(ctx context.Context, meetingIDs []string, status []string) ([]*model.MeetingInfo, error)
+	// FindOverdue Get the meetings in the status lasting for the maximum duration since their start time,
+	// maxDuration is the server limit, 0 means no limit
+	FindOverdue(ctx context.Context, status string, maxDuration int64, now int64) ([]*model.MeetingInfo, error)
 	GenerateMeetingID(ctx context.Context) (string, error)
+	// AcquireSchedulerLease try to become or stay the only replica running the meeting scheduler
+	AcquireSchedulerLease(ctx context.Context, owner string, expire time.Duration) (bool, error)
+	// ReleaseSchedulerLease give up the scheduler lease held by owner
+	ReleaseSchedulerLease(ctx context.Context, owner string) error
 }
 
 type MeetingStorageManager struct {
@@ -74,6 +84,25 @@ func (u *MeetingStorageManager) FindByStatus(ctx context.Context, status []strin
 	return u.db.FindByStatus(ctx, status, userID)
 }
 
+func (u *MeetingStorageManager) FindByMeetingIDs(ctx context.Context, meetingIDs []string, status []string) ([]*model.MeetingInfo, error) {
+	if len(meetingIDs) == 0 {
+		return nil, nil
+	}
+	return u.db.FindByMeetingIDs(ctx, meetingIDs, status)
+}
+
+func (u *MeetingStorageManager) FindOverdue(ctx context.Context, status string, maxDuration int64, now int64) ([]*model.MeetingInfo, error) {
+	return u.db.FindOverdue(ctx, status, maxDuration, now)
+}
+
 func (u *MeetingStorageManager) GenerateMeetingID(ctx context.Context) (string, error) {
 	return u.cache.GenerateMeetingID(ctx)
 }
+
+func (u *MeetingStorageManager) AcquireSchedulerLease(ctx context.Context, owner string, expire time.Duration) (bool, error) {
+	return u.cache.AcquireSchedulerLease(ctx, owner, expire)
+}
+
+func (u *MeetingStorageManager) ReleaseSchedulerLease(ctx context.Context, owner string) error {
+	return u.cache.ReleaseSchedulerLease(ctx, owner)
+}
@@ -0,0 +1,118 @@
+package controller
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/database"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/tx"
+	"github.com/openimsdk/tools/errs"
+)
+
+type Occurrence interface {
+	// CreateIfNotExist Insert occurrences, the ones already generated before are kept untouched
+	CreateIfNotExist(ctx context.Context, occurrences []*model.MeetingOccurrence) error
+	Take(ctx context.Context, occurrenceID string) (*model.MeetingOccurrence, error)
+	// TakeCurrent Get the occurrence in progress, or the scheduled one whose planned time covers now
+	TakeCurrent(ctx context.Context, meetingID string, now int64) (*model.MeetingOccurrence, error)
+	Update(ctx context.Context, occurrenceID string, updateData map[string]any) error
+	AddParticipant(ctx context.Context, occurrenceID, userID string) error
+	// FindInWindow Get the occurrences started before the end and not ended by the start of the window
+	FindInWindow(ctx context.Context, meetingIDs []string, start, end int64, status []string) ([]*model.MeetingOccurrence, error)
+	FindByStatus(ctx context.Context, status []string) ([]*model.MeetingOccurrence, error)
+	// FindToRemind Get the scheduled occurrences starting in (start, end] which are not reminded yet
+	FindToRemind(ctx context.Context, start, end int64) ([]*model.MeetingOccurrence, error)
+	// Regenerate Replace the occurrences not started after the given time with the new ones
+	Regenerate(ctx context.Context, meetingID string, after int64, occurrences []*model.MeetingOccurrence) error
+	// DeleteByMeetingID Delete the occurrences and the overrides of the meeting
+	DeleteByMeetingID(ctx context.Context, meetingID string) error
+	// Override Save the override and apply it on the stored occurrence in one transaction
+	Override(ctx context.Context, override *model.MeetingOverride, occurrence *model.MeetingOccurrence, updateData map[string]any) error
+	TakeOverride(ctx context.Context, meetingID string, originalStartTime int64) (*model.MeetingOverride, error)
+	FindOverrides(ctx context.Context, meetingIDs []string) ([]*model.MeetingOverride, error)
+}
+
+type OccurrenceStorageManager struct {
+	tx         tx.Tx
+	db         database.Occurrence
+	overrideDB database.MeetingOverride
+}
+
+func NewOccurrence(occurrenceDB database.Occurrence, overrideDB database.MeetingOverride, tx tx.Tx) Occurrence {
+	return &OccurrenceStorageManager{db: occurrenceDB, overrideDB: overrideDB, tx: tx}
+}
+
+func (o *OccurrenceStorageManager) CreateIfNotExist(ctx context.Context, occurrences []*model.MeetingOccurrence) error {
+	return o.db.CreateIfNotExist(ctx, occurrences)
+}
+
+func (o *OccurrenceStorageManager) Take(ctx context.Context, occurrenceID string) (*model.MeetingOccurrence, error) {
+	return o.db.Take(ctx, occurrenceID)
+}
+
+func (o *OccurrenceStorageManager) TakeCurrent(ctx context.Context, meetingID string, now int64) (*model.MeetingOccurrence, error) {
+	return o.db.TakeCurrent(ctx, meetingID, now)
+}
+
+func (o *OccurrenceStorageManager) Update(ctx context.Context, occurrenceID string, updateData map[string]any) error {
+	return o.db.Update(ctx, occurrenceID, updateData)
+}
+
+func (o *OccurrenceStorageManager) AddParticipant(ctx context.Context, occurrenceID, userID string) error {
+	return o.db.AddParticipant(ctx, occurrenceID, userID)
+}
+
+func (o *OccurrenceStorageManager) FindInWindow(ctx context.Context, meetingIDs []string, start, end int64, status []string) ([]*model.MeetingOccurrence, error) {
+	if len(meetingIDs) == 0 {
+		return nil, nil
+	}
+	return o.db.FindInWindow(ctx, meetingIDs, start, end, status)
+}
+
+func (o *OccurrenceStorageManager) FindByStatus(ctx context.Context, status []string) ([]*model.MeetingOccurrence, error) {
+	return o.db.FindByStatus(ctx, status)
+}
+
+func (o *OccurrenceStorageManager) FindToRemind(ctx context.Context, start, end int64) ([]*model.MeetingOccurrence, error) {
+	return o.db.FindToRemind(ctx, start, end)
+}
+
+func (o *OccurrenceStorageManager) Regenerate(ctx context.Context, meetingID string, after int64, occurrences []*model.MeetingOccurrence) error {
+	return o.tx.Transaction(ctx, func(ctx context.Context) error {
+		if err := o.db.DeleteNotStarted(ctx, meetingID, after); err != nil {
+			return errs.WrapMsg(err, "delete not started occurrences failed, meetingID:", meetingID)
+		}
+		return o.db.CreateIfNotExist(ctx, occurrences)
+	})
+}
+
+func (o *OccurrenceStorageManager) DeleteByMeetingID(ctx context.Context, meetingID string) error {
+	return o.tx.Transaction(ctx, func(ctx context.Context) error {
+		if err := o.db.DeleteByMeetingID(ctx, meetingID); err != nil {
+			return err
+		}
+		return o.overrideDB.DeleteByMeetingID(ctx, meetingID)
+	})
+}
+
+func (o *OccurrenceStorageManager) Override(ctx context.Context, override *model.MeetingOverride, occurrence *model.MeetingOccurrence, updateData map[string]any) error {
+	return o.tx.Transaction(ctx, func(ctx context.Context) error {
+		if err := o.overrideDB.Upsert(ctx, override); err != nil {
+			return err
+		}
+		if err := o.db.CreateIfNotExist(ctx, []*model.MeetingOccurrence{occurrence}); err != nil {
+			return err
+		}
+		return o.db.Update(ctx, occurrence.OccurrenceID, updateData)
+	})
+}
+
+func (o *OccurrenceStorageManager) TakeOverride(ctx context.Context, meetingID string, originalStartTime int64) (*model.MeetingOverride, error) {
+	return o.overrideDB.Take(ctx, meetingID, originalStartTime)
+}
+
+func (o *OccurrenceStorageManager) FindOverrides(ctx context.Context, meetingIDs []string) ([]*model.MeetingOverride, error) {
+	if len(meetingIDs) == 0 {
+		return nil, nil
+	}
+	return o.overrideDB.Find(ctx, meetingIDs)
+}
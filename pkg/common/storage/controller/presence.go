@@ -0,0 +1,90 @@
+package controller
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/cache"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/openimsdk/tools/utils/timeutil"
+)
+
+type Presence interface {
+	// Join Put the user into the room, the user is connected to it rather than holding a token of it
+	Join(ctx context.Context, roomID, userID string) error
+	Leave(ctx context.Context, roomID string, userIDs []string) error
+	// FindUserRooms Get the rooms the user is in, a breakout room is kept apart from the room of its meeting
+	FindUserRooms(ctx context.Context, userID string) ([]string, error)
+	// CloseRoom Remove the room with all the users in it
+	CloseRoom(ctx context.Context, roomID string) error
+	// Reconcile Replace the index with the users of the rooms in the rtc server keyed by the roomID,
+	// the users joining since the rooms are listed are kept, in milliseconds, and the skipped rooms are left as they are
+	Reconcile(ctx context.Context, rooms map[string][]string, skippedRoomIDs []string, since int64) error
+}
+
+type PresenceStorageManager struct {
+	cache cache.Presence
+}
+
+func NewPresence(cache cache.Presence) Presence {
+	return &PresenceStorageManager{cache: cache}
+}
+
+func (p *PresenceStorageManager) Join(ctx context.Context, roomID, userID string) error {
+	return p.cache.AddPresence(ctx, roomID, userID, timeutil.GetCurrentTimestampByMill())
+}
+
+func (p *PresenceStorageManager) Leave(ctx context.Context, roomID string, userIDs []string) error {
+	return p.cache.DelPresence(ctx, roomID, userIDs)
+}
+
+func (p *PresenceStorageManager) FindUserRooms(ctx context.Context, userID string) ([]string, error) {
+	return p.cache.GetUserRooms(ctx, userID)
+}
+
+func (p *PresenceStorageManager) CloseRoom(ctx context.Context, roomID string) error {
+	return p.cache.DelRoomPresence(ctx, roomID)
+}
+
+func (p *PresenceStorageManager) Reconcile(ctx context.Context, rooms map[string][]string, skippedRoomIDs []string, since int64) error {
+	indexed, err := p.cache.GetPresenceRooms(ctx)
+	if err != nil {
+		return err
+	}
+	roomIDs := datautil.Keys(rooms)
+	for _, roomID := range indexed {
+		if _, ok := rooms[roomID]; !ok && !datautil.Contain(roomID, skippedRoomIDs...) {
+			roomIDs = append(roomIDs, roomID)
+		}
+	}
+	for _, roomID := range roomIDs {
+		current, err := p.cache.GetRoomPresence(ctx, roomID)
+		if err != nil {
+			return err
+		}
+		userIDs, live := rooms[roomID]
+		if !live && len(current) == 0 {
+			// the users of the room expired
+			if err := p.cache.DelRoomPresence(ctx, roomID); err != nil {
+				return err
+			}
+			continue
+		}
+		for _, userID := range userIDs {
+			if _, ok := current[userID]; ok {
+				continue
+			}
+			if err := p.cache.AddPresence(ctx, roomID, userID, since); err != nil {
+				return err
+			}
+		}
+		var left []string
+		for userID, joinTime := range current {
+			if joinTime < since && !datautil.Contain(userID, userIDs...) {
+				left = append(left, userID)
+			}
+		}
+		if err := p.cache.DelPresence(ctx, roomID, left); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package controller
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/cache"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/database"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+	"time"
+)
+
+type Recording interface {
+	Create(ctx context.Context, recording *model.MeetingRecording) error
+	// Take Get the recording by the id of the rtc server, errs.ErrRecordNotFound if it is not recorded by the meeting
+	Take(ctx context.Context, recordingID string) (*model.MeetingRecording, error)
+	Update(ctx context.Context, recordingID string, update map[string]any) error
+	// FindActive Get the recordings of the meeting in progress
+	FindActive(ctx context.Context, meetingID string) ([]*model.MeetingRecording, error)
+	// Page Get the recordings of the meeting, the newest first
+	Page(ctx context.Context, meetingID, occurrenceID string, pagination pagination.Pagination) (int64, []*model.MeetingRecording, error)
+	// LockStart Only one replica starts the recording of the meeting at a time, false if it is locked by another owner
+	LockStart(ctx context.Context, meetingID, owner string, expire time.Duration) (bool, error)
+	// UnlockStart Release the lock held by owner
+	UnlockStart(ctx context.Context, meetingID, owner string) error
+}
+
+type RecordingStorageManager struct {
+	db    database.Recording
+	cache cache.Recording
+}
+
+func NewRecording(db database.Recording, cache cache.Recording) Recording {
+	return &RecordingStorageManager{db: db, cache: cache}
+}
+
+func (r *RecordingStorageManager) Create(ctx context.Context, recording *model.MeetingRecording) error {
+	return r.db.Create(ctx, recording)
+}
+
+func (r *RecordingStorageManager) Take(ctx context.Context, recordingID string) (*model.MeetingRecording, error) {
+	return r.db.Take(ctx, recordingID)
+}
+
+func (r *RecordingStorageManager) Update(ctx context.Context, recordingID string, update map[string]any) error {
+	return r.db.Update(ctx, recordingID, update)
+}
+
+func (r *RecordingStorageManager) FindActive(ctx context.Context, meetingID string) ([]*model.MeetingRecording, error) {
+	return r.db.FindActive(ctx, meetingID)
+}
+
+func (r *RecordingStorageManager) Page(ctx context.Context, meetingID, occurrenceID string, pagination pagination.Pagination) (int64, []*model.MeetingRecording, error) {
+	return r.db.Page(ctx, meetingID, occurrenceID, pagination)
+}
+
+func (r *RecordingStorageManager) LockStart(ctx context.Context, meetingID, owner string, expire time.Duration) (bool, error) {
+	return r.cache.LockStart(ctx, meetingID, owner, expire)
+}
+
+func (r *RecordingStorageManager) UnlockStart(ctx context.Context, meetingID, owner string) error {
+	return r.cache.UnlockStart(ctx, meetingID, owner)
+}
@@ -0,0 +1,64 @@
+package controller
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/database"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+type MeetingState interface {
+	// Take Get the state of the meeting, errs.ErrRecordNotFound if the state is not saved
+	Take(ctx context.Context, meetingID string) (*model.MeetingState, error)
+	// SetHost Hand the host of the meeting over, the host is taken out of the co-hosts
+	SetHost(ctx context.Context, meetingID, hostUserID string) error
+	// UpdateCoHosts Add and remove the co-hosts of the meeting in one update
+	UpdateCoHosts(ctx context.Context, meetingID string, addUserIDs, removeUserIDs []string) error
+	// UpdateRoles Set the presenters or the viewers of the meeting, the users of the other roles are removed from them
+	UpdateRoles(ctx context.Context, meetingID string, userIDs []string, role string) error
+	FindParticipants(ctx context.Context, meetingID string) ([]*model.MeetingParticipantState, error)
+	// UpdateParticipant Update the personal data of the participant, which is created from defaultData if not saved
+	UpdateParticipant(ctx context.Context, defaultData *model.MeetingParticipantState, updateData map[string]any) error
+	// Delete Remove the state when the meeting is not in progress any more
+	Delete(ctx context.Context, meetingID string) error
+}
+
+type MeetingStateStorageManager struct {
+	db database.MeetingState
+}
+
+func NewMeetingState(db database.MeetingState) MeetingState {
+	return &MeetingStateStorageManager{db: db}
+}
+
+func (m *MeetingStateStorageManager) Take(ctx context.Context, meetingID string) (*model.MeetingState, error) {
+	return m.db.Take(ctx, meetingID)
+}
+
+func (m *MeetingStateStorageManager) SetHost(ctx context.Context, meetingID, hostUserID string) error {
+	return m.db.SetHost(ctx, meetingID, hostUserID)
+}
+
+func (m *MeetingStateStorageManager) UpdateCoHosts(ctx context.Context, meetingID string, addUserIDs, removeUserIDs []string) error {
+	return m.db.UpdateCoHosts(ctx, meetingID, datautil.Distinct(addUserIDs), removeUserIDs)
+}
+
+func (m *MeetingStateStorageManager) UpdateRoles(ctx context.Context, meetingID string, userIDs []string, role string) error {
+	return m.db.UpdateRoles(ctx, meetingID, datautil.Distinct(userIDs), role)
+}
+
+func (m *MeetingStateStorageManager) FindParticipants(ctx context.Context, meetingID string) ([]*model.MeetingParticipantState, error) {
+	return m.db.FindParticipants(ctx, meetingID)
+}
+
+func (m *MeetingStateStorageManager) UpdateParticipant(ctx context.Context, defaultData *model.MeetingParticipantState, updateData map[string]any) error {
+	if err := m.db.CreateParticipant(ctx, defaultData); err != nil {
+		return errs.WrapMsg(err, "create participant state failed", "meetingID", defaultData.MeetingID, "userID", defaultData.UserID)
+	}
+	return m.db.UpdateParticipant(ctx, defaultData.MeetingID, defaultData.UserID, updateData)
+}
+
+func (m *MeetingStateStorageManager) Delete(ctx context.Context, meetingID string) error {
+	return m.db.Delete(ctx, meetingID)
+}
@@ -0,0 +1,56 @@
+package controller
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/cache"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+)
+
+type WaitingRoom interface {
+	// Wait Put the user into the waiting room, the previous status of the user is replaced
+	Wait(ctx context.Context, user *model.WaitingUser) error
+	// Respond Save the status of the users admitted or denied by the host
+	Respond(ctx context.Context, users []*model.WaitingUser) error
+	// TakeUser Get the user in the waiting room, return an error if the user is not in it
+	TakeUser(ctx context.Context, meetingID, userID string) (*model.WaitingUser, error)
+	FindUsers(ctx context.Context, meetingID string) ([]*model.WaitingUser, error)
+	// Remove Take the users out of the waiting room, e.g., after they joined the meeting
+	Remove(ctx context.Context, meetingID string, userIDs []string) error
+	// Clear Remove all the users, e.g., when the meeting ends
+	Clear(ctx context.Context, meetingID string) error
+}
+
+type WaitingRoomStorageManager struct {
+	cache cache.WaitingRoom
+}
+
+func NewWaitingRoom(cache cache.WaitingRoom) WaitingRoom {
+	return &WaitingRoomStorageManager{cache: cache}
+}
+
+func (w *WaitingRoomStorageManager) Wait(ctx context.Context, user *model.WaitingUser) error {
+	return w.cache.SetWaitingUsers(ctx, user.MeetingID, []*model.WaitingUser{user})
+}
+
+func (w *WaitingRoomStorageManager) Respond(ctx context.Context, users []*model.WaitingUser) error {
+	if len(users) == 0 {
+		return nil
+	}
+	return w.cache.SetWaitingUsers(ctx, users[0].MeetingID, users)
+}
+
+func (w *WaitingRoomStorageManager) TakeUser(ctx context.Context, meetingID, userID string) (*model.WaitingUser, error) {
+	return w.cache.GetWaitingUser(ctx, meetingID, userID)
+}
+
+func (w *WaitingRoomStorageManager) FindUsers(ctx context.Context, meetingID string) ([]*model.WaitingUser, error) {
+	return w.cache.GetWaitingUsers(ctx, meetingID)
+}
+
+func (w *WaitingRoomStorageManager) Remove(ctx context.Context, meetingID string, userIDs []string) error {
+	return w.cache.DelWaitingUsers(ctx, meetingID, userIDs)
+}
+
+func (w *WaitingRoomStorageManager) Clear(ctx context.Context, meetingID string) error {
+	return w.cache.DelWaitingRoom(ctx, meetingID)
+}
@@ -0,0 +1,71 @@
+package controller
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/database"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+type Webhook interface {
+	CreateSubscription(ctx context.Context, subscription *model.WebhookSubscription) error
+	TakeSubscription(ctx context.Context, subscriptionID string) (*model.WebhookSubscription, error)
+	UpdateSubscription(ctx context.Context, subscriptionID string, updateData map[string]any) error
+	DeleteSubscription(ctx context.Context, subscriptionID string) error
+	FindSubscriptions(ctx context.Context) ([]*model.WebhookSubscription, error)
+	// FindSubscriptionsByEvent Get the enabled subscriptions which should receive the event
+	FindSubscriptionsByEvent(ctx context.Context, eventType string) ([]*model.WebhookSubscription, error)
+	CreateDeadLetter(ctx context.Context, letter *model.WebhookDeadLetter) error
+	TakeDeadLetter(ctx context.Context, deliveryID string) (*model.WebhookDeadLetter, error)
+	PageDeadLetters(ctx context.Context, subscriptionID string, pagination pagination.Pagination) (int64, []*model.WebhookDeadLetter, error)
+	DeleteDeadLetters(ctx context.Context, deliveryIDs []string) error
+}
+
+type WebhookStorageManager struct {
+	subscriptionDB database.WebhookSubscription
+	deadLetterDB   database.WebhookDeadLetter
+}
+
+func NewWebhook(subscriptionDB database.WebhookSubscription, deadLetterDB database.WebhookDeadLetter) Webhook {
+	return &WebhookStorageManager{subscriptionDB: subscriptionDB, deadLetterDB: deadLetterDB}
+}
+
+func (w *WebhookStorageManager) CreateSubscription(ctx context.Context, subscription *model.WebhookSubscription) error {
+	return w.subscriptionDB.Create(ctx, subscription)
+}
+
+func (w *WebhookStorageManager) TakeSubscription(ctx context.Context, subscriptionID string) (*model.WebhookSubscription, error) {
+	return w.subscriptionDB.Take(ctx, subscriptionID)
+}
+
+func (w *WebhookStorageManager) UpdateSubscription(ctx context.Context, subscriptionID string, updateData map[string]any) error {
+	return w.subscriptionDB.Update(ctx, subscriptionID, updateData)
+}
+
+func (w *WebhookStorageManager) DeleteSubscription(ctx context.Context, subscriptionID string) error {
+	return w.subscriptionDB.Delete(ctx, subscriptionID)
+}
+
+func (w *WebhookStorageManager) FindSubscriptions(ctx context.Context) ([]*model.WebhookSubscription, error) {
+	return w.subscriptionDB.Find(ctx)
+}
+
+func (w *WebhookStorageManager) FindSubscriptionsByEvent(ctx context.Context, eventType string) ([]*model.WebhookSubscription, error) {
+	return w.subscriptionDB.FindEnabledByEvent(ctx, eventType)
+}
+
+func (w *WebhookStorageManager) CreateDeadLetter(ctx context.Context, letter *model.WebhookDeadLetter) error {
+	return w.deadLetterDB.Create(ctx, letter)
+}
+
+func (w *WebhookStorageManager) TakeDeadLetter(ctx context.Context, deliveryID string) (*model.WebhookDeadLetter, error) {
+	return w.deadLetterDB.Take(ctx, deliveryID)
+}
+
+func (w *WebhookStorageManager) PageDeadLetters(ctx context.Context, subscriptionID string, pagination pagination.Pagination) (int64, []*model.WebhookDeadLetter, error) {
+	return w.deadLetterDB.Page(ctx, subscriptionID, pagination)
+}
+
+func (w *WebhookStorageManager) DeleteDeadLetters(ctx context.Context, deliveryIDs []string) error {
+	return w.deadLetterDB.Delete(ctx, deliveryIDs)
+}
@@ -0,0 +1,27 @@
+package database
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+)
+
+type Attendance interface {
+	// Join insert the attendance if the user has no open one in the meeting
+	Join(ctx context.Context, attendance *model.MeetingAttendance) error
+	// Leave close the open attendance of the users in the meeting
+	Leave(ctx context.Context, meetingID string, userIDs []string, leaveTime int64, reason string) error
+	// LeaveAll close all the open attendances of the meeting
+	LeaveAll(ctx context.Context, meetingID string, leaveTime int64, reason string) error
+	// Find get the attendances of the meeting ordered by join time, only of the occurrence if occurrenceID is not empty
+	Find(ctx context.Context, meetingID, occurrenceID string) ([]*model.MeetingAttendance, error)
+	// FindByJoinTime get the attendances of all the meetings joined within [start, end) ordered by join time
+	FindByJoinTime(ctx context.Context, start, end int64) ([]*model.MeetingAttendance, error)
+}
+
+type HostChange interface {
+	Create(ctx context.Context, change *model.MeetingHostChange) error
+	// Find get the host changes of the meeting ordered by change time, only of the occurrence if occurrenceID is not empty
+	Find(ctx context.Context, meetingID, occurrenceID string) ([]*model.MeetingHostChange, error)
+	// FindByChangeTime get the host changes of all the meetings within [start, end) ordered by change time
+	FindByChangeTime(ctx context.Context, start, end int64) ([]*model.MeetingHostChange, error)
+}
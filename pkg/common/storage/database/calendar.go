@@ -0,0 +1,15 @@
+package database
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+)
+
+type CalendarFeed interface {
+	// Take get the feed of the user, errs.ErrRecordNotFound is returned if the user has no feed token
+	Take(ctx context.Context, userID string) (*model.CalendarFeed, error)
+	// TakeByToken get the feed by its token, errs.ErrRecordNotFound is returned if the token is unknown
+	TakeByToken(ctx context.Context, token string) (*model.CalendarFeed, error)
+	// Upsert create or replace the feed token of the user
+	Upsert(ctx context.Context, feed *model.CalendarFeed) error
+}
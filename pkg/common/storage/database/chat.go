@@ -0,0 +1,15 @@
+package database
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+type Chat interface {
+	Create(ctx context.Context, message *model.MeetingChatMessage) error
+	// Page get the messages of the meeting visible to the user ordered by send time descending,
+	// the messages to the hosts are included if withHosts, only of the occurrence if occurrenceID is not empty
+	Page(ctx context.Context, meetingID, occurrenceID, userID string, withHosts bool, pagination pagination.Pagination) (int64, []*model.MeetingChatMessage, error)
+	DeleteByMeetingID(ctx context.Context, meetingID string) error
+}
@@ -0,0 +1,15 @@
+package database
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+)
+
+type DialIn interface {
+	// Create errs.ErrDuplicateKey is returned if the meeting or the PIN is taken
+	Create(ctx context.Context, dialIn *model.MeetingDialIn) error
+	// Take get the dial-in of the meeting, errs.ErrRecordNotFound is returned if no PIN is allocated
+	Take(ctx context.Context, meetingID string) (*model.MeetingDialIn, error)
+	UpdateRuleID(ctx context.Context, meetingID, ruleID string) error
+	Delete(ctx context.Context, meetingID string) error
+}
@@ -0,0 +1,18 @@
+package database
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+)
+
+type Invitation interface {
+	// CreateIfNotExist insert the invitations, the users already invited keep their response
+	CreateIfNotExist(ctx context.Context, invitations []*model.MeetingInvitation) error
+	Delete(ctx context.Context, meetingID string, userIDs []string) error
+	// UpdateResponse set the RSVP of the invited user, errs.ErrRecordNotFound is returned if the user is not invited
+	UpdateResponse(ctx context.Context, meetingID, userID, response string, responseTime int64) error
+	FindByMeetingID(ctx context.Context, meetingID string) ([]*model.MeetingInvitation, error)
+	// FindByUserID find the invitations of the user, all the responses are matched if responses is empty
+	FindByUserID(ctx context.Context, userID string, responses []string) ([]*model.MeetingInvitation, error)
+	DeleteByMeetingID(ctx context.Context, meetingID string) error
+}
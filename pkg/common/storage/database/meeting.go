@@ -10,5 +10,10 @@ type Meeting interface {
 	Take(ctx context.Context, meetingID string) (meeting *model.MeetingInfo, err error)
 	Update(ctx context.Context, meetingID string, updateData map[string]any) error
 	FindByStatus(ctx context.Context, status []string, userID string) ([]*model.MeetingInfo, error)
+	// FindByMeetingIDs find the meetings in the status, all the status are matched if status is empty
+	FindByMeetingIDs(ctx context.Context, meetingIDs []string, status []string) ([]*model.MeetingInfo, error)
 	Delete(ctx context.Context, meetingID string) error
+	// FindOverdue find the meetings in the status whose start time plus the maximum duration is not after now,
+	// maxDuration applies to the meetings without their own or with a longer one, 0 means no server limit
+	FindOverdue(ctx context.Context, status string, maxDuration int64, now int64) ([]*model.MeetingInfo, error)
 }
@@ -0,0 +1,126 @@
+package mgo
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/database"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewAttendanceMongo(db *mongo.Database) (database.Attendance, error) {
+	coll := db.Collection("meeting_attendance")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "meeting_id", Value: 1},
+				{Key: "join_time", Value: 1},
+			},
+		},
+		{
+			// one open attendance of the user in the meeting at most
+			Keys: bson.D{
+				{Key: "meeting_id", Value: 1},
+				{Key: "user_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{"leave_time": 0}),
+		},
+		{
+			Keys: bson.D{
+				{Key: "join_time", Value: 1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &AttendanceMgo{coll: coll}, nil
+}
+
+type AttendanceMgo struct {
+	coll *mongo.Collection
+}
+
+func (a *AttendanceMgo) Join(ctx context.Context, attendance *model.MeetingAttendance) error {
+	filter := bson.M{"meeting_id": attendance.MeetingID, "user_id": attendance.UserID, "leave_time": 0}
+	err := mongoutil.UpdateOne(ctx, a.coll, filter, bson.M{"$setOnInsert": attendance}, false, options.Update().SetUpsert(true))
+	if err != nil && mongo.IsDuplicateKeyError(errs.Unwrap(err)) {
+		// the concurrent join inserted the open attendance first
+		return nil
+	}
+	return err
+}
+
+func (a *AttendanceMgo) Leave(ctx context.Context, meetingID string, userIDs []string, leaveTime int64, reason string) error {
+	filter := bson.M{"meeting_id": meetingID, "user_id": bson.M{"$in": userIDs}, "leave_time": 0}
+	_, err := mongoutil.UpdateMany(ctx, a.coll, filter, bson.M{"$set": bson.M{"leave_time": leaveTime, "leave_reason": reason}})
+	return err
+}
+
+func (a *AttendanceMgo) LeaveAll(ctx context.Context, meetingID string, leaveTime int64, reason string) error {
+	filter := bson.M{"meeting_id": meetingID, "leave_time": 0}
+	_, err := mongoutil.UpdateMany(ctx, a.coll, filter, bson.M{"$set": bson.M{"leave_time": leaveTime, "leave_reason": reason}})
+	return err
+}
+
+func (a *AttendanceMgo) Find(ctx context.Context, meetingID, occurrenceID string) ([]*model.MeetingAttendance, error) {
+	filter := bson.M{"meeting_id": meetingID}
+	if occurrenceID != "" {
+		filter["occurrence_id"] = occurrenceID
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "join_time", Value: 1}})
+	return mongoutil.Find[*model.MeetingAttendance](ctx, a.coll, filter, opts)
+}
+
+func (a *AttendanceMgo) FindByJoinTime(ctx context.Context, start, end int64) ([]*model.MeetingAttendance, error) {
+	filter := bson.M{"join_time": bson.M{"$gte": start, "$lt": end}}
+	opts := options.Find().SetSort(bson.D{{Key: "join_time", Value: 1}})
+	return mongoutil.Find[*model.MeetingAttendance](ctx, a.coll, filter, opts)
+}
+
+func NewHostChangeMongo(db *mongo.Database) (database.HostChange, error) {
+	coll := db.Collection("meeting_host_change")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "meeting_id", Value: 1},
+				{Key: "change_time", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "change_time", Value: 1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &HostChangeMgo{coll: coll}, nil
+}
+
+type HostChangeMgo struct {
+	coll *mongo.Collection
+}
+
+func (h *HostChangeMgo) Create(ctx context.Context, change *model.MeetingHostChange) error {
+	return mongoutil.InsertMany(ctx, h.coll, []*model.MeetingHostChange{change})
+}
+
+func (h *HostChangeMgo) Find(ctx context.Context, meetingID, occurrenceID string) ([]*model.MeetingHostChange, error) {
+	filter := bson.M{"meeting_id": meetingID}
+	if occurrenceID != "" {
+		filter["occurrence_id"] = occurrenceID
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "change_time", Value: 1}})
+	return mongoutil.Find[*model.MeetingHostChange](ctx, h.coll, filter, opts)
+}
+
+func (h *HostChangeMgo) FindByChangeTime(ctx context.Context, start, end int64) ([]*model.MeetingHostChange, error) {
+	filter := bson.M{"change_time": bson.M{"$gte": start, "$lt": end}}
+	opts := options.Find().SetSort(bson.D{{Key: "change_time", Value: 1}})
+	return mongoutil.Find[*model.MeetingHostChange](ctx, h.coll, filter, opts)
+}
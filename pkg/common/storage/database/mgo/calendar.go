@@ -0,0 +1,58 @@
+package mgo
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/database"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewCalendarFeedMongo(db *mongo.Database) (database.CalendarFeed, error) {
+	coll := db.Collection("calendar_feed")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "user_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "token", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &CalendarFeedMgo{coll: coll}, nil
+}
+
+type CalendarFeedMgo struct {
+	coll *mongo.Collection
+}
+
+func (c *CalendarFeedMgo) take(ctx context.Context, filter bson.M) (*model.CalendarFeed, error) {
+	feed, err := mongoutil.FindOne[*model.CalendarFeed](ctx, c.coll, filter)
+	if err != nil && errs.Unwrap(err) == mongo.ErrNoDocuments {
+		return nil, errs.ErrRecordNotFound.WrapMsg("calendar feed not found")
+	}
+	return feed, err
+}
+
+func (c *CalendarFeedMgo) Take(ctx context.Context, userID string) (*model.CalendarFeed, error) {
+	return c.take(ctx, bson.M{"user_id": userID})
+}
+
+func (c *CalendarFeedMgo) TakeByToken(ctx context.Context, token string) (*model.CalendarFeed, error) {
+	return c.take(ctx, bson.M{"token": token})
+}
+
+func (c *CalendarFeedMgo) Upsert(ctx context.Context, feed *model.CalendarFeed) error {
+	_, err := c.coll.ReplaceOne(ctx, bson.M{"user_id": feed.UserID}, feed, options.Replace().SetUpsert(true))
+	if err != nil {
+		return errs.WrapMsg(err, "upsert calendar feed failed")
+	}
+	return nil
+}
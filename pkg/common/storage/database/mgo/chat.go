@@ -0,0 +1,65 @@
+package mgo
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/constant"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/database"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewChatMongo(db *mongo.Database) (database.Chat, error) {
+	coll := db.Collection("meeting_chat")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "message_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "meeting_id", Value: 1},
+				{Key: "send_time", Value: -1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &ChatMgo{coll: coll}, nil
+}
+
+type ChatMgo struct {
+	coll *mongo.Collection
+}
+
+func (c *ChatMgo) Create(ctx context.Context, message *model.MeetingChatMessage) error {
+	return mongoutil.InsertMany(ctx, c.coll, []*model.MeetingChatMessage{message})
+}
+
+func (c *ChatMgo) Page(ctx context.Context, meetingID, occurrenceID, userID string, withHosts bool, pagination pagination.Pagination) (int64, []*model.MeetingChatMessage, error) {
+	visible := []bson.M{
+		{"recv_type": constant.ChatRecvPublic},
+		{"send_user_id": userID},
+		{"recv_type": constant.ChatRecvPrivate, "recv_user_id": userID},
+	}
+	if withHosts {
+		visible = append(visible, bson.M{"recv_type": constant.ChatRecvHosts})
+	}
+	filter := bson.M{"meeting_id": meetingID, "$or": visible}
+	if occurrenceID != "" {
+		filter["occurrence_id"] = occurrenceID
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "send_time", Value: -1}})
+	return mongoutil.FindPage[*model.MeetingChatMessage](ctx, c.coll, filter, pagination, opts)
+}
+
+func (c *ChatMgo) DeleteByMeetingID(ctx context.Context, meetingID string) error {
+	return mongoutil.DeleteMany(ctx, c.coll, bson.M{"meeting_id": meetingID})
+}
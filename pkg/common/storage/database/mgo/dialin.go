@@ -0,0 +1,62 @@
+package mgo
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/database"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewDialInMongo(db *mongo.Database) (database.DialIn, error) {
+	coll := db.Collection("meeting_dial_in")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "meeting_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "pin", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &DialInMgo{coll: coll}, nil
+}
+
+type DialInMgo struct {
+	coll *mongo.Collection
+}
+
+func (d *DialInMgo) Create(ctx context.Context, dialIn *model.MeetingDialIn) error {
+	err := mongoutil.InsertMany(ctx, d.coll, []*model.MeetingDialIn{dialIn})
+	if err != nil && mongo.IsDuplicateKeyError(errs.Unwrap(err)) {
+		return errs.ErrDuplicateKey.WrapMsg("meeting or pin is taken", "meetingID", dialIn.MeetingID)
+	}
+	return err
+}
+
+func (d *DialInMgo) Take(ctx context.Context, meetingID string) (*model.MeetingDialIn, error) {
+	dialIn, err := mongoutil.FindOne[*model.MeetingDialIn](ctx, d.coll, bson.M{"meeting_id": meetingID})
+	if err != nil && errs.Unwrap(err) == mongo.ErrNoDocuments {
+		return nil, errs.ErrRecordNotFound.WrapMsg("dial-in not found", "meetingID", meetingID)
+	}
+	return dialIn, err
+}
+
+func (d *DialInMgo) UpdateRuleID(ctx context.Context, meetingID, ruleID string) error {
+	return mongoutil.UpdateOne(ctx, d.coll, bson.M{"meeting_id": meetingID}, bson.M{"$set": bson.M{"rule_id": ruleID}}, false)
+}
+
+func (d *DialInMgo) Delete(ctx context.Context, meetingID string) error {
+	return mongoutil.DeleteOne(ctx, d.coll, bson.M{"meeting_id": meetingID})
+}
@@ -0,0 +1,88 @@
+package mgo
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/database"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewInvitationMongo(db *mongo.Database) (database.Invitation, error) {
+	coll := db.Collection("meeting_invitation")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "meeting_id", Value: 1},
+				{Key: "user_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &InvitationMgo{coll: coll}, nil
+}
+
+type InvitationMgo struct {
+	coll *mongo.Collection
+}
+
+func (i *InvitationMgo) CreateIfNotExist(ctx context.Context, invitations []*model.MeetingInvitation) error {
+	if len(invitations) == 0 {
+		return nil
+	}
+	models := make([]mongo.WriteModel, 0, len(invitations))
+	for _, one := range invitations {
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"meeting_id": one.MeetingID, "user_id": one.UserID}).
+			SetUpdate(bson.M{"$setOnInsert": one}).
+			SetUpsert(true))
+	}
+	if _, err := i.coll.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false)); err != nil {
+		return errs.WrapMsg(err, "upsert meeting invitations failed")
+	}
+	return nil
+}
+
+func (i *InvitationMgo) Delete(ctx context.Context, meetingID string, userIDs []string) error {
+	return mongoutil.DeleteMany(ctx, i.coll, bson.M{"meeting_id": meetingID, "user_id": bson.M{"$in": userIDs}})
+}
+
+func (i *InvitationMgo) UpdateResponse(ctx context.Context, meetingID, userID, response string, responseTime int64) error {
+	res, err := mongoutil.UpdateOneResult(ctx, i.coll, bson.M{"meeting_id": meetingID, "user_id": userID},
+		bson.M{"$set": bson.M{"response": response, "response_time": responseTime}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return errs.ErrRecordNotFound.WrapMsg("user is not invited", "meetingID", meetingID, "userID", userID)
+	}
+	return nil
+}
+
+func (i *InvitationMgo) FindByMeetingID(ctx context.Context, meetingID string) ([]*model.MeetingInvitation, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "invite_time", Value: 1}})
+	return mongoutil.Find[*model.MeetingInvitation](ctx, i.coll, bson.M{"meeting_id": meetingID}, opts)
+}
+
+func (i *InvitationMgo) FindByUserID(ctx context.Context, userID string, responses []string) ([]*model.MeetingInvitation, error) {
+	filter := bson.M{"user_id": userID}
+	if len(responses) > 0 {
+		filter["response"] = bson.M{"$in": responses}
+	}
+	return mongoutil.Find[*model.MeetingInvitation](ctx, i.coll, filter)
+}
+
+func (i *InvitationMgo) DeleteByMeetingID(ctx context.Context, meetingID string) error {
+	return mongoutil.DeleteMany(ctx, i.coll, bson.M{"meeting_id": meetingID})
+}
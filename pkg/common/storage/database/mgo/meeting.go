@@ -44,10 +44,39 @@ func (u *MeetingMgo) Update(ctx context.Context, meetingID string, updateData ma
 	return mongoutil.UpdateOne(ctx, u.coll, bson.M{"meeting_id": meetingID}, bson.M{"$set": updateData}, false)
 }
 
+func (u *MeetingMgo) FindByMeetingIDs(ctx context.Context, meetingIDs []string, status []string) ([]*model.MeetingInfo, error) {
+	filter := bson.M{
+		"meeting_id": bson.M{"$in": meetingIDs},
+	}
+	if len(status) > 0 {
+		filter["status"] = bson.M{"$in": status}
+	}
+	return mongoutil.Find[*model.MeetingInfo](ctx, u.coll, filter)
+}
+
 func (u *MeetingMgo) Delete(ctx context.Context, meetingID string) error {
 	return mongoutil.DeleteOne(ctx, u.coll, bson.M{"meeting_id": meetingID})
 }
 
+func (u *MeetingMgo) FindOverdue(ctx context.Context, status string, maxDuration int64, now int64) ([]*model.MeetingInfo, error) {
+	var duration any = "$max_duration"
+	if maxDuration > 0 {
+		duration = bson.M{"$cond": bson.A{
+			bson.M{"$gt": bson.A{"$max_duration", 0}},
+			bson.M{"$min": bson.A{"$max_duration", maxDuration}},
+			maxDuration,
+		}}
+	}
+	filter := bson.M{
+		"status": status,
+		"$expr": bson.M{"$and": bson.A{
+			bson.M{"$gt": bson.A{duration, 0}},
+			bson.M{"$lte": bson.A{bson.M{"$add": bson.A{"$start_time", duration}}, now}},
+		}},
+	}
+	return mongoutil.Find[*model.MeetingInfo](ctx, u.coll, filter)
+}
+
 func (u *MeetingMgo) FindByStatus(ctx context.Context, status []string, userID string) ([]*model.MeetingInfo, error) {
 	filter := bson.M{
 		"status": bson.M{"$in": status},
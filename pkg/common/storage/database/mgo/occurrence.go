@@ -0,0 +1,131 @@
+package mgo
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/constant"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/database"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewOccurrenceMongo(db *mongo.Database) (database.Occurrence, error) {
+	coll := db.Collection("meeting_occurrence")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "occurrence_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "meeting_id", Value: 1},
+				{Key: "planned_start_time", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "status", Value: 1},
+				{Key: "planned_start_time", Value: 1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &OccurrenceMgo{coll: coll}, nil
+}
+
+type OccurrenceMgo struct {
+	coll *mongo.Collection
+}
+
+func (o *OccurrenceMgo) CreateIfNotExist(ctx context.Context, occurrences []*model.MeetingOccurrence) error {
+	if len(occurrences) == 0 {
+		return nil
+	}
+	models := make([]mongo.WriteModel, 0, len(occurrences))
+	for _, one := range occurrences {
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"occurrence_id": one.OccurrenceID}).
+			SetUpdate(bson.M{"$setOnInsert": one}).
+			SetUpsert(true))
+	}
+	if _, err := o.coll.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false)); err != nil {
+		return errs.WrapMsg(err, "upsert meeting occurrences failed")
+	}
+	return nil
+}
+
+func (o *OccurrenceMgo) Take(ctx context.Context, occurrenceID string) (*model.MeetingOccurrence, error) {
+	occurrence, err := mongoutil.FindOne[*model.MeetingOccurrence](ctx, o.coll, bson.M{"occurrence_id": occurrenceID})
+	if err != nil && errs.Unwrap(err) == mongo.ErrNoDocuments {
+		return nil, errs.ErrRecordNotFound.WrapMsg("occurrence not found", "occurrenceID", occurrenceID)
+	}
+	return occurrence, err
+}
+
+func (o *OccurrenceMgo) TakeCurrent(ctx context.Context, meetingID string, now int64) (*model.MeetingOccurrence, error) {
+	filter := bson.M{
+		"meeting_id": meetingID,
+		"$or": []bson.M{
+			{"status": constant.InProgress},
+			{"status": constant.Scheduled, "planned_start_time": bson.M{"$lte": now}, "planned_end_time": bson.M{"$gt": now}},
+		},
+	}
+	opts := options.FindOne().SetSort(bson.D{{Key: "planned_start_time", Value: 1}})
+	return mongoutil.FindOne[*model.MeetingOccurrence](ctx, o.coll, filter, opts)
+}
+
+func (o *OccurrenceMgo) Update(ctx context.Context, occurrenceID string, updateData map[string]any) error {
+	if len(updateData) == 0 {
+		return nil
+	}
+	return mongoutil.UpdateOne(ctx, o.coll, bson.M{"occurrence_id": occurrenceID}, bson.M{"$set": updateData}, false)
+}
+
+func (o *OccurrenceMgo) AddParticipant(ctx context.Context, occurrenceID, userID string) error {
+	return mongoutil.UpdateOne(ctx, o.coll, bson.M{"occurrence_id": occurrenceID},
+		bson.M{"$addToSet": bson.M{"participant_user_ids": userID}}, false)
+}
+
+func (o *OccurrenceMgo) FindInWindow(ctx context.Context, meetingIDs []string, start, end int64, status []string) ([]*model.MeetingOccurrence, error) {
+	filter := bson.M{
+		"meeting_id":         bson.M{"$in": meetingIDs},
+		"planned_start_time": bson.M{"$lt": end},
+		"planned_end_time":   bson.M{"$gt": start},
+	}
+	if len(status) > 0 {
+		filter["status"] = bson.M{"$in": status}
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "planned_start_time", Value: 1}})
+	return mongoutil.Find[*model.MeetingOccurrence](ctx, o.coll, filter, opts)
+}
+
+func (o *OccurrenceMgo) FindByStatus(ctx context.Context, status []string) ([]*model.MeetingOccurrence, error) {
+	return mongoutil.Find[*model.MeetingOccurrence](ctx, o.coll, bson.M{"status": bson.M{"$in": status}})
+}
+
+func (o *OccurrenceMgo) FindToRemind(ctx context.Context, start, end int64) ([]*model.MeetingOccurrence, error) {
+	return mongoutil.Find[*model.MeetingOccurrence](ctx, o.coll, bson.M{
+		"status":             constant.Scheduled,
+		"reminded":           bson.M{"$ne": true},
+		"planned_start_time": bson.M{"$gt": start, "$lte": end},
+	})
+}
+
+func (o *OccurrenceMgo) DeleteNotStarted(ctx context.Context, meetingID string, after int64) error {
+	return mongoutil.DeleteMany(ctx, o.coll, bson.M{
+		"meeting_id":         meetingID,
+		"status":             bson.M{"$in": []string{constant.Scheduled, constant.Cancelled}},
+		"planned_start_time": bson.M{"$gt": after},
+	})
+}
+
+func (o *OccurrenceMgo) DeleteByMeetingID(ctx context.Context, meetingID string) error {
+	return mongoutil.DeleteMany(ctx, o.coll, bson.M{"meeting_id": meetingID})
+}
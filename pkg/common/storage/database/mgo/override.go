@@ -0,0 +1,56 @@
+package mgo
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/database"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewMeetingOverrideMongo(db *mongo.Database) (database.MeetingOverride, error) {
+	coll := db.Collection("meeting_override")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "meeting_id", Value: 1},
+			{Key: "original_start_time", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &MeetingOverrideMgo{coll: coll}, nil
+}
+
+type MeetingOverrideMgo struct {
+	coll *mongo.Collection
+}
+
+func (m *MeetingOverrideMgo) Upsert(ctx context.Context, override *model.MeetingOverride) error {
+	filter := bson.M{"meeting_id": override.MeetingID, "original_start_time": override.OriginalStartTime}
+	_, err := m.coll.ReplaceOne(ctx, filter, override, options.Replace().SetUpsert(true))
+	if err != nil {
+		return errs.WrapMsg(err, "upsert meeting override failed")
+	}
+	return nil
+}
+
+func (m *MeetingOverrideMgo) Take(ctx context.Context, meetingID string, originalStartTime int64) (*model.MeetingOverride, error) {
+	override, err := mongoutil.FindOne[*model.MeetingOverride](ctx, m.coll, bson.M{"meeting_id": meetingID, "original_start_time": originalStartTime})
+	if err != nil && errs.Unwrap(err) == mongo.ErrNoDocuments {
+		return nil, errs.ErrRecordNotFound.WrapMsg("override not found", "meetingID", meetingID, "originalStartTime", originalStartTime)
+	}
+	return override, err
+}
+
+func (m *MeetingOverrideMgo) Find(ctx context.Context, meetingIDs []string) ([]*model.MeetingOverride, error) {
+	return mongoutil.Find[*model.MeetingOverride](ctx, m.coll, bson.M{"meeting_id": bson.M{"$in": meetingIDs}})
+}
+
+func (m *MeetingOverrideMgo) DeleteByMeetingID(ctx context.Context, meetingID string) error {
+	return mongoutil.DeleteMany(ctx, m.coll, bson.M{"meeting_id": meetingID})
+}
@@ -0,0 +1,76 @@
+package mgo
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/constant"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/database"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewRecordingMongo(db *mongo.Database) (database.Recording, error) {
+	coll := db.Collection("recording")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "recording_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "meeting_id", Value: 1},
+				{Key: "start_time", Value: -1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &RecordingMgo{coll: coll}, nil
+}
+
+type RecordingMgo struct {
+	coll *mongo.Collection
+}
+
+func (r *RecordingMgo) Create(ctx context.Context, recording *model.MeetingRecording) error {
+	return mongoutil.InsertMany(ctx, r.coll, []*model.MeetingRecording{recording})
+}
+
+func (r *RecordingMgo) Take(ctx context.Context, recordingID string) (*model.MeetingRecording, error) {
+	recording, err := mongoutil.FindOne[*model.MeetingRecording](ctx, r.coll, bson.M{"recording_id": recordingID})
+	if err != nil && errs.Unwrap(err) == mongo.ErrNoDocuments {
+		return nil, errs.ErrRecordNotFound.WrapMsg("recording not found", "recordingID", recordingID)
+	}
+	return recording, err
+}
+
+func (r *RecordingMgo) Update(ctx context.Context, recordingID string, update map[string]any) error {
+	if len(update) == 0 {
+		return nil
+	}
+	return mongoutil.UpdateOne(ctx, r.coll, bson.M{"recording_id": recordingID}, bson.M{"$set": update}, false)
+}
+
+func (r *RecordingMgo) FindActive(ctx context.Context, meetingID string) ([]*model.MeetingRecording, error) {
+	filter := bson.M{
+		"meeting_id": meetingID,
+		"status":     bson.M{"$in": []string{constant.RecordingStatusStarting, constant.RecordingStatusActive, constant.RecordingStatusEnding}},
+	}
+	return mongoutil.Find[*model.MeetingRecording](ctx, r.coll, filter)
+}
+
+func (r *RecordingMgo) Page(ctx context.Context, meetingID, occurrenceID string, pagination pagination.Pagination) (int64, []*model.MeetingRecording, error) {
+	filter := bson.M{"meeting_id": meetingID}
+	if occurrenceID != "" {
+		filter["occurrence_id"] = occurrenceID
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "start_time", Value: -1}})
+	return mongoutil.FindPage[*model.MeetingRecording](ctx, r.coll, filter, pagination, opts)
+}
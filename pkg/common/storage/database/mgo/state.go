@@ -0,0 +1,138 @@
+package mgo
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/database"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewMeetingStateMongo(db *mongo.Database) (database.MeetingState, error) {
+	coll := db.Collection("meeting_state")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "meeting_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	participantColl := db.Collection("meeting_participant_state")
+	_, err = participantColl.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "meeting_id", Value: 1},
+			{Key: "user_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &MeetingStateMgo{coll: coll, participantColl: participantColl}, nil
+}
+
+type MeetingStateMgo struct {
+	coll            *mongo.Collection
+	participantColl *mongo.Collection
+}
+
+func (m *MeetingStateMgo) Take(ctx context.Context, meetingID string) (*model.MeetingState, error) {
+	state, err := mongoutil.FindOne[*model.MeetingState](ctx, m.coll, bson.M{"meeting_id": meetingID})
+	if err != nil && errs.Unwrap(err) == mongo.ErrNoDocuments {
+		return nil, errs.ErrRecordNotFound.WrapMsg("meeting state not found", "meetingID", meetingID)
+	}
+	return state, err
+}
+
+func (m *MeetingStateMgo) SetHost(ctx context.Context, meetingID, hostUserID string) error {
+	update := bson.M{
+		"$set":  bson.M{"host_user_id": hostUserID},
+		"$pull": bson.M{"co_host_user_ids": hostUserID},
+	}
+	return m.upsert(ctx, meetingID, update)
+}
+
+// UpdateCoHosts $addToSet and $pull could not update the same field at once, so the co-hosts are computed by the pipeline,
+// the user both added and removed is removed
+func (m *MeetingStateMgo) UpdateCoHosts(ctx context.Context, meetingID string, addUserIDs, removeUserIDs []string) error {
+	if len(addUserIDs) == 0 && len(removeUserIDs) == 0 {
+		return nil
+	}
+	// the nil slices are encoded as null, which $in does not accept, and $literal keeps the ids from being taken as the field paths
+	add := bson.M{"$literal": append([]string{}, addUserIDs...)}
+	remove := bson.M{"$literal": append([]string{}, removeUserIDs...)}
+	coHosts := bson.M{"$ifNull": bson.A{"$co_host_user_ids", bson.A{}}}
+	kept := bson.M{"$filter": bson.M{
+		"input": coHosts,
+		"cond":  bson.M{"$not": bson.A{bson.M{"$in": bson.A{"$$this", remove}}}},
+	}}
+	added := bson.M{"$filter": bson.M{
+		"input": add,
+		"cond": bson.M{"$not": bson.A{bson.M{"$or": bson.A{
+			bson.M{"$in": bson.A{"$$this", coHosts}},
+			bson.M{"$in": bson.A{"$$this", remove}},
+		}}}},
+	}}
+	update := bson.A{bson.M{"$set": bson.M{
+		"co_host_user_ids": bson.M{"$concatArrays": bson.A{kept, added}},
+		"co_hosts_saved":   true,
+	}}}
+	return m.upsert(ctx, meetingID, update)
+}
+
+// UpdateRoles the roles are kept in an array as the user ids could not be the field names safely, so they are replaced by the pipeline
+func (m *MeetingStateMgo) UpdateRoles(ctx context.Context, meetingID string, userIDs []string, role string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	added := make([]*model.MeetingUserRole, 0, len(userIDs))
+	if role != "" {
+		for _, userID := range userIDs {
+			added = append(added, &model.MeetingUserRole{UserID: userID, Role: role})
+		}
+	}
+	kept := bson.M{"$filter": bson.M{
+		"input": bson.M{"$ifNull": bson.A{"$roles", bson.A{}}},
+		"cond":  bson.M{"$not": bson.A{bson.M{"$in": bson.A{"$$this.user_id", bson.M{"$literal": userIDs}}}}},
+	}}
+	update := bson.A{bson.M{"$set": bson.M{"roles": bson.M{"$concatArrays": bson.A{kept, bson.M{"$literal": added}}}}}}
+	return m.upsert(ctx, meetingID, update)
+}
+
+// upsert the state is created by the first update of the meeting
+func (m *MeetingStateMgo) upsert(ctx context.Context, meetingID string, update any) error {
+	return mongoutil.UpdateOne(ctx, m.coll, bson.M{"meeting_id": meetingID}, update, false, options.Update().SetUpsert(true))
+}
+
+func (m *MeetingStateMgo) FindParticipants(ctx context.Context, meetingID string) ([]*model.MeetingParticipantState, error) {
+	return mongoutil.Find[*model.MeetingParticipantState](ctx, m.participantColl, bson.M{"meeting_id": meetingID})
+}
+
+func (m *MeetingStateMgo) CreateParticipant(ctx context.Context, participant *model.MeetingParticipantState) error {
+	filter := bson.M{"meeting_id": participant.MeetingID, "user_id": participant.UserID}
+	err := mongoutil.UpdateOne(ctx, m.participantColl, filter, bson.M{"$setOnInsert": participant}, false, options.Update().SetUpsert(true))
+	if err != nil && mongo.IsDuplicateKeyError(errs.Unwrap(err)) {
+		// created by others at the same time
+		return nil
+	}
+	return err
+}
+
+func (m *MeetingStateMgo) UpdateParticipant(ctx context.Context, meetingID, userID string, updateData map[string]any) error {
+	if len(updateData) == 0 {
+		return nil
+	}
+	return mongoutil.UpdateOne(ctx, m.participantColl, bson.M{"meeting_id": meetingID, "user_id": userID}, bson.M{"$set": updateData}, true)
+}
+
+func (m *MeetingStateMgo) Delete(ctx context.Context, meetingID string) error {
+	if err := mongoutil.DeleteMany(ctx, m.participantColl, bson.M{"meeting_id": meetingID}); err != nil {
+		return err
+	}
+	return mongoutil.DeleteOne(ctx, m.coll, bson.M{"meeting_id": meetingID})
+}
@@ -0,0 +1,138 @@
+package mgo
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/database"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewWebhookSubscriptionMongo(db *mongo.Database) (database.WebhookSubscription, error) {
+	coll := db.Collection("webhook_subscription")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "subscription_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &WebhookSubscriptionMgo{coll: coll}, nil
+}
+
+type WebhookSubscriptionMgo struct {
+	coll *mongo.Collection
+}
+
+func (w *WebhookSubscriptionMgo) Create(ctx context.Context, subscription *model.WebhookSubscription) error {
+	return mongoutil.InsertMany(ctx, w.coll, []*model.WebhookSubscription{subscription})
+}
+
+func (w *WebhookSubscriptionMgo) Take(ctx context.Context, subscriptionID string) (*model.WebhookSubscription, error) {
+	subscription, err := mongoutil.FindOne[*model.WebhookSubscription](ctx, w.coll, bson.M{"subscription_id": subscriptionID})
+	if err != nil {
+		if errs.Unwrap(err) == mongo.ErrNoDocuments {
+			return nil, errs.ErrRecordNotFound.WrapMsg("webhook subscription not found", "subscriptionID", subscriptionID)
+		}
+		return nil, err
+	}
+	return subscription, nil
+}
+
+func (w *WebhookSubscriptionMgo) Update(ctx context.Context, subscriptionID string, updateData map[string]any) error {
+	if len(updateData) == 0 {
+		return nil
+	}
+	result, err := mongoutil.UpdateOneResult(ctx, w.coll, bson.M{"subscription_id": subscriptionID}, bson.M{"$set": updateData})
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return errs.ErrRecordNotFound.WrapMsg("webhook subscription not found", "subscriptionID", subscriptionID)
+	}
+	return nil
+}
+
+func (w *WebhookSubscriptionMgo) Delete(ctx context.Context, subscriptionID string) error {
+	return mongoutil.DeleteOne(ctx, w.coll, bson.M{"subscription_id": subscriptionID})
+}
+
+func (w *WebhookSubscriptionMgo) Find(ctx context.Context) ([]*model.WebhookSubscription, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "create_time", Value: 1}})
+	return mongoutil.Find[*model.WebhookSubscription](ctx, w.coll, bson.M{}, opts)
+}
+
+func (w *WebhookSubscriptionMgo) FindEnabledByEvent(ctx context.Context, eventType string) ([]*model.WebhookSubscription, error) {
+	return mongoutil.Find[*model.WebhookSubscription](ctx, w.coll, bson.M{
+		"enable": true,
+		"$or": []bson.M{
+			{"events": eventType},
+			{"events": bson.M{"$size": 0}},
+			{"events": nil},
+		},
+	})
+}
+
+func NewWebhookDeadLetterMongo(db *mongo.Database) (database.WebhookDeadLetter, error) {
+	coll := db.Collection("webhook_dead_letter")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "delivery_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "subscription_id", Value: 1},
+				{Key: "create_time", Value: -1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &WebhookDeadLetterMgo{coll: coll}, nil
+}
+
+type WebhookDeadLetterMgo struct {
+	coll *mongo.Collection
+}
+
+func (w *WebhookDeadLetterMgo) Create(ctx context.Context, letter *model.WebhookDeadLetter) error {
+	// the same delivery may fail again after a manual redelivery, keep the latest failure
+	return mongoutil.UpdateOne(ctx, w.coll, bson.M{"delivery_id": letter.DeliveryID}, bson.M{"$set": letter}, false, options.Update().SetUpsert(true))
+}
+
+func (w *WebhookDeadLetterMgo) Take(ctx context.Context, deliveryID string) (*model.WebhookDeadLetter, error) {
+	letter, err := mongoutil.FindOne[*model.WebhookDeadLetter](ctx, w.coll, bson.M{"delivery_id": deliveryID})
+	if err != nil {
+		if errs.Unwrap(err) == mongo.ErrNoDocuments {
+			return nil, errs.ErrRecordNotFound.WrapMsg("webhook dead letter not found", "deliveryID", deliveryID)
+		}
+		return nil, err
+	}
+	return letter, nil
+}
+
+func (w *WebhookDeadLetterMgo) Page(ctx context.Context, subscriptionID string, pagination pagination.Pagination) (int64, []*model.WebhookDeadLetter, error) {
+	filter := bson.M{}
+	if subscriptionID != "" {
+		filter["subscription_id"] = subscriptionID
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "create_time", Value: -1}})
+	return mongoutil.FindPage[*model.WebhookDeadLetter](ctx, w.coll, filter, pagination, opts)
+}
+
+func (w *WebhookDeadLetterMgo) Delete(ctx context.Context, deliveryIDs []string) error {
+	if len(deliveryIDs) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, w.coll, bson.M{"delivery_id": bson.M{"$in": deliveryIDs}})
+}
@@ -0,0 +1,25 @@
+package database
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+)
+
+type Occurrence interface {
+	// CreateIfNotExist insert the occurrences whose occurrence id is not stored yet
+	CreateIfNotExist(ctx context.Context, occurrences []*model.MeetingOccurrence) error
+	// Take get the occurrence, errs.ErrRecordNotFound is returned if it is not generated
+	Take(ctx context.Context, occurrenceID string) (*model.MeetingOccurrence, error)
+	// TakeCurrent get the in-progress occurrence or the scheduled one planned in [start, end) of now
+	TakeCurrent(ctx context.Context, meetingID string, now int64) (*model.MeetingOccurrence, error)
+	Update(ctx context.Context, occurrenceID string, updateData map[string]any) error
+	AddParticipant(ctx context.Context, occurrenceID, userID string) error
+	// FindInWindow find the occurrences planned to overlap [start, end)
+	FindInWindow(ctx context.Context, meetingIDs []string, start, end int64, status []string) ([]*model.MeetingOccurrence, error)
+	FindByStatus(ctx context.Context, status []string) ([]*model.MeetingOccurrence, error)
+	// FindToRemind get the scheduled occurrences planned to start in (start, end] whose reminder is not sent
+	FindToRemind(ctx context.Context, start, end int64) ([]*model.MeetingOccurrence, error)
+	// DeleteNotStarted delete the scheduled or cancelled occurrences of the meeting which have not started after the given time
+	DeleteNotStarted(ctx context.Context, meetingID string, after int64) error
+	DeleteByMeetingID(ctx context.Context, meetingID string) error
+}
@@ -0,0 +1,15 @@
+package database
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+)
+
+type MeetingOverride interface {
+	// Upsert create or replace the override of the occurrence
+	Upsert(ctx context.Context, override *model.MeetingOverride) error
+	// Take get the override of the occurrence, errs.ErrRecordNotFound is returned if it is not overridden
+	Take(ctx context.Context, meetingID string, originalStartTime int64) (*model.MeetingOverride, error)
+	Find(ctx context.Context, meetingIDs []string) ([]*model.MeetingOverride, error)
+	DeleteByMeetingID(ctx context.Context, meetingID string) error
+}
@@ -0,0 +1,18 @@
+package database
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+type Recording interface {
+	Create(ctx context.Context, recording *model.MeetingRecording) error
+	// Take get the recording, errs.ErrRecordNotFound is returned if it is not recorded by the meeting
+	Take(ctx context.Context, recordingID string) (*model.MeetingRecording, error)
+	Update(ctx context.Context, recordingID string, update map[string]any) error
+	// FindActive get the recordings of the meeting not ended yet
+	FindActive(ctx context.Context, meetingID string) ([]*model.MeetingRecording, error)
+	// Page get the recordings of the meeting ordered by start time descending, only of the occurrence if occurrenceID is not empty
+	Page(ctx context.Context, meetingID, occurrenceID string, pagination pagination.Pagination) (int64, []*model.MeetingRecording, error)
+}
@@ -0,0 +1,22 @@
+package database
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+)
+
+type MeetingState interface {
+	// Take get the state of the meeting, errs.ErrRecordNotFound is returned if the state is not saved
+	Take(ctx context.Context, meetingID string) (*model.MeetingState, error)
+	// SetHost the host is not one of the co-hosts any more
+	SetHost(ctx context.Context, meetingID, hostUserID string) error
+	// UpdateCoHosts add and remove the co-hosts at once, the order of the co-hosts is kept
+	UpdateCoHosts(ctx context.Context, meetingID string, addUserIDs, removeUserIDs []string) error
+	// UpdateRoles set the role of the users, the users are removed from the roles if role is empty
+	UpdateRoles(ctx context.Context, meetingID string, userIDs []string, role string) error
+	FindParticipants(ctx context.Context, meetingID string) ([]*model.MeetingParticipantState, error)
+	// CreateParticipant the saved state of the participant is kept
+	CreateParticipant(ctx context.Context, participant *model.MeetingParticipantState) error
+	UpdateParticipant(ctx context.Context, meetingID, userID string, updateData map[string]any) error
+	Delete(ctx context.Context, meetingID string) error
+}
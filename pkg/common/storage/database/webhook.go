@@ -0,0 +1,28 @@
+package database
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+type WebhookSubscription interface {
+	Create(ctx context.Context, subscription *model.WebhookSubscription) error
+	// Take get the subscription, errs.ErrRecordNotFound is returned if it does not exist
+	Take(ctx context.Context, subscriptionID string) (*model.WebhookSubscription, error)
+	// Update errs.ErrRecordNotFound is returned if the subscription does not exist
+	Update(ctx context.Context, subscriptionID string, updateData map[string]any) error
+	Delete(ctx context.Context, subscriptionID string) error
+	Find(ctx context.Context) ([]*model.WebhookSubscription, error)
+	// FindEnabledByEvent get the enabled subscriptions of the event type, including the ones subscribing all the events
+	FindEnabledByEvent(ctx context.Context, eventType string) ([]*model.WebhookSubscription, error)
+}
+
+type WebhookDeadLetter interface {
+	Create(ctx context.Context, letter *model.WebhookDeadLetter) error
+	// Take get the dead letter, errs.ErrRecordNotFound is returned if it does not exist
+	Take(ctx context.Context, deliveryID string) (*model.WebhookDeadLetter, error)
+	// Page get the dead letters of the subscription, or of all the subscriptions if subscriptionID is empty
+	Page(ctx context.Context, subscriptionID string, pagination pagination.Pagination) (int64, []*model.WebhookDeadLetter, error)
+	Delete(ctx context.Context, deliveryIDs []string) error
+}
@@ -0,0 +1,21 @@
+package model
+
+// MeetingAttendance represents one stay of a user in the meeting room, from joining to leaving.
+type MeetingAttendance struct {
+	MeetingID    string `bson:"meeting_id"`
+	OccurrenceID string `bson:"occurrence_id"` // empty if the occurrence is not found when joining
+	UserID       string `bson:"user_id"`
+	JoinTime     int64  `bson:"join_time"`
+	LeaveTime    int64  `bson:"leave_time"` // 0 if the user is still in the room
+	LeaveReason  string `bson:"leave_reason"`
+}
+
+// MeetingHostChange represents the host of the meeting room handed over from one user to another.
+type MeetingHostChange struct {
+	MeetingID          string `bson:"meeting_id"`
+	OccurrenceID       string `bson:"occurrence_id"`
+	PreviousHostUserID string `bson:"previous_host_user_id"`
+	HostUserID         string `bson:"host_user_id"`
+	OperatorUserID     string `bson:"operator_user_id"` // empty if the host is handed over by the server, e.g., the host left
+	ChangeTime         int64  `bson:"change_time"`
+}
@@ -0,0 +1,8 @@
+package model
+
+// CalendarFeed is the long-lived token of the user to subscribe the calendar feed of the meetings.
+type CalendarFeed struct {
+	UserID     string `bson:"user_id"`
+	Token      string `bson:"token"`
+	CreateTime int64  `bson:"create_time"`
+}
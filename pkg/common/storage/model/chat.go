@@ -0,0 +1,14 @@
+package model
+
+// MeetingChatMessage represents a chat message sent in the meeting room.
+type MeetingChatMessage struct {
+	MessageID    string `bson:"message_id"`
+	MeetingID    string `bson:"meeting_id"`
+	OccurrenceID string `bson:"occurrence_id"` // empty if the occurrence is not found when sending
+	SendUserID   string `bson:"send_user_id"`
+	SendNickname string `bson:"send_nickname"` // the nickname in the meeting when sending
+	RecvType     string `bson:"recv_type"`
+	RecvUserID   string `bson:"recv_user_id"` // only for the private message
+	Content      string `bson:"content"`
+	SendTime     int64  `bson:"send_time"` // milliseconds
+}
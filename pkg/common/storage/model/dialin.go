@@ -0,0 +1,9 @@
+package model
+
+// MeetingDialIn represents the PIN the phone participants enter to join the room of the meeting.
+type MeetingDialIn struct {
+	MeetingID  string `bson:"meeting_id"`
+	PIN        string `bson:"pin"`     // unique among the meetings
+	RuleID     string `bson:"rule_id"` // the dispatch rule of the rtc server, empty if the room is not open
+	CreateTime int64  `bson:"create_time"`
+}
@@ -0,0 +1,11 @@
+package model
+
+// MeetingInvitation records one user invited to a meeting and the RSVP of the user.
+type MeetingInvitation struct {
+	MeetingID     string `bson:"meeting_id"`
+	UserID        string `bson:"user_id"`
+	InviterUserID string `bson:"inviter_user_id"`
+	Response      string `bson:"response"`
+	InviteTime    int64  `bson:"invite_time"`
+	ResponseTime  int64  `bson:"response_time"`
+}
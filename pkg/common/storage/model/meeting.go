@@ -17,5 +17,11 @@ type MeetingInfo struct {
 	UintType        string  `bson:"uint_type"`          // only used when repeat_type is custom
 	Interval        int32   `bson:"interval"`           // only used when repeat_type is custom
 	RepeatDayOfWeek []int32 `bson:"repeat_day_of_week"` // only used when repeat_type is custom
+	RRule           string  `bson:"rrule"`              // only used when repeat_type is rrule, RFC 5545 RRULE without DTSTART
+	ExDates         []int64 `bson:"ex_dates"`           // start timestamps of the occurrences excluded from the repetition
 	Setting         string  `bson:"setting"`
+	WaitingRoom     bool    `bson:"waiting_room"`     // joiners other than the hosts wait for the admission of the hosts
+	MaxParticipants int32   `bson:"max_participants"` // 0 means the server-wide limit, so do the other limits
+	EmptyTimeout    int32   `bson:"empty_timeout"`
+	MaxDuration     int64   `bson:"max_duration"`
 }
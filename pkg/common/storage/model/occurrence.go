@@ -0,0 +1,15 @@
+package model
+
+// MeetingOccurrence represents one session of a meeting, recurring meetings get one per repetition.
+type MeetingOccurrence struct {
+	OccurrenceID       string   `bson:"occurrence_id"`
+	MeetingID          string   `bson:"meeting_id"`
+	OriginalStartTime  int64    `bson:"original_start_time"` // the start time generated by the repetition
+	PlannedStartTime   int64    `bson:"planned_start_time"`
+	PlannedEndTime     int64    `bson:"planned_end_time"`
+	ActualStartTime    int64    `bson:"actual_start_time"`
+	ActualEndTime      int64    `bson:"actual_end_time"`
+	Status             string   `bson:"status"`
+	ParticipantUserIDs []string `bson:"participant_user_ids"`
+	Reminded           bool     `bson:"reminded"` // whether the starting reminder is sent
+}
@@ -0,0 +1,13 @@
+package model
+
+// MeetingOverride changes one occurrence of a recurring meeting, the fields not overridden keep the series value.
+type MeetingOverride struct {
+	MeetingID         string  `bson:"meeting_id"`
+	OriginalStartTime int64   `bson:"original_start_time"` // the start time generated by the repetition
+	Cancelled         bool    `bson:"cancelled"`
+	ScheduledTime     int64   `bson:"scheduled_time"`   // 0 means not rescheduled
+	MeetingDuration   int64   `bson:"meeting_duration"` // 0 means the duration of the series
+	Title             *string `bson:"title,omitempty"`
+	Password          *string `bson:"password,omitempty"`
+	UpdateTime        int64   `bson:"update_time"`
+}
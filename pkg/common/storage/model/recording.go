@@ -0,0 +1,23 @@
+package model
+
+// MeetingRecording represents a cloud recording of the meeting room.
+type MeetingRecording struct {
+	RecordingID  string                  `bson:"recording_id"` // id of the recording on the rtc server
+	MeetingID    string                  `bson:"meeting_id"`
+	OccurrenceID string                  `bson:"occurrence_id"` // empty if the occurrence is not found when starting
+	StartUserID  string                  `bson:"start_user_id"`
+	StopUserID   string                  `bson:"stop_user_id"` // empty if the recording is not stopped by a user, e.g., the meeting ended
+	Status       string                  `bson:"status"`
+	StartTime    int64                   `bson:"start_time"`
+	EndTime      int64                   `bson:"end_time"` // 0 if the recording is in progress
+	Error        string                  `bson:"error"`
+	Files        []*MeetingRecordingFile `bson:"files"`
+}
+
+// MeetingRecordingFile represents a file of the recording on the storage of the rtc server.
+type MeetingRecordingFile struct {
+	Filename string `bson:"filename"`
+	Location string `bson:"location"`
+	Size     int64  `bson:"size"`     // bytes
+	Duration int64  `bson:"duration"` // seconds
+}
@@ -0,0 +1,27 @@
+package model
+
+// MeetingState represents the runtime state of the meeting in progress, the room metadata is projected from it,
+// so it survives the room being closed and created again, removed when the meeting is not in progress.
+type MeetingState struct {
+	MeetingID     string             `bson:"meeting_id"`
+	HostUserID    string             `bson:"host_user_id"`
+	CoHostUserIDs []string           `bson:"co_host_user_ids"`
+	CoHostsSaved  bool               `bson:"co_hosts_saved"` // false if the state is written by SetHost only, which does not know the co-hosts
+	Roles         []*MeetingUserRole `bson:"roles"`          // the presenters and the viewers
+}
+
+// MeetingUserRole represents the role of a participant which is not kept in the meeting info, e.g., Presenter.
+type MeetingUserRole struct {
+	UserID string `bson:"user_id"`
+	Role   string `bson:"role"`
+}
+
+// MeetingParticipantState represents the personal data of a participant in the meeting in progress.
+type MeetingParticipantState struct {
+	MeetingID              string `bson:"meeting_id"`
+	UserID                 string `bson:"user_id"`
+	CameraOnEntry          bool   `bson:"camera_on_entry"`     // the personal setting of the participant
+	MicrophoneOnEntry      bool   `bson:"microphone_on_entry"` // the personal setting of the participant
+	LimitCameraOnEntry     bool   `bson:"limit_camera_on_entry"`
+	LimitMicrophoneOnEntry bool   `bson:"limit_microphone_on_entry"`
+}
@@ -0,0 +1,12 @@
+package model
+
+// WaitingUser represents a user asking to join a meeting with the waiting room on, kept in redis until the meeting ends.
+type WaitingUser struct {
+	MeetingID      string `bson:"meeting_id"`
+	UserID         string `bson:"user_id"`
+	Nickname       string `bson:"nickname"`
+	Status         string `bson:"status"` // Waiting, Admitted or Denied
+	RequestTime    int64  `bson:"request_time"`
+	ResponseTime   int64  `bson:"response_time"` // 0 if the host has not responded yet
+	OperatorUserID string `bson:"operator_user_id"`
+}
@@ -0,0 +1,24 @@
+package model
+
+// WebhookSubscription is an endpoint receiving the meeting and participant events.
+type WebhookSubscription struct {
+	SubscriptionID string   `bson:"subscription_id"`
+	URL            string   `bson:"url"`
+	Secret         string   `bson:"secret"` // key of the HMAC-SHA256 signature of the payload
+	Events         []string `bson:"events"` // subscribed event types, all the events if empty
+	Enable         bool     `bson:"enable"`
+	CreateTime     int64    `bson:"create_time"`
+	UpdateTime     int64    `bson:"update_time"`
+}
+
+// WebhookDeadLetter records a delivery which still failed after all the retries.
+type WebhookDeadLetter struct {
+	DeliveryID     string `bson:"delivery_id"`
+	SubscriptionID string `bson:"subscription_id"`
+	URL            string `bson:"url"`
+	EventType      string `bson:"event_type"`
+	Payload        string `bson:"payload"`
+	Attempts       int    `bson:"attempts"`
+	LastError      string `bson:"last_error"`
+	CreateTime     int64  `bson:"create_time"`
+}
@@ -12,3 +12,38 @@ type User struct {
 func (User) SheetName() string {
 	return "user"
 }
+
+// Attendance is one stay of a user in a meeting, the times are formatted in the time zone of the export.
+type Attendance struct {
+	MeetingID    string `json:"meetingID" column:"meeting_id"`
+	Title        string `json:"title" column:"title"`
+	OccurrenceID string `json:"occurrenceID" column:"occurrence_id"`
+	UserID       string `json:"userID" column:"user_id"`
+	Account      string `json:"account" column:"account"`
+	Nickname     string `json:"nickname" column:"nickname"`
+	JoinTime     string `json:"joinTime" column:"join_time"`
+	LeaveTime    string `json:"leaveTime" column:"leave_time"`
+	Duration     int64  `json:"duration" column:"duration_seconds"`
+	LeaveReason  string `json:"leaveReason" column:"leave_reason"`
+}
+
+func (Attendance) SheetName() string {
+	return "attendance"
+}
+
+// HostChange is the host of a meeting handed over from one user to another.
+type HostChange struct {
+	MeetingID          string `json:"meetingID" column:"meeting_id"`
+	Title              string `json:"title" column:"title"`
+	OccurrenceID       string `json:"occurrenceID" column:"occurrence_id"`
+	ChangeTime         string `json:"changeTime" column:"change_time"`
+	PreviousHostUserID string `json:"previousHostUserID" column:"previous_host_user_id"`
+	PreviousHost       string `json:"previousHost" column:"previous_host_nickname"`
+	HostUserID         string `json:"hostUserID" column:"host_user_id"`
+	Host               string `json:"host" column:"host_nickname"`
+	OperatorUserID     string `json:"operatorUserID" column:"operator_user_id"`
+}
+
+func (HostChange) SheetName() string {
+	return "host_change"
+}
@@ -0,0 +1,140 @@
+package xlsx
+
+import (
+	"encoding/csv"
+	"fmt"
+	"github.com/openimsdk/tools/errs"
+	"github.com/xuri/excelize/v2"
+	"io"
+	"reflect"
+	"strings"
+)
+
+const (
+	defaultSheet = "Sheet1"
+	// formulaPrefixes are the leading characters starting a formula, tab and carriage return included
+	formulaPrefixes = "=+-@\t\r"
+)
+
+// Export write each slice of structs into a sheet named by GetSheetName, the header is the column tags
+func Export(sheets ...any) (*excelize.File, error) {
+	f := excelize.NewFile()
+	keepDefault := false
+	for _, rows := range sheets {
+		name := GetSheetName(rows)
+		if name == "" {
+			return nil, errs.New("rows must be a slice of struct", "type", reflect.TypeOf(rows).String())
+		}
+		if name == defaultSheet {
+			keepDefault = true
+		} else if _, err := f.NewSheet(name); err != nil {
+			return nil, errs.WrapMsg(err, "create sheet failed", "sheet", name)
+		}
+		if err := writeSheet(f, name, rows); err != nil {
+			return nil, err
+		}
+	}
+	if !keepDefault && len(sheets) > 0 {
+		if err := f.DeleteSheet(defaultSheet); err != nil {
+			return nil, errs.WrapMsg(err, "delete default sheet failed")
+		}
+	}
+	return f, nil
+}
+
+func writeSheet(f *excelize.File, sheet string, rows any) error {
+	headers, values, err := getRows(rows)
+	if err != nil {
+		return err
+	}
+	if err := f.SetSheetRow(sheet, GetAxis(1, 1), &headers); err != nil {
+		return errs.WrapMsg(err, "write header failed", "sheet", sheet)
+	}
+	for i := range values {
+		if err := f.SetSheetRow(sheet, GetAxis(1, i+2), &values[i]); err != nil {
+			return errs.WrapMsg(err, "write row failed", "sheet", sheet, "row", i+2)
+		}
+	}
+	return nil
+}
+
+// ExportCSV write the slice of structs as csv, the header is the column tags
+func ExportCSV(w io.Writer, rows any) error {
+	headers, values, err := getRows(rows)
+	if err != nil {
+		return err
+	}
+	cw := csv.NewWriter(w)
+	if err := cw.Write(headers); err != nil {
+		return errs.WrapMsg(err, "write csv header failed")
+	}
+	record := make([]string, len(headers))
+	for _, row := range values {
+		for i, v := range row {
+			record[i] = fmt.Sprint(v)
+		}
+		if err := cw.Write(record); err != nil {
+			return errs.WrapMsg(err, "write csv row failed")
+		}
+	}
+	cw.Flush()
+	return errs.Wrap(cw.Error())
+}
+
+// escapeFormula prefixes the text which spreadsheet applications would evaluate as a formula with a single quote,
+// so the user supplied text, e.g., a nickname "=HYPERLINK(...)", stays text when the export is opened
+func escapeFormula(text string) string {
+	if text != "" && strings.ContainsRune(formulaPrefixes, rune(text[0])) {
+		return "'" + text
+	}
+	return text
+}
+
+// getRows get the column tags and the values of the tagged fields, nil elements are skipped
+func getRows(rows any) ([]string, [][]any, error) {
+	rv := reflect.ValueOf(rows)
+	if rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Slice {
+		return nil, nil, errs.New("rows must be a slice of struct", "type", rv.Type().String())
+	}
+	elem := rv.Type().Elem()
+	if elem.Kind() == reflect.Ptr {
+		elem = elem.Elem()
+	}
+	if elem.Kind() != reflect.Struct {
+		return nil, nil, errs.New("rows must be a slice of struct", "type", rv.Type().String())
+	}
+	var (
+		headers []string
+		fields  []int
+	)
+	for i := 0; i < elem.NumField(); i++ {
+		tag := elem.Field(i).Tag.Get("column")
+		if tag == "" || tag == "-" {
+			continue
+		}
+		headers = append(headers, tag)
+		fields = append(fields, i)
+	}
+	values := make([][]any, 0, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		row := rv.Index(i)
+		if row.Kind() == reflect.Ptr {
+			if row.IsNil() {
+				continue
+			}
+			row = row.Elem()
+		}
+		value := make([]any, len(fields))
+		for j, field := range fields {
+			value[j] = row.Field(field).Interface()
+			if text, ok := value[j].(string); ok {
+				value[j] = escapeFormula(text)
+			}
+		}
+		values = append(values, value)
+	}
+	return headers, values, nil
+}
@@ -0,0 +1,38 @@
+package xlsx
+
+import (
+	"bytes"
+	"testing"
+)
+
+type exportRow struct {
+	Name  string `column:"name"`
+	Count int64  `column:"count"`
+	Skip  string
+}
+
+func TestExportCSVEscapesFormula(t *testing.T) {
+	rows := []*exportRow{
+		{Name: "=HYPERLINK(\"http://example.com\")", Count: -1},
+		{Name: "+1", Count: 2},
+		{Name: "@SUM(A1)"},
+		nil,
+		{Name: "-2"},
+		{Name: "\tcmd"},
+		{Name: "a=b"},
+	}
+	buf := &bytes.Buffer{}
+	if err := ExportCSV(buf, rows); err != nil {
+		t.Fatalf("ExportCSV() error = %v", err)
+	}
+	want := "name,count\n" +
+		"\"'=HYPERLINK(\"\"http://example.com\"\")\",-1\n" +
+		"'+1,2\n" +
+		"'@SUM(A1),0\n" +
+		"'-2,0\n" +
+		"'\tcmd,0\n" +
+		"a=b,0\n"
+	if got := buf.String(); got != want {
+		t.Errorf("ExportCSV() =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,158 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"strings"
+	"time"
+)
+
+const (
+	EventMeetingBooked    = "meeting.booked"
+	EventMeetingUpdated   = "meeting.updated"
+	EventMeetingCancelled = "meeting.cancelled"
+	EventMeetingStarting  = "meeting.starting"
+	EventHostChanged      = "meeting.host_changed"
+)
+
+const (
+	defaultWorkers   = 4
+	defaultQueueSize = 1024
+)
+
+// Recipient is one user who should hear about the event, Account is used as the mail address when it is one.
+type Recipient struct {
+	UserID   string `json:"userID"`
+	Nickname string `json:"nickname"`
+	Account  string `json:"account"`
+}
+
+// Event is one lifecycle event of a meeting, an event of one occurrence has OccurrenceID set.
+type Event struct {
+	Type           string       `json:"type"`
+	MeetingID      string       `json:"meetingID"`
+	OccurrenceID   string       `json:"occurrenceID,omitempty"`
+	Title          string       `json:"title"`
+	StartTime      int64        `json:"startTime"`
+	Duration       int64        `json:"duration"`
+	TimeZone       string       `json:"timeZone"`
+	OperatorUserID string       `json:"operatorUserID,omitempty"`
+	HostUserID     string       `json:"hostUserID,omitempty"`
+	Recipients     []*Recipient `json:"recipients"`
+	CreateTime     int64        `json:"createTime"`
+}
+
+// Sender delivers the event through one channel, e.g., mail or webhook.
+type Sender interface {
+	Name() string
+	Send(ctx context.Context, event *Event) error
+}
+
+// Subject returns the one line summary of the event.
+func (e *Event) Subject() string {
+	var action string
+	switch e.Type {
+	case EventMeetingBooked:
+		action = "Meeting booked"
+	case EventMeetingUpdated:
+		action = "Meeting updated"
+	case EventMeetingCancelled:
+		action = "Meeting cancelled"
+	case EventMeetingStarting:
+		action = "Meeting starting soon"
+	case EventHostChanged:
+		action = "Meeting host changed"
+	default:
+		action = e.Type
+	}
+	return fmt.Sprintf("[OpenMeeting] %s: %s", action, e.Title)
+}
+
+// Text returns the readable body of the event, the start time is shown in the time zone of the meeting.
+func (e *Event) Text() string {
+	loc, err := time.LoadLocation(e.TimeZone)
+	if err != nil {
+		loc = time.UTC
+	}
+	lines := []string{
+		e.Subject(),
+		"",
+		fmt.Sprintf("Meeting ID: %s", e.MeetingID),
+		fmt.Sprintf("Start: %s", time.Unix(e.StartTime, 0).In(loc).Format("2006-01-02 15:04 MST")),
+		fmt.Sprintf("Duration: %d minutes", e.Duration/60),
+	}
+	if e.HostUserID != "" {
+		lines = append(lines, fmt.Sprintf("Host: %s", e.HostUserID))
+	}
+	return strings.Join(lines, "\r\n")
+}
+
+// Dispatcher sends the events through all the senders in the background, so the caller is not blocked by them.
+type Dispatcher struct {
+	senders []Sender
+	events  chan *Event
+	workers int
+}
+
+func NewDispatcher(senders []Sender, workers, queueSize int) *Dispatcher {
+	if workers <= 0 {
+		workers = defaultWorkers
+	}
+	if queueSize <= 0 {
+		queueSize = defaultQueueSize
+	}
+	return &Dispatcher{
+		senders: senders,
+		events:  make(chan *Event, queueSize),
+		workers: workers,
+	}
+}
+
+// Start runs the workers until the context is done.
+func (d *Dispatcher) Start(ctx context.Context) {
+	for i := 0; i < d.workers; i++ {
+		go d.run(ctx)
+	}
+}
+
+// Enabled reports whether any sender is configured.
+func (d *Dispatcher) Enabled() bool {
+	return d != nil && len(d.senders) > 0
+}
+
+// Dispatch queues the event, it is dropped if the queue is full.
+func (d *Dispatcher) Dispatch(ctx context.Context, event *Event) {
+	if !d.Enabled() {
+		return
+	}
+	if event.CreateTime == 0 {
+		event.CreateTime = time.Now().Unix()
+	}
+	select {
+	case d.events <- event:
+	default:
+		log.ZWarn(ctx, "notification queue is full, drop the event", nil, "type", event.Type, "meetingID", event.MeetingID)
+	}
+}
+
+func (d *Dispatcher) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-d.events:
+			d.send(event)
+		}
+	}
+}
+
+func (d *Dispatcher) send(event *Event) {
+	ctx := mcontext.NewCtx(fmt.Sprintf("notification_%s_%d", event.MeetingID, time.Now().UnixMilli()))
+	for _, sender := range d.senders {
+		if err := sender.Send(ctx, event); err != nil {
+			log.ZError(ctx, "send notification failed", err, "sender", sender.Name(), "type", event.Type, "meetingID", event.MeetingID)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"github.com/openimsdk/openmeeting-server/pkg/common/config"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SMTPSender mails the event to the recipients whose account is a mail address.
+type SMTPSender struct {
+	conf *config.SMTP
+}
+
+func NewSMTPSender(conf *config.SMTP) *SMTPSender {
+	return &SMTPSender{conf: conf}
+}
+
+func (s *SMTPSender) Name() string {
+	return "smtp"
+}
+
+func (s *SMTPSender) Send(ctx context.Context, event *Event) error {
+	var to []string
+	for _, recipient := range event.Recipients {
+		address, err := mail.ParseAddress(recipient.Account)
+		if err != nil {
+			log.ZDebug(ctx, "account is not a mail address, skip", "userID", recipient.UserID)
+			continue
+		}
+		to = append(to, address.Address)
+	}
+	if len(to) == 0 {
+		return nil
+	}
+	var auth smtp.Auth
+	if s.conf.Username != "" {
+		auth = smtp.PlainAuth("", s.conf.Username, s.conf.Password, s.conf.Host)
+	}
+	addr := net.JoinHostPort(s.conf.Host, strconv.Itoa(s.conf.Port))
+	if err := smtp.SendMail(addr, auth, s.conf.From, to, s.message(event)); err != nil {
+		return errs.WrapMsg(err, "send mail failed", "addr", addr)
+	}
+	return nil
+}
+
+// message builds the mail, the recipients are not listed in the header so they do not see each other.
+func (s *SMTPSender) message(event *Event) []byte {
+	headers := []string{
+		fmt.Sprintf("From: %s", s.conf.From),
+		"To: undisclosed-recipients:;",
+		fmt.Sprintf("Subject: %s", mime.QEncoding.Encode("utf-8", event.Subject())),
+		fmt.Sprintf("Date: %s", time.Now().Format(time.RFC1123Z)),
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=utf-8",
+		"Content-Transfer-Encoding: 8bit",
+	}
+	return []byte(strings.Join(headers, "\r\n") + "\r\n\r\n" + event.Text() + "\r\n")
+}
@@ -0,0 +1,213 @@
+package notification
+
+import (
+	"context"
+	"encoding/base64"
+	"github.com/openimsdk/openmeeting-server/pkg/common/config"
+	"net"
+	"net/textproto"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// smtpSession is what the stand-in server received in one delivered mail.
+type smtpSession struct {
+	auth string
+	from string
+	to   []string
+	data string
+}
+
+// smtpServer is a stand-in SMTP server speaking just enough of the protocol for net/smtp.
+type smtpServer struct {
+	listener   net.Listener
+	sessions   chan *smtpSession
+	rejectRcpt string
+}
+
+// newSMTPServer starts the stand-in server, which rejects the recipient rejectRcpt if it is not empty.
+func newSMTPServer(t *testing.T, rejectRcpt string) *smtpServer {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen failed: %v", err)
+	}
+	s := &smtpServer{listener: listener, sessions: make(chan *smtpSession, 1), rejectRcpt: rejectRcpt}
+	t.Cleanup(func() { _ = listener.Close() })
+	go s.serve()
+	return s
+}
+
+func (s *smtpServer) conf(username string) *config.SMTP {
+	addr := s.listener.Addr().(*net.TCPAddr)
+	return &config.SMTP{
+		Enable:   true,
+		Host:     addr.IP.String(),
+		Port:     addr.Port,
+		Username: username,
+		Password: "secret",
+		From:     "meeting@example.com",
+	}
+}
+
+func (s *smtpServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *smtpServer) handle(conn net.Conn) {
+	defer conn.Close()
+	tp := textproto.NewConn(conn)
+	session := &smtpSession{}
+	_ = tp.PrintfLine("220 localhost ESMTP")
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		command := strings.ToUpper(line)
+		switch {
+		case strings.HasPrefix(command, "EHLO"):
+			_ = tp.PrintfLine("250-localhost")
+			_ = tp.PrintfLine("250 AUTH PLAIN")
+		case strings.HasPrefix(command, "AUTH"):
+			session.auth = line
+			_ = tp.PrintfLine("235 2.7.0 Authentication successful")
+		case strings.HasPrefix(command, "MAIL FROM:"):
+			session.from = trimPath(line[len("MAIL FROM:"):])
+			_ = tp.PrintfLine("250 OK")
+		case strings.HasPrefix(command, "RCPT TO:"):
+			to := trimPath(line[len("RCPT TO:"):])
+			if to == s.rejectRcpt {
+				_ = tp.PrintfLine("550 5.1.1 mailbox unavailable")
+				continue
+			}
+			session.to = append(session.to, to)
+			_ = tp.PrintfLine("250 OK")
+		case command == "DATA":
+			_ = tp.PrintfLine("354 end data with <CR><LF>.<CR><LF>")
+			data, err := tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			session.data = string(data)
+			_ = tp.PrintfLine("250 OK")
+		case command == "QUIT":
+			_ = tp.PrintfLine("221 bye")
+			s.sessions <- session
+			return
+		default:
+			_ = tp.PrintfLine("250 OK")
+		}
+	}
+}
+
+func trimPath(path string) string {
+	path = strings.TrimSpace(path)
+	if i := strings.IndexByte(path, ' '); i >= 0 {
+		path = path[:i]
+	}
+	return strings.Trim(path, "<>")
+}
+
+func (s *smtpServer) session(t *testing.T) *smtpSession {
+	t.Helper()
+	select {
+	case session := <-s.sessions:
+		return session
+	case <-time.After(5 * time.Second):
+		t.Fatal("no mail is delivered")
+		return nil
+	}
+}
+
+func newTestEvent() *Event {
+	return &Event{
+		Type:      EventMeetingBooked,
+		MeetingID: "1001",
+		Title:     "周会 weekly sync",
+		StartTime: time.Date(2026, 10, 19, 7, 0, 0, 0, time.UTC).Unix(),
+		Duration:  3600,
+		TimeZone:  "UTC",
+		Recipients: []*Recipient{
+			{UserID: "1", Account: "alice@example.com"},
+			{UserID: "2", Account: "13800000000"},
+			{UserID: "3", Account: "Bob <bob@example.com>"},
+		},
+	}
+}
+
+func TestSMTPSenderSend(t *testing.T) {
+	server := newSMTPServer(t, "")
+	sender := NewSMTPSender(server.conf("meeting"))
+	if err := sender.Send(context.Background(), newTestEvent()); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	session := server.session(t)
+
+	if want := "AUTH PLAIN " + base64.StdEncoding.EncodeToString([]byte("\x00meeting\x00secret")); session.auth != want {
+		t.Errorf("auth = %q, want %q", session.auth, want)
+	}
+	if session.from != "meeting@example.com" {
+		t.Errorf("from = %q", session.from)
+	}
+	// the account which is not a mail address is skipped
+	if want := []string{"alice@example.com", "bob@example.com"}; !reflect.DeepEqual(session.to, want) {
+		t.Errorf("to = %v, want %v", session.to, want)
+	}
+	for _, want := range []string{
+		"From: meeting@example.com\n",
+		"To: undisclosed-recipients:;\n",
+		"Subject: =?utf-8?q?",
+		"Content-Type: text/plain; charset=utf-8\n",
+		"Meeting ID: 1001\n",
+		"Start: 2026-10-19 07:00 UTC\n",
+		"Duration: 60 minutes\n",
+	} {
+		if !strings.Contains(session.data, want) {
+			t.Errorf("mail does not contain %q:\n%s", want, session.data)
+		}
+	}
+	// the recipients do not see each other
+	if strings.Contains(session.data, "alice@example.com") || strings.Contains(session.data, "bob@example.com") {
+		t.Errorf("mail discloses the recipients:\n%s", session.data)
+	}
+}
+
+func TestSMTPSenderSendWithoutAuth(t *testing.T) {
+	server := newSMTPServer(t, "")
+	sender := NewSMTPSender(server.conf(""))
+	if err := sender.Send(context.Background(), newTestEvent()); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if session := server.session(t); session.auth != "" {
+		t.Errorf("auth = %q, want no auth", session.auth)
+	}
+}
+
+func TestSMTPSenderSendRejected(t *testing.T) {
+	server := newSMTPServer(t, "bob@example.com")
+	sender := NewSMTPSender(server.conf(""))
+	if err := sender.Send(context.Background(), newTestEvent()); err == nil {
+		t.Fatal("Send() should fail when the server rejects a recipient")
+	}
+}
+
+func TestSMTPSenderSendNoMailRecipient(t *testing.T) {
+	server := newSMTPServer(t, "")
+	conf := server.conf("")
+	_ = server.listener.Close()
+	event := newTestEvent()
+	event.Recipients = []*Recipient{{UserID: "2", Account: "13800000000"}}
+	// nothing is sent, so the closed server is not dialed
+	if err := NewSMTPSender(conf).Send(context.Background(), event); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+}
@@ -0,0 +1,67 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/openimsdk/openmeeting-server/pkg/common/config"
+	"github.com/openimsdk/tools/errs"
+	"io"
+	"net/http"
+	"time"
+)
+
+const defaultWebhookTimeout = 5 * time.Second
+
+// WebhookSender posts the event as JSON to every configured url.
+type WebhookSender struct {
+	conf   *config.NotificationWebhook
+	client *http.Client
+}
+
+func NewWebhookSender(conf *config.NotificationWebhook) *WebhookSender {
+	timeout := time.Duration(conf.Timeout) * time.Second
+	if timeout <= 0 {
+		timeout = defaultWebhookTimeout
+	}
+	return &WebhookSender{conf: conf, client: &http.Client{Timeout: timeout}}
+}
+
+func (w *WebhookSender) Name() string {
+	return "webhook"
+}
+
+func (w *WebhookSender) Send(ctx context.Context, event *Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return errs.WrapMsg(err, "marshal event failed")
+	}
+	var errList []error
+	for _, url := range w.conf.URLs {
+		if err := w.post(ctx, url, body); err != nil {
+			errList = append(errList, err)
+		}
+	}
+	if len(errList) > 0 {
+		return errs.WrapMsg(errList[0], "post webhook failed", "failed", len(errList), "total", len(w.conf.URLs))
+	}
+	return nil
+}
+
+func (w *WebhookSender) post(ctx context.Context, url string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return errs.WrapMsg(err, "new webhook request failed", "url", url)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return errs.WrapMsg(err, "webhook request failed", "url", url)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return errs.New("webhook response status is not 2xx", "url", url, "status", resp.StatusCode).Wrap()
+	}
+	return nil
+}
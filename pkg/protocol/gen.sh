@@ -0,0 +1,42 @@
+#!/usr/bin/env bash
+# Copyright © 2024 OpenIM. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# Generates the server side protocol extensions which are not published in github.com/openimsdk/protocol yet.
+# The upstream proto files are resolved from the go module cache, so run `go mod download` first.
+
+cd "$(dirname "$0")" || exit 1
+
+UPSTREAM_PROTOCOL=$(go list -m -f '{{.Dir}}' github.com/openimsdk/protocol)
+
+PROTO_NAMES=(
+    "meetingext"
+)
+
+for name in "${PROTO_NAMES[@]}"; do
+  protoc -I . -I "${UPSTREAM_PROTOCOL}" \
+    --go_out=. --go_opt=paths=source_relative \
+    --go-grpc_out=. --go-grpc_opt=paths=source_relative,require_unimplemented_servers=false \
+    ${name}/${name}.proto
+  if [ $? -ne 0 ]; then
+      echo "error processing ${name}.proto"
+      exit 1
+  fi
+done
+
+if [ "$(uname -s)" == "Darwin" ]; then
+    find . -type f -name '*.pb.go' -exec sed -i '' 's/,omitempty"`/\"\`/g' {} +
+else
+    find . -type f -name '*.pb.go' -exec sed -i 's/,omitempty"`/\"\`/g' {} +
+fi
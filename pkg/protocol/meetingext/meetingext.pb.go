@@ -0,0 +1,9673 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v5.26.0
+// source: meetingext/meetingext.proto
+
+package meetingext
+
+import (
+	meeting "github.com/openimsdk/protocol/openmeeting/meeting"
+	sdkws "github.com/openimsdk/protocol/sdkws"
+	wrapperspb "github.com/openimsdk/protocol/wrapperspb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// One session of a meeting, a non-repeat meeting has exactly one occurrence.
+type MeetingOccurrence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OccurrenceID       string                      `protobuf:"bytes,1,opt,name=occurrenceID,proto3" json:"occurrenceID"`             // Unique identifier for the occurrence.
+	MeetingID          string                      `protobuf:"bytes,2,opt,name=meetingID,proto3" json:"meetingID"`                   // The meeting which the occurrence belongs to.
+	PlannedStartTime   int64                       `protobuf:"varint,3,opt,name=plannedStartTime,proto3" json:"plannedStartTime"`    // The planned start time of the occurrence (as a timestamp).
+	PlannedEndTime     int64                       `protobuf:"varint,4,opt,name=plannedEndTime,proto3" json:"plannedEndTime"`        // The planned end time of the occurrence (as a timestamp).
+	ActualStartTime    int64                       `protobuf:"varint,5,opt,name=actualStartTime,proto3" json:"actualStartTime"`      // The time the room of the occurrence was opened, 0 if not started.
+	ActualEndTime      int64                       `protobuf:"varint,6,opt,name=actualEndTime,proto3" json:"actualEndTime"`          // The time the room of the occurrence was closed, 0 if not ended.
+	Status             string                      `protobuf:"bytes,7,opt,name=status,proto3" json:"status"`                         // The current status of the occurrence, e.g., scheduled, in-progress, completed.
+	ParticipantUserIDs []string                    `protobuf:"bytes,8,rep,name=participantUserIDs,proto3" json:"participantUserIDs"` // Users who joined this occurrence.
+	MeetingDetail      *meeting.MeetingInfoSetting `protobuf:"bytes,9,opt,name=meetingDetail,proto3" json:"meetingDetail"`           // Detail of the meeting with the occurrence overrides applied.
+	OriginalStartTime  int64                       `protobuf:"varint,10,opt,name=originalStartTime,proto3" json:"originalStartTime"` // The start time generated by the repetition, identifies the occurrence in overrides.
+}
+
+func (x *MeetingOccurrence) Reset() {
+	*x = MeetingOccurrence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeetingOccurrence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeetingOccurrence) ProtoMessage() {}
+
+func (x *MeetingOccurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeetingOccurrence.ProtoReflect.Descriptor instead.
+func (*MeetingOccurrence) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MeetingOccurrence) GetOccurrenceID() string {
+	if x != nil {
+		return x.OccurrenceID
+	}
+	return ""
+}
+
+func (x *MeetingOccurrence) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *MeetingOccurrence) GetPlannedStartTime() int64 {
+	if x != nil {
+		return x.PlannedStartTime
+	}
+	return 0
+}
+
+func (x *MeetingOccurrence) GetPlannedEndTime() int64 {
+	if x != nil {
+		return x.PlannedEndTime
+	}
+	return 0
+}
+
+func (x *MeetingOccurrence) GetActualStartTime() int64 {
+	if x != nil {
+		return x.ActualStartTime
+	}
+	return 0
+}
+
+func (x *MeetingOccurrence) GetActualEndTime() int64 {
+	if x != nil {
+		return x.ActualEndTime
+	}
+	return 0
+}
+
+func (x *MeetingOccurrence) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *MeetingOccurrence) GetParticipantUserIDs() []string {
+	if x != nil {
+		return x.ParticipantUserIDs
+	}
+	return nil
+}
+
+func (x *MeetingOccurrence) GetMeetingDetail() *meeting.MeetingInfoSetting {
+	if x != nil {
+		return x.MeetingDetail
+	}
+	return nil
+}
+
+func (x *MeetingOccurrence) GetOriginalStartTime() int64 {
+	if x != nil {
+		return x.OriginalStartTime
+	}
+	return 0
+}
+
+// Request to get the occurrences of the user's meetings within a time window.
+type GetMeetingOccurrencesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID    string   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	MeetingID string   `protobuf:"bytes,2,opt,name=meetingID,proto3" json:"meetingID"`  // Only return the occurrences of this meeting if set.
+	StartTime int64    `protobuf:"varint,3,opt,name=startTime,proto3" json:"startTime"` // Occurrences planned to start at or after this time (as a timestamp).
+	EndTime   int64    `protobuf:"varint,4,opt,name=endTime,proto3" json:"endTime"`     // Occurrences planned to start before this time (as a timestamp).
+	Status    []string `protobuf:"bytes,5,rep,name=status,proto3" json:"status"`        // The status filter for occurrences.
+}
+
+func (x *GetMeetingOccurrencesReq) Reset() {
+	*x = GetMeetingOccurrencesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMeetingOccurrencesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMeetingOccurrencesReq) ProtoMessage() {}
+
+func (x *GetMeetingOccurrencesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMeetingOccurrencesReq.ProtoReflect.Descriptor instead.
+func (*GetMeetingOccurrencesReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetMeetingOccurrencesReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetMeetingOccurrencesReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *GetMeetingOccurrencesReq) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *GetMeetingOccurrencesReq) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *GetMeetingOccurrencesReq) GetStatus() []string {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Response with the occurrences in the requested time window, ordered by planned start time.
+type GetMeetingOccurrencesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Occurrences []*MeetingOccurrence `protobuf:"bytes,1,rep,name=occurrences,proto3" json:"occurrences"`
+}
+
+func (x *GetMeetingOccurrencesResp) Reset() {
+	*x = GetMeetingOccurrencesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMeetingOccurrencesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMeetingOccurrencesResp) ProtoMessage() {}
+
+func (x *GetMeetingOccurrencesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMeetingOccurrencesResp.ProtoReflect.Descriptor instead.
+func (*GetMeetingOccurrencesResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetMeetingOccurrencesResp) GetOccurrences() []*MeetingOccurrence {
+	if x != nil {
+		return x.Occurrences
+	}
+	return nil
+}
+
+// The RFC 5545 recurrence of a meeting, DTSTART is always the scheduled time of the meeting.
+type MeetingRecurrence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rrule   string  `protobuf:"bytes,1,opt,name=rrule,proto3" json:"rrule"`             // RRULE value, e.g., FREQ=MONTHLY;BYDAY=-1FR, the "RRULE:" prefix is optional.
+	ExDates []int64 `protobuf:"varint,2,rep,packed,name=exDates,proto3" json:"exDates"` // Start times of the occurrences excluded from the repetition (as timestamps).
+}
+
+func (x *MeetingRecurrence) Reset() {
+	*x = MeetingRecurrence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeetingRecurrence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeetingRecurrence) ProtoMessage() {}
+
+func (x *MeetingRecurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeetingRecurrence.ProtoReflect.Descriptor instead.
+func (*MeetingRecurrence) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MeetingRecurrence) GetRrule() string {
+	if x != nil {
+		return x.Rrule
+	}
+	return ""
+}
+
+func (x *MeetingRecurrence) GetExDates() []int64 {
+	if x != nil {
+		return x.ExDates
+	}
+	return nil
+}
+
+// Request to book a meeting repeating by an RRULE.
+type BookRecurringMeetingReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Meeting    *meeting.BookMeetingReq `protobuf:"bytes,1,opt,name=meeting,proto3" json:"meeting"` // The repeat info of the meeting is ignored.
+	Recurrence *MeetingRecurrence      `protobuf:"bytes,2,opt,name=recurrence,proto3" json:"recurrence"`
+}
+
+func (x *BookRecurringMeetingReq) Reset() {
+	*x = BookRecurringMeetingReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BookRecurringMeetingReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookRecurringMeetingReq) ProtoMessage() {}
+
+func (x *BookRecurringMeetingReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookRecurringMeetingReq.ProtoReflect.Descriptor instead.
+func (*BookRecurringMeetingReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BookRecurringMeetingReq) GetMeeting() *meeting.BookMeetingReq {
+	if x != nil {
+		return x.Meeting
+	}
+	return nil
+}
+
+func (x *BookRecurringMeetingReq) GetRecurrence() *MeetingRecurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+// Request to replace the recurrence of a meeting.
+type UpdateMeetingRecurrenceReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingID      string             `protobuf:"bytes,1,opt,name=meetingID,proto3" json:"meetingID"`
+	UpdatingUserID string             `protobuf:"bytes,2,opt,name=updatingUserID,proto3" json:"updatingUserID"`
+	Recurrence     *MeetingRecurrence `protobuf:"bytes,3,opt,name=recurrence,proto3" json:"recurrence"`
+}
+
+func (x *UpdateMeetingRecurrenceReq) Reset() {
+	*x = UpdateMeetingRecurrenceReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateMeetingRecurrenceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMeetingRecurrenceReq) ProtoMessage() {}
+
+func (x *UpdateMeetingRecurrenceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMeetingRecurrenceReq.ProtoReflect.Descriptor instead.
+func (*UpdateMeetingRecurrenceReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateMeetingRecurrenceReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *UpdateMeetingRecurrenceReq) GetUpdatingUserID() string {
+	if x != nil {
+		return x.UpdatingUserID
+	}
+	return ""
+}
+
+func (x *UpdateMeetingRecurrenceReq) GetRecurrence() *MeetingRecurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+// Response after updating the recurrence of a meeting.
+type UpdateMeetingRecurrenceResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateMeetingRecurrenceResp) Reset() {
+	*x = UpdateMeetingRecurrenceResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateMeetingRecurrenceResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMeetingRecurrenceResp) ProtoMessage() {}
+
+func (x *UpdateMeetingRecurrenceResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMeetingRecurrenceResp.ProtoReflect.Descriptor instead.
+func (*UpdateMeetingRecurrenceResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{6}
+}
+
+// Request to get the recurrence of a meeting.
+type GetMeetingRecurrenceReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingID string `protobuf:"bytes,1,opt,name=meetingID,proto3" json:"meetingID"`
+}
+
+func (x *GetMeetingRecurrenceReq) Reset() {
+	*x = GetMeetingRecurrenceReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMeetingRecurrenceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMeetingRecurrenceReq) ProtoMessage() {}
+
+func (x *GetMeetingRecurrenceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMeetingRecurrenceReq.ProtoReflect.Descriptor instead.
+func (*GetMeetingRecurrenceReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetMeetingRecurrenceReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+// Response with the effective recurrence, the repeat type based setting is mapped onto the equivalent RRULE.
+type GetMeetingRecurrenceResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Recurrence *MeetingRecurrence `protobuf:"bytes,1,opt,name=recurrence,proto3" json:"recurrence"` // Empty rrule if the meeting does not repeat.
+}
+
+func (x *GetMeetingRecurrenceResp) Reset() {
+	*x = GetMeetingRecurrenceResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMeetingRecurrenceResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMeetingRecurrenceResp) ProtoMessage() {}
+
+func (x *GetMeetingRecurrenceResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMeetingRecurrenceResp.ProtoReflect.Descriptor instead.
+func (*GetMeetingRecurrenceResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetMeetingRecurrenceResp) GetRecurrence() *MeetingRecurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+// Request to override one occurrence of a recurring meeting, unset fields keep the value of the series.
+type UpdateMeetingOccurrenceReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingID         string                  `protobuf:"bytes,1,opt,name=meetingID,proto3" json:"meetingID"`
+	UpdatingUserID    string                  `protobuf:"bytes,2,opt,name=updatingUserID,proto3" json:"updatingUserID"`
+	OriginalStartTime int64                   `protobuf:"varint,3,opt,name=originalStartTime,proto3" json:"originalStartTime"` // The start time generated by the repetition.
+	ScheduledTime     *wrapperspb.Int64Value  `protobuf:"bytes,4,opt,name=scheduledTime,proto3" json:"scheduledTime"`          // The new start time of the occurrence.
+	MeetingDuration   *wrapperspb.Int64Value  `protobuf:"bytes,5,opt,name=meetingDuration,proto3" json:"meetingDuration"`
+	Title             *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=title,proto3" json:"title"`
+	Password          *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=password,proto3" json:"password"`
+}
+
+func (x *UpdateMeetingOccurrenceReq) Reset() {
+	*x = UpdateMeetingOccurrenceReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateMeetingOccurrenceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMeetingOccurrenceReq) ProtoMessage() {}
+
+func (x *UpdateMeetingOccurrenceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMeetingOccurrenceReq.ProtoReflect.Descriptor instead.
+func (*UpdateMeetingOccurrenceReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateMeetingOccurrenceReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *UpdateMeetingOccurrenceReq) GetUpdatingUserID() string {
+	if x != nil {
+		return x.UpdatingUserID
+	}
+	return ""
+}
+
+func (x *UpdateMeetingOccurrenceReq) GetOriginalStartTime() int64 {
+	if x != nil {
+		return x.OriginalStartTime
+	}
+	return 0
+}
+
+func (x *UpdateMeetingOccurrenceReq) GetScheduledTime() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.ScheduledTime
+	}
+	return nil
+}
+
+func (x *UpdateMeetingOccurrenceReq) GetMeetingDuration() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.MeetingDuration
+	}
+	return nil
+}
+
+func (x *UpdateMeetingOccurrenceReq) GetTitle() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Title
+	}
+	return nil
+}
+
+func (x *UpdateMeetingOccurrenceReq) GetPassword() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Password
+	}
+	return nil
+}
+
+// Response with the occurrence after the override applied.
+type UpdateMeetingOccurrenceResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Occurrence *MeetingOccurrence `protobuf:"bytes,1,opt,name=occurrence,proto3" json:"occurrence"`
+}
+
+func (x *UpdateMeetingOccurrenceResp) Reset() {
+	*x = UpdateMeetingOccurrenceResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateMeetingOccurrenceResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMeetingOccurrenceResp) ProtoMessage() {}
+
+func (x *UpdateMeetingOccurrenceResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMeetingOccurrenceResp.ProtoReflect.Descriptor instead.
+func (*UpdateMeetingOccurrenceResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateMeetingOccurrenceResp) GetOccurrence() *MeetingOccurrence {
+	if x != nil {
+		return x.Occurrence
+	}
+	return nil
+}
+
+// Request to cancel one occurrence of a recurring meeting, the rest of the series is kept.
+type CancelMeetingOccurrenceReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingID         string `protobuf:"bytes,1,opt,name=meetingID,proto3" json:"meetingID"`
+	UserID            string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	OriginalStartTime int64  `protobuf:"varint,3,opt,name=originalStartTime,proto3" json:"originalStartTime"` // The start time generated by the repetition.
+}
+
+func (x *CancelMeetingOccurrenceReq) Reset() {
+	*x = CancelMeetingOccurrenceReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelMeetingOccurrenceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelMeetingOccurrenceReq) ProtoMessage() {}
+
+func (x *CancelMeetingOccurrenceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelMeetingOccurrenceReq.ProtoReflect.Descriptor instead.
+func (*CancelMeetingOccurrenceReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CancelMeetingOccurrenceReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *CancelMeetingOccurrenceReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *CancelMeetingOccurrenceReq) GetOriginalStartTime() int64 {
+	if x != nil {
+		return x.OriginalStartTime
+	}
+	return 0
+}
+
+// Response after cancelling the occurrence.
+type CancelMeetingOccurrenceResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelMeetingOccurrenceResp) Reset() {
+	*x = CancelMeetingOccurrenceResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelMeetingOccurrenceResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelMeetingOccurrenceResp) ProtoMessage() {}
+
+func (x *CancelMeetingOccurrenceResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelMeetingOccurrenceResp.ProtoReflect.Descriptor instead.
+func (*CancelMeetingOccurrenceResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{12}
+}
+
+// Request to export a meeting as an iCalendar.
+type GetMeetingICalendarReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingID string `protobuf:"bytes,1,opt,name=meetingID,proto3" json:"meetingID"`
+	UserID    string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *GetMeetingICalendarReq) Reset() {
+	*x = GetMeetingICalendarReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMeetingICalendarReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMeetingICalendarReq) ProtoMessage() {}
+
+func (x *GetMeetingICalendarReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMeetingICalendarReq.ProtoReflect.Descriptor instead.
+func (*GetMeetingICalendarReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetMeetingICalendarReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *GetMeetingICalendarReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+// Response with the RFC 5545 VCALENDAR of the meeting.
+type GetMeetingICalendarResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Calendar string `protobuf:"bytes,1,opt,name=calendar,proto3" json:"calendar"`
+}
+
+func (x *GetMeetingICalendarResp) Reset() {
+	*x = GetMeetingICalendarResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMeetingICalendarResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMeetingICalendarResp) ProtoMessage() {}
+
+func (x *GetMeetingICalendarResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMeetingICalendarResp.ProtoReflect.Descriptor instead.
+func (*GetMeetingICalendarResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetMeetingICalendarResp) GetCalendar() string {
+	if x != nil {
+		return x.Calendar
+	}
+	return ""
+}
+
+// Request to get the calendar feed token of the user.
+type GetCalendarFeedTokenReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Renew  bool   `protobuf:"varint,2,opt,name=renew,proto3" json:"renew"` // Generate a new token, the feed links with the old token stop working.
+}
+
+func (x *GetCalendarFeedTokenReq) Reset() {
+	*x = GetCalendarFeedTokenReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCalendarFeedTokenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarFeedTokenReq) ProtoMessage() {}
+
+func (x *GetCalendarFeedTokenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarFeedTokenReq.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedTokenReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetCalendarFeedTokenReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetCalendarFeedTokenReq) GetRenew() bool {
+	if x != nil {
+		return x.Renew
+	}
+	return false
+}
+
+// Response with the long-lived token to subscribe the calendar feed.
+type GetCalendarFeedTokenResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FeedToken string `protobuf:"bytes,1,opt,name=feedToken,proto3" json:"feedToken"`
+}
+
+func (x *GetCalendarFeedTokenResp) Reset() {
+	*x = GetCalendarFeedTokenResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCalendarFeedTokenResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarFeedTokenResp) ProtoMessage() {}
+
+func (x *GetCalendarFeedTokenResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarFeedTokenResp.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedTokenResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetCalendarFeedTokenResp) GetFeedToken() string {
+	if x != nil {
+		return x.FeedToken
+	}
+	return ""
+}
+
+// Request to get the calendar feed of the user owning the feed token.
+type GetCalendarFeedReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FeedToken string `protobuf:"bytes,1,opt,name=feedToken,proto3" json:"feedToken"`
+}
+
+func (x *GetCalendarFeedReq) Reset() {
+	*x = GetCalendarFeedReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCalendarFeedReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarFeedReq) ProtoMessage() {}
+
+func (x *GetCalendarFeedReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarFeedReq.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetCalendarFeedReq) GetFeedToken() string {
+	if x != nil {
+		return x.FeedToken
+	}
+	return ""
+}
+
+// Response with the RFC 5545 VCALENDAR of all the user's meetings.
+type GetCalendarFeedResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Calendar string `protobuf:"bytes,1,opt,name=calendar,proto3" json:"calendar"`
+}
+
+func (x *GetCalendarFeedResp) Reset() {
+	*x = GetCalendarFeedResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCalendarFeedResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarFeedResp) ProtoMessage() {}
+
+func (x *GetCalendarFeedResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarFeedResp.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetCalendarFeedResp) GetCalendar() string {
+	if x != nil {
+		return x.Calendar
+	}
+	return ""
+}
+
+// A user invited to a meeting and the RSVP of the user.
+type MeetingInvitee struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID        string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Nickname      string `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname"`
+	InviterUserID string `protobuf:"bytes,3,opt,name=inviterUserID,proto3" json:"inviterUserID"`
+	Response      string `protobuf:"bytes,4,opt,name=response,proto3" json:"response"` // The RSVP of the invitee, e.g., Pending, Accepted, Declined, Tentative.
+	InviteTime    int64  `protobuf:"varint,5,opt,name=inviteTime,proto3" json:"inviteTime"`
+	ResponseTime  int64  `protobuf:"varint,6,opt,name=responseTime,proto3" json:"responseTime"` // 0 if the invitee has not responded yet.
+}
+
+func (x *MeetingInvitee) Reset() {
+	*x = MeetingInvitee{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeetingInvitee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeetingInvitee) ProtoMessage() {}
+
+func (x *MeetingInvitee) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeetingInvitee.ProtoReflect.Descriptor instead.
+func (*MeetingInvitee) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *MeetingInvitee) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *MeetingInvitee) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *MeetingInvitee) GetInviterUserID() string {
+	if x != nil {
+		return x.InviterUserID
+	}
+	return ""
+}
+
+func (x *MeetingInvitee) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+func (x *MeetingInvitee) GetInviteTime() int64 {
+	if x != nil {
+		return x.InviteTime
+	}
+	return 0
+}
+
+func (x *MeetingInvitee) GetResponseTime() int64 {
+	if x != nil {
+		return x.ResponseTime
+	}
+	return 0
+}
+
+// Request to invite users to a meeting.
+type AddMeetingInviteesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingID      string   `protobuf:"bytes,1,opt,name=meetingID,proto3" json:"meetingID"`
+	OperatorUserID string   `protobuf:"bytes,2,opt,name=operatorUserID,proto3" json:"operatorUserID"`
+	UserIDs        []string `protobuf:"bytes,3,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *AddMeetingInviteesReq) Reset() {
+	*x = AddMeetingInviteesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddMeetingInviteesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMeetingInviteesReq) ProtoMessage() {}
+
+func (x *AddMeetingInviteesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMeetingInviteesReq.ProtoReflect.Descriptor instead.
+func (*AddMeetingInviteesReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AddMeetingInviteesReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *AddMeetingInviteesReq) GetOperatorUserID() string {
+	if x != nil {
+		return x.OperatorUserID
+	}
+	return ""
+}
+
+func (x *AddMeetingInviteesReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+// Response after inviting users.
+type AddMeetingInviteesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AddMeetingInviteesResp) Reset() {
+	*x = AddMeetingInviteesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddMeetingInviteesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMeetingInviteesResp) ProtoMessage() {}
+
+func (x *AddMeetingInviteesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMeetingInviteesResp.ProtoReflect.Descriptor instead.
+func (*AddMeetingInviteesResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{21}
+}
+
+// Request to withdraw the invitations of users.
+type RemoveMeetingInviteesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingID      string   `protobuf:"bytes,1,opt,name=meetingID,proto3" json:"meetingID"`
+	OperatorUserID string   `protobuf:"bytes,2,opt,name=operatorUserID,proto3" json:"operatorUserID"`
+	UserIDs        []string `protobuf:"bytes,3,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *RemoveMeetingInviteesReq) Reset() {
+	*x = RemoveMeetingInviteesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveMeetingInviteesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMeetingInviteesReq) ProtoMessage() {}
+
+func (x *RemoveMeetingInviteesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMeetingInviteesReq.ProtoReflect.Descriptor instead.
+func (*RemoveMeetingInviteesReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RemoveMeetingInviteesReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *RemoveMeetingInviteesReq) GetOperatorUserID() string {
+	if x != nil {
+		return x.OperatorUserID
+	}
+	return ""
+}
+
+func (x *RemoveMeetingInviteesReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+// Response after withdrawing the invitations.
+type RemoveMeetingInviteesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveMeetingInviteesResp) Reset() {
+	*x = RemoveMeetingInviteesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveMeetingInviteesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMeetingInviteesResp) ProtoMessage() {}
+
+func (x *RemoveMeetingInviteesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMeetingInviteesResp.ProtoReflect.Descriptor instead.
+func (*RemoveMeetingInviteesResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{23}
+}
+
+// Request to respond the invitation of a meeting.
+type RespondMeetingInvitationReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingID string `protobuf:"bytes,1,opt,name=meetingID,proto3" json:"meetingID"`
+	UserID    string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	Response  string `protobuf:"bytes,3,opt,name=response,proto3" json:"response"` // Accepted, Declined or Tentative.
+}
+
+func (x *RespondMeetingInvitationReq) Reset() {
+	*x = RespondMeetingInvitationReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RespondMeetingInvitationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondMeetingInvitationReq) ProtoMessage() {}
+
+func (x *RespondMeetingInvitationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondMeetingInvitationReq.ProtoReflect.Descriptor instead.
+func (*RespondMeetingInvitationReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RespondMeetingInvitationReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *RespondMeetingInvitationReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RespondMeetingInvitationReq) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+// Response after responding the invitation.
+type RespondMeetingInvitationResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RespondMeetingInvitationResp) Reset() {
+	*x = RespondMeetingInvitationResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RespondMeetingInvitationResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondMeetingInvitationResp) ProtoMessage() {}
+
+func (x *RespondMeetingInvitationResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondMeetingInvitationResp.ProtoReflect.Descriptor instead.
+func (*RespondMeetingInvitationResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{25}
+}
+
+// Request to get the invitees of a meeting.
+type GetMeetingInviteesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingID string `protobuf:"bytes,1,opt,name=meetingID,proto3" json:"meetingID"`
+	UserID    string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"` // The user reading the invitees, bound to the login user.
+}
+
+func (x *GetMeetingInviteesReq) Reset() {
+	*x = GetMeetingInviteesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMeetingInviteesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMeetingInviteesReq) ProtoMessage() {}
+
+func (x *GetMeetingInviteesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMeetingInviteesReq.ProtoReflect.Descriptor instead.
+func (*GetMeetingInviteesReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetMeetingInviteesReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *GetMeetingInviteesReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+// Response with the invitees of the meeting.
+type GetMeetingInviteesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Invitees []*MeetingInvitee `protobuf:"bytes,1,rep,name=invitees,proto3" json:"invitees"`
+}
+
+func (x *GetMeetingInviteesResp) Reset() {
+	*x = GetMeetingInviteesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMeetingInviteesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMeetingInviteesResp) ProtoMessage() {}
+
+func (x *GetMeetingInviteesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMeetingInviteesResp.ProtoReflect.Descriptor instead.
+func (*GetMeetingInviteesResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetMeetingInviteesResp) GetInvitees() []*MeetingInvitee {
+	if x != nil {
+		return x.Invitees
+	}
+	return nil
+}
+
+// A meeting the user is invited to.
+type InvitedMeeting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingDetail *meeting.MeetingInfoSetting `protobuf:"bytes,1,opt,name=meetingDetail,proto3" json:"meetingDetail"`
+	Invitation    *MeetingInvitee             `protobuf:"bytes,2,opt,name=invitation,proto3" json:"invitation"`
+}
+
+func (x *InvitedMeeting) Reset() {
+	*x = InvitedMeeting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvitedMeeting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvitedMeeting) ProtoMessage() {}
+
+func (x *InvitedMeeting) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvitedMeeting.ProtoReflect.Descriptor instead.
+func (*InvitedMeeting) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *InvitedMeeting) GetMeetingDetail() *meeting.MeetingInfoSetting {
+	if x != nil {
+		return x.MeetingDetail
+	}
+	return nil
+}
+
+func (x *InvitedMeeting) GetInvitation() *MeetingInvitee {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+// Request to get the meetings the user is invited to.
+type GetInvitedMeetingsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID    string   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Status    []string `protobuf:"bytes,2,rep,name=status,proto3" json:"status"`       // The status filter for meetings.
+	Responses []string `protobuf:"bytes,3,rep,name=responses,proto3" json:"responses"` // The RSVP filter for invitations.
+}
+
+func (x *GetInvitedMeetingsReq) Reset() {
+	*x = GetInvitedMeetingsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInvitedMeetingsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvitedMeetingsReq) ProtoMessage() {}
+
+func (x *GetInvitedMeetingsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvitedMeetingsReq.ProtoReflect.Descriptor instead.
+func (*GetInvitedMeetingsReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetInvitedMeetingsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetInvitedMeetingsReq) GetStatus() []string {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GetInvitedMeetingsReq) GetResponses() []string {
+	if x != nil {
+		return x.Responses
+	}
+	return nil
+}
+
+// Response with the meetings the user is invited to.
+type GetInvitedMeetingsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Meetings []*InvitedMeeting `protobuf:"bytes,1,rep,name=meetings,proto3" json:"meetings"`
+}
+
+func (x *GetInvitedMeetingsResp) Reset() {
+	*x = GetInvitedMeetingsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInvitedMeetingsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvitedMeetingsResp) ProtoMessage() {}
+
+func (x *GetInvitedMeetingsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvitedMeetingsResp.ProtoReflect.Descriptor instead.
+func (*GetInvitedMeetingsResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetInvitedMeetingsResp) GetMeetings() []*InvitedMeeting {
+	if x != nil {
+		return x.Meetings
+	}
+	return nil
+}
+
+// Request with a webhook of the LiveKit server as it is received.
+type HandleLiveKitWebhookReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Body      []byte `protobuf:"bytes,1,opt,name=body,proto3" json:"body"`           // The raw request body, the checksum in the auth token covers it byte by byte.
+	AuthToken string `protobuf:"bytes,2,opt,name=authToken,proto3" json:"authToken"` // The Authorization header, a JWT signed with the api secret.
+}
+
+func (x *HandleLiveKitWebhookReq) Reset() {
+	*x = HandleLiveKitWebhookReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HandleLiveKitWebhookReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandleLiveKitWebhookReq) ProtoMessage() {}
+
+func (x *HandleLiveKitWebhookReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandleLiveKitWebhookReq.ProtoReflect.Descriptor instead.
+func (*HandleLiveKitWebhookReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *HandleLiveKitWebhookReq) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *HandleLiveKitWebhookReq) GetAuthToken() string {
+	if x != nil {
+		return x.AuthToken
+	}
+	return ""
+}
+
+// Response after the webhook is handled.
+type HandleLiveKitWebhookResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *HandleLiveKitWebhookResp) Reset() {
+	*x = HandleLiveKitWebhookResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HandleLiveKitWebhookResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandleLiveKitWebhookResp) ProtoMessage() {}
+
+func (x *HandleLiveKitWebhookResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandleLiveKitWebhookResp.ProtoReflect.Descriptor instead.
+func (*HandleLiveKitWebhookResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{32}
+}
+
+// One stay of a user in the meeting room.
+type AttendanceRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OccurrenceID string `protobuf:"bytes,1,opt,name=occurrenceID,proto3" json:"occurrenceID"`
+	JoinTime     int64  `protobuf:"varint,2,opt,name=joinTime,proto3" json:"joinTime"`
+	LeaveTime    int64  `protobuf:"varint,3,opt,name=leaveTime,proto3" json:"leaveTime"`    // 0 if the user is still in the room.
+	LeaveReason  string `protobuf:"bytes,4,opt,name=leaveReason,proto3" json:"leaveReason"` // e.g., Left, Kicked, MeetingEnded, DuplicatedLogin.
+	Duration     int64  `protobuf:"varint,5,opt,name=duration,proto3" json:"duration"`      // Seconds in the room, counted until now if the user is still in it.
+}
+
+func (x *AttendanceRecord) Reset() {
+	*x = AttendanceRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttendanceRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendanceRecord) ProtoMessage() {}
+
+func (x *AttendanceRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendanceRecord.ProtoReflect.Descriptor instead.
+func (*AttendanceRecord) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AttendanceRecord) GetOccurrenceID() string {
+	if x != nil {
+		return x.OccurrenceID
+	}
+	return ""
+}
+
+func (x *AttendanceRecord) GetJoinTime() int64 {
+	if x != nil {
+		return x.JoinTime
+	}
+	return 0
+}
+
+func (x *AttendanceRecord) GetLeaveTime() int64 {
+	if x != nil {
+		return x.LeaveTime
+	}
+	return 0
+}
+
+func (x *AttendanceRecord) GetLeaveReason() string {
+	if x != nil {
+		return x.LeaveReason
+	}
+	return ""
+}
+
+func (x *AttendanceRecord) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+// The attendance of one user in the meeting.
+type MeetingAttendee struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID        string              `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Nickname      string              `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname"`
+	TotalDuration int64               `protobuf:"varint,3,opt,name=totalDuration,proto3" json:"totalDuration"` // Total seconds of all the records.
+	FirstJoinTime int64               `protobuf:"varint,4,opt,name=firstJoinTime,proto3" json:"firstJoinTime"`
+	LastLeaveTime int64               `protobuf:"varint,5,opt,name=lastLeaveTime,proto3" json:"lastLeaveTime"` // 0 if the user is still in the room.
+	Records       []*AttendanceRecord `protobuf:"bytes,6,rep,name=records,proto3" json:"records"`
+}
+
+func (x *MeetingAttendee) Reset() {
+	*x = MeetingAttendee{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeetingAttendee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeetingAttendee) ProtoMessage() {}
+
+func (x *MeetingAttendee) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeetingAttendee.ProtoReflect.Descriptor instead.
+func (*MeetingAttendee) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *MeetingAttendee) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *MeetingAttendee) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *MeetingAttendee) GetTotalDuration() int64 {
+	if x != nil {
+		return x.TotalDuration
+	}
+	return 0
+}
+
+func (x *MeetingAttendee) GetFirstJoinTime() int64 {
+	if x != nil {
+		return x.FirstJoinTime
+	}
+	return 0
+}
+
+func (x *MeetingAttendee) GetLastLeaveTime() int64 {
+	if x != nil {
+		return x.LastLeaveTime
+	}
+	return 0
+}
+
+func (x *MeetingAttendee) GetRecords() []*AttendanceRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+// Request to get the attendance of a meeting.
+type GetMeetingAttendanceReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingID    string `protobuf:"bytes,1,opt,name=meetingID,proto3" json:"meetingID"`
+	UserID       string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	OccurrenceID string `protobuf:"bytes,3,opt,name=occurrenceID,proto3" json:"occurrenceID"` // Only the attendance of this occurrence if set.
+}
+
+func (x *GetMeetingAttendanceReq) Reset() {
+	*x = GetMeetingAttendanceReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMeetingAttendanceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMeetingAttendanceReq) ProtoMessage() {}
+
+func (x *GetMeetingAttendanceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMeetingAttendanceReq.ProtoReflect.Descriptor instead.
+func (*GetMeetingAttendanceReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetMeetingAttendanceReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *GetMeetingAttendanceReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetMeetingAttendanceReq) GetOccurrenceID() string {
+	if x != nil {
+		return x.OccurrenceID
+	}
+	return ""
+}
+
+// Response with the attendees ordered by their first join time.
+type GetMeetingAttendanceResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attendees []*MeetingAttendee `protobuf:"bytes,1,rep,name=attendees,proto3" json:"attendees"`
+}
+
+func (x *GetMeetingAttendanceResp) Reset() {
+	*x = GetMeetingAttendanceResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMeetingAttendanceResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMeetingAttendanceResp) ProtoMessage() {}
+
+func (x *GetMeetingAttendanceResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMeetingAttendanceResp.ProtoReflect.Descriptor instead.
+func (*GetMeetingAttendanceResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetMeetingAttendanceResp) GetAttendees() []*MeetingAttendee {
+	if x != nil {
+		return x.Attendees
+	}
+	return nil
+}
+
+// A user asking to join the meeting with the waiting room on.
+type WaitingUser struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID         string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Nickname       string `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname"`
+	Status         string `protobuf:"bytes,3,opt,name=status,proto3" json:"status"` // Waiting, Admitted or Denied.
+	RequestTime    int64  `protobuf:"varint,4,opt,name=requestTime,proto3" json:"requestTime"`
+	ResponseTime   int64  `protobuf:"varint,5,opt,name=responseTime,proto3" json:"responseTime"`    // 0 if the hosts have not responded yet.
+	OperatorUserID string `protobuf:"bytes,6,opt,name=operatorUserID,proto3" json:"operatorUserID"` // The host who admitted or denied the user.
+}
+
+func (x *WaitingUser) Reset() {
+	*x = WaitingUser{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WaitingUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitingUser) ProtoMessage() {}
+
+func (x *WaitingUser) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitingUser.ProtoReflect.Descriptor instead.
+func (*WaitingUser) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *WaitingUser) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *WaitingUser) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *WaitingUser) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WaitingUser) GetRequestTime() int64 {
+	if x != nil {
+		return x.RequestTime
+	}
+	return 0
+}
+
+func (x *WaitingUser) GetResponseTime() int64 {
+	if x != nil {
+		return x.ResponseTime
+	}
+	return 0
+}
+
+func (x *WaitingUser) GetOperatorUserID() string {
+	if x != nil {
+		return x.OperatorUserID
+	}
+	return ""
+}
+
+// Notifies the hosts of the users waiting for admission.
+type WaitingRoomData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users []*WaitingUser `protobuf:"bytes,1,rep,name=users,proto3" json:"users"` // All the users still waiting.
+}
+
+func (x *WaitingRoomData) Reset() {
+	*x = WaitingRoomData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WaitingRoomData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitingRoomData) ProtoMessage() {}
+
+func (x *WaitingRoomData) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitingRoomData.ProtoReflect.Descriptor instead.
+func (*WaitingRoomData) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *WaitingRoomData) GetUsers() []*WaitingUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+// Notifies the participants that the meeting is locked or unlocked.
+type MeetingLockData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Locked bool `protobuf:"varint,1,opt,name=locked,proto3" json:"locked"`
+}
+
+func (x *MeetingLockData) Reset() {
+	*x = MeetingLockData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeetingLockData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeetingLockData) ProtoMessage() {}
+
+func (x *MeetingLockData) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeetingLockData.ProtoReflect.Descriptor instead.
+func (*MeetingLockData) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *MeetingLockData) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+// Room data of the features which are not part of the published NotifyMeetingData yet, sent on its own topic.
+type NotifyMeetingExtData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OperatorUserID string `protobuf:"bytes,1,opt,name=operatorUserID,proto3" json:"operatorUserID"`
+	// Types that are assignable to MessageType:
+	//	*NotifyMeetingExtData_WaitingRoomData
+	//	*NotifyMeetingExtData_MeetingLockData
+	//	*NotifyMeetingExtData_MeetingRoleData
+	//	*NotifyMeetingExtData_SpeakingQueueData
+	//	*NotifyMeetingExtData_MeetingRecordingData
+	//	*NotifyMeetingExtData_MeetingLiveStreamData
+	//	*NotifyMeetingExtData_MeetingBreakoutData
+	//	*NotifyMeetingExtData_BreakoutBroadcastData
+	MessageType isNotifyMeetingExtData_MessageType `protobuf_oneof:"messageType"`
+}
+
+func (x *NotifyMeetingExtData) Reset() {
+	*x = NotifyMeetingExtData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotifyMeetingExtData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyMeetingExtData) ProtoMessage() {}
+
+func (x *NotifyMeetingExtData) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyMeetingExtData.ProtoReflect.Descriptor instead.
+func (*NotifyMeetingExtData) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *NotifyMeetingExtData) GetOperatorUserID() string {
+	if x != nil {
+		return x.OperatorUserID
+	}
+	return ""
+}
+
+func (m *NotifyMeetingExtData) GetMessageType() isNotifyMeetingExtData_MessageType {
+	if m != nil {
+		return m.MessageType
+	}
+	return nil
+}
+
+func (x *NotifyMeetingExtData) GetWaitingRoomData() *WaitingRoomData {
+	if x, ok := x.GetMessageType().(*NotifyMeetingExtData_WaitingRoomData); ok {
+		return x.WaitingRoomData
+	}
+	return nil
+}
+
+func (x *NotifyMeetingExtData) GetMeetingLockData() *MeetingLockData {
+	if x, ok := x.GetMessageType().(*NotifyMeetingExtData_MeetingLockData); ok {
+		return x.MeetingLockData
+	}
+	return nil
+}
+
+func (x *NotifyMeetingExtData) GetMeetingRoleData() *MeetingRoleData {
+	if x, ok := x.GetMessageType().(*NotifyMeetingExtData_MeetingRoleData); ok {
+		return x.MeetingRoleData
+	}
+	return nil
+}
+
+func (x *NotifyMeetingExtData) GetSpeakingQueueData() *SpeakingQueueData {
+	if x, ok := x.GetMessageType().(*NotifyMeetingExtData_SpeakingQueueData); ok {
+		return x.SpeakingQueueData
+	}
+	return nil
+}
+
+func (x *NotifyMeetingExtData) GetMeetingRecordingData() *MeetingRecordingData {
+	if x, ok := x.GetMessageType().(*NotifyMeetingExtData_MeetingRecordingData); ok {
+		return x.MeetingRecordingData
+	}
+	return nil
+}
+
+func (x *NotifyMeetingExtData) GetMeetingLiveStreamData() *MeetingLiveStreamData {
+	if x, ok := x.GetMessageType().(*NotifyMeetingExtData_MeetingLiveStreamData); ok {
+		return x.MeetingLiveStreamData
+	}
+	return nil
+}
+
+func (x *NotifyMeetingExtData) GetMeetingBreakoutData() *MeetingBreakoutData {
+	if x, ok := x.GetMessageType().(*NotifyMeetingExtData_MeetingBreakoutData); ok {
+		return x.MeetingBreakoutData
+	}
+	return nil
+}
+
+func (x *NotifyMeetingExtData) GetBreakoutBroadcastData() *BreakoutBroadcastData {
+	if x, ok := x.GetMessageType().(*NotifyMeetingExtData_BreakoutBroadcastData); ok {
+		return x.BreakoutBroadcastData
+	}
+	return nil
+}
+
+type isNotifyMeetingExtData_MessageType interface {
+	isNotifyMeetingExtData_MessageType()
+}
+
+type NotifyMeetingExtData_WaitingRoomData struct {
+	WaitingRoomData *WaitingRoomData `protobuf:"bytes,2,opt,name=waitingRoomData,proto3,oneof"`
+}
+
+type NotifyMeetingExtData_MeetingLockData struct {
+	MeetingLockData *MeetingLockData `protobuf:"bytes,3,opt,name=meetingLockData,proto3,oneof"`
+}
+
+type NotifyMeetingExtData_MeetingRoleData struct {
+	MeetingRoleData *MeetingRoleData `protobuf:"bytes,4,opt,name=meetingRoleData,proto3,oneof"`
+}
+
+type NotifyMeetingExtData_SpeakingQueueData struct {
+	SpeakingQueueData *SpeakingQueueData `protobuf:"bytes,5,opt,name=speakingQueueData,proto3,oneof"` // Also sent on the "system" topic, where NotifyMeetingData skips the field.
+}
+
+type NotifyMeetingExtData_MeetingRecordingData struct {
+	MeetingRecordingData *MeetingRecordingData `protobuf:"bytes,6,opt,name=meetingRecordingData,proto3,oneof"`
+}
+
+type NotifyMeetingExtData_MeetingLiveStreamData struct {
+	MeetingLiveStreamData *MeetingLiveStreamData `protobuf:"bytes,7,opt,name=meetingLiveStreamData,proto3,oneof"`
+}
+
+type NotifyMeetingExtData_MeetingBreakoutData struct {
+	MeetingBreakoutData *MeetingBreakoutData `protobuf:"bytes,8,opt,name=meetingBreakoutData,proto3,oneof"`
+}
+
+type NotifyMeetingExtData_BreakoutBroadcastData struct {
+	BreakoutBroadcastData *BreakoutBroadcastData `protobuf:"bytes,9,opt,name=breakoutBroadcastData,proto3,oneof"`
+}
+
+func (*NotifyMeetingExtData_WaitingRoomData) isNotifyMeetingExtData_MessageType() {}
+
+func (*NotifyMeetingExtData_MeetingLockData) isNotifyMeetingExtData_MessageType() {}
+
+func (*NotifyMeetingExtData_MeetingRoleData) isNotifyMeetingExtData_MessageType() {}
+
+func (*NotifyMeetingExtData_SpeakingQueueData) isNotifyMeetingExtData_MessageType() {}
+
+func (*NotifyMeetingExtData_MeetingRecordingData) isNotifyMeetingExtData_MessageType() {}
+
+func (*NotifyMeetingExtData_MeetingLiveStreamData) isNotifyMeetingExtData_MessageType() {}
+
+func (*NotifyMeetingExtData_MeetingBreakoutData) isNotifyMeetingExtData_MessageType() {}
+
+func (*NotifyMeetingExtData_BreakoutBroadcastData) isNotifyMeetingExtData_MessageType() {}
+
+// Request to turn the waiting room of a meeting on or off.
+type SetMeetingWaitingRoomReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingID string `protobuf:"bytes,1,opt,name=meetingID,proto3" json:"meetingID"`
+	UserID    string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	Enable    bool   `protobuf:"varint,3,opt,name=enable,proto3" json:"enable"` // Turning it off admits all the users still waiting.
+}
+
+func (x *SetMeetingWaitingRoomReq) Reset() {
+	*x = SetMeetingWaitingRoomReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetMeetingWaitingRoomReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMeetingWaitingRoomReq) ProtoMessage() {}
+
+func (x *SetMeetingWaitingRoomReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMeetingWaitingRoomReq.ProtoReflect.Descriptor instead.
+func (*SetMeetingWaitingRoomReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SetMeetingWaitingRoomReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *SetMeetingWaitingRoomReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SetMeetingWaitingRoomReq) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+// Response after setting the waiting room.
+type SetMeetingWaitingRoomResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetMeetingWaitingRoomResp) Reset() {
+	*x = SetMeetingWaitingRoomResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetMeetingWaitingRoomResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMeetingWaitingRoomResp) ProtoMessage() {}
+
+func (x *SetMeetingWaitingRoomResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMeetingWaitingRoomResp.ProtoReflect.Descriptor instead.
+func (*SetMeetingWaitingRoomResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{42}
+}
+
+// Request of the hosts to get the waiting room of a meeting.
+type GetWaitingRoomReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingID string `protobuf:"bytes,1,opt,name=meetingID,proto3" json:"meetingID"`
+	UserID    string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *GetWaitingRoomReq) Reset() {
+	*x = GetWaitingRoomReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWaitingRoomReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWaitingRoomReq) ProtoMessage() {}
+
+func (x *GetWaitingRoomReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWaitingRoomReq.ProtoReflect.Descriptor instead.
+func (*GetWaitingRoomReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetWaitingRoomReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *GetWaitingRoomReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+// Response with the users in the waiting room ordered by their request time.
+type GetWaitingRoomResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enable bool           `protobuf:"varint,1,opt,name=enable,proto3" json:"enable"`
+	Users  []*WaitingUser `protobuf:"bytes,2,rep,name=users,proto3" json:"users"`
+}
+
+func (x *GetWaitingRoomResp) Reset() {
+	*x = GetWaitingRoomResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWaitingRoomResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWaitingRoomResp) ProtoMessage() {}
+
+func (x *GetWaitingRoomResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWaitingRoomResp.ProtoReflect.Descriptor instead.
+func (*GetWaitingRoomResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetWaitingRoomResp) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *GetWaitingRoomResp) GetUsers() []*WaitingUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+// Request to admit or deny users in the waiting room.
+type RespondWaitingUsersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingID      string   `protobuf:"bytes,1,opt,name=meetingID,proto3" json:"meetingID"`
+	UserID         string   `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	WaitingUserIDs []string `protobuf:"bytes,3,rep,name=waitingUserIDs,proto3" json:"waitingUserIDs"`
+	All            bool     `protobuf:"varint,4,opt,name=all,proto3" json:"all"` // All the users still waiting, waitingUserIDs is ignored.
+}
+
+func (x *RespondWaitingUsersReq) Reset() {
+	*x = RespondWaitingUsersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RespondWaitingUsersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondWaitingUsersReq) ProtoMessage() {}
+
+func (x *RespondWaitingUsersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondWaitingUsersReq.ProtoReflect.Descriptor instead.
+func (*RespondWaitingUsersReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RespondWaitingUsersReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *RespondWaitingUsersReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RespondWaitingUsersReq) GetWaitingUserIDs() []string {
+	if x != nil {
+		return x.WaitingUserIDs
+	}
+	return nil
+}
+
+func (x *RespondWaitingUsersReq) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+// Response with the users admitted or denied.
+type RespondWaitingUsersResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *RespondWaitingUsersResp) Reset() {
+	*x = RespondWaitingUsersResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RespondWaitingUsersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondWaitingUsersResp) ProtoMessage() {}
+
+func (x *RespondWaitingUsersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondWaitingUsersResp.ProtoReflect.Descriptor instead.
+func (*RespondWaitingUsersResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *RespondWaitingUsersResp) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+// Request of a waiting user to get the admission, it blocks for up to waitSeconds while the user is still waiting.
+type GetWaitingStatusReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingID   string `protobuf:"bytes,1,opt,name=meetingID,proto3" json:"meetingID"`
+	UserID      string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	WaitSeconds int32  `protobuf:"varint,3,opt,name=waitSeconds,proto3" json:"waitSeconds"` // 0 returns at once, at most 30.
+}
+
+func (x *GetWaitingStatusReq) Reset() {
+	*x = GetWaitingStatusReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWaitingStatusReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWaitingStatusReq) ProtoMessage() {}
+
+func (x *GetWaitingStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWaitingStatusReq.ProtoReflect.Descriptor instead.
+func (*GetWaitingStatusReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetWaitingStatusReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *GetWaitingStatusReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetWaitingStatusReq) GetWaitSeconds() int32 {
+	if x != nil {
+		return x.WaitSeconds
+	}
+	return 0
+}
+
+// Response with the status of the waiting user, and the token once the user is admitted.
+type GetWaitingStatusResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status  string           `protobuf:"bytes,1,opt,name=status,proto3" json:"status"`   // Waiting, Admitted or Denied.
+	LiveKit *meeting.LiveKit `protobuf:"bytes,2,opt,name=liveKit,proto3" json:"liveKit"` // Only set if admitted.
+}
+
+func (x *GetWaitingStatusResp) Reset() {
+	*x = GetWaitingStatusResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWaitingStatusResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWaitingStatusResp) ProtoMessage() {}
+
+func (x *GetWaitingStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWaitingStatusResp.ProtoReflect.Descriptor instead.
+func (*GetWaitingStatusResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetWaitingStatusResp) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetWaitingStatusResp) GetLiveKit() *meeting.LiveKit {
+	if x != nil {
+		return x.LiveKit
+	}
+	return nil
+}
+
+// Request to lock or unlock a meeting in progress, the lock is kept in lockMeeting of the meeting setting.
+type SetMeetingLockReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingID string `protobuf:"bytes,1,opt,name=meetingID,proto3" json:"meetingID"`
+	UserID    string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	Locked    bool   `protobuf:"varint,3,opt,name=locked,proto3" json:"locked"`
+}
+
+func (x *SetMeetingLockReq) Reset() {
+	*x = SetMeetingLockReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetMeetingLockReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMeetingLockReq) ProtoMessage() {}
+
+func (x *SetMeetingLockReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMeetingLockReq.ProtoReflect.Descriptor instead.
+func (*SetMeetingLockReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SetMeetingLockReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *SetMeetingLockReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SetMeetingLockReq) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+// Response after locking or unlocking the meeting.
+type SetMeetingLockResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetMeetingLockResp) Reset() {
+	*x = SetMeetingLockResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetMeetingLockResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMeetingLockResp) ProtoMessage() {}
+
+func (x *SetMeetingLockResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMeetingLockResp.ProtoReflect.Descriptor instead.
+func (*SetMeetingLockResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{50}
+}
+
+// Limits of a meeting, 0 means the server-wide limit.
+type MeetingLimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxParticipants int32 `protobuf:"varint,1,opt,name=maxParticipants,proto3" json:"maxParticipants"`
+	EmptyTimeout    int32 `protobuf:"varint,2,opt,name=emptyTimeout,proto3" json:"emptyTimeout"` // Seconds an empty room is kept before it is closed.
+	MaxDuration     int64 `protobuf:"varint,3,opt,name=maxDuration,proto3" json:"maxDuration"`   // Seconds the meeting lasts at most before it is ended automatically.
+}
+
+func (x *MeetingLimit) Reset() {
+	*x = MeetingLimit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeetingLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeetingLimit) ProtoMessage() {}
+
+func (x *MeetingLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeetingLimit.ProtoReflect.Descriptor instead.
+func (*MeetingLimit) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *MeetingLimit) GetMaxParticipants() int32 {
+	if x != nil {
+		return x.MaxParticipants
+	}
+	return 0
+}
+
+func (x *MeetingLimit) GetEmptyTimeout() int32 {
+	if x != nil {
+		return x.EmptyTimeout
+	}
+	return 0
+}
+
+func (x *MeetingLimit) GetMaxDuration() int64 {
+	if x != nil {
+		return x.MaxDuration
+	}
+	return 0
+}
+
+// Request to set the limits of a meeting, unset fields keep their value. The limits could not exceed the server-wide ones.
+type SetMeetingLimitReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingID       string                 `protobuf:"bytes,1,opt,name=meetingID,proto3" json:"meetingID"`
+	UserID          string                 `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	MaxParticipants *wrapperspb.Int32Value `protobuf:"bytes,3,opt,name=maxParticipants,proto3" json:"maxParticipants"`
+	EmptyTimeout    *wrapperspb.Int32Value `protobuf:"bytes,4,opt,name=emptyTimeout,proto3" json:"emptyTimeout"` // Applies to the room opened after the change.
+	MaxDuration     *wrapperspb.Int64Value `protobuf:"bytes,5,opt,name=maxDuration,proto3" json:"maxDuration"`
+}
+
+func (x *SetMeetingLimitReq) Reset() {
+	*x = SetMeetingLimitReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetMeetingLimitReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMeetingLimitReq) ProtoMessage() {}
+
+func (x *SetMeetingLimitReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMeetingLimitReq.ProtoReflect.Descriptor instead.
+func (*SetMeetingLimitReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SetMeetingLimitReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *SetMeetingLimitReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SetMeetingLimitReq) GetMaxParticipants() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MaxParticipants
+	}
+	return nil
+}
+
+func (x *SetMeetingLimitReq) GetEmptyTimeout() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.EmptyTimeout
+	}
+	return nil
+}
+
+func (x *SetMeetingLimitReq) GetMaxDuration() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.MaxDuration
+	}
+	return nil
+}
+
+// Response after setting the limits.
+type SetMeetingLimitResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetMeetingLimitResp) Reset() {
+	*x = SetMeetingLimitResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetMeetingLimitResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMeetingLimitResp) ProtoMessage() {}
+
+func (x *SetMeetingLimitResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMeetingLimitResp.ProtoReflect.Descriptor instead.
+func (*SetMeetingLimitResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{53}
+}
+
+// Request to get the limits of a meeting.
+type GetMeetingLimitReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingID string `protobuf:"bytes,1,opt,name=meetingID,proto3" json:"meetingID"`
+}
+
+func (x *GetMeetingLimitReq) Reset() {
+	*x = GetMeetingLimitReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMeetingLimitReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMeetingLimitReq) ProtoMessage() {}
+
+func (x *GetMeetingLimitReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMeetingLimitReq.ProtoReflect.Descriptor instead.
+func (*GetMeetingLimitReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetMeetingLimitReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+// Response with the limits set on the meeting and the ones in effect.
+// 0 of the effective maxParticipants or emptyTimeout means the default of the rtc server, 0 of maxDuration means no limit.
+type GetMeetingLimitResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit          *MeetingLimit `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit"`
+	EffectiveLimit *MeetingLimit `protobuf:"bytes,2,opt,name=effectiveLimit,proto3" json:"effectiveLimit"`
+}
+
+func (x *GetMeetingLimitResp) Reset() {
+	*x = GetMeetingLimitResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMeetingLimitResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMeetingLimitResp) ProtoMessage() {}
+
+func (x *GetMeetingLimitResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMeetingLimitResp.ProtoReflect.Descriptor instead.
+func (*GetMeetingLimitResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetMeetingLimitResp) GetLimit() *MeetingLimit {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+func (x *GetMeetingLimitResp) GetEffectiveLimit() *MeetingLimit {
+	if x != nil {
+		return x.EffectiveLimit
+	}
+	return nil
+}
+
+// State of the meeting kept in the room metadata under "ext", beside the fields of the published MeetingMetadata.
+type MeetingMetadataExt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Roles       map[string]string  `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // Presenter or Viewer keyed by the userID, the host and the co-hosts are kept in MeetingMetadata.
+	RaisedHands []*RaisedHand      `protobuf:"bytes,2,rep,name=raisedHands,proto3" json:"raisedHands"`                                                                                       // The speaking queue in the order the hands are raised.
+	LiveStream  *MeetingLiveStream `protobuf:"bytes,3,opt,name=liveStream,proto3" json:"liveStream"`                                                                                         // Nil if the meeting is not streamed.
+	Breakout    *MeetingBreakout   `protobuf:"bytes,4,opt,name=breakout,proto3" json:"breakout"`                                                                                             // Nil if the meeting is not split into breakout rooms.
+}
+
+func (x *MeetingMetadataExt) Reset() {
+	*x = MeetingMetadataExt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeetingMetadataExt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeetingMetadataExt) ProtoMessage() {}
+
+func (x *MeetingMetadataExt) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeetingMetadataExt.ProtoReflect.Descriptor instead.
+func (*MeetingMetadataExt) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *MeetingMetadataExt) GetRoles() map[string]string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *MeetingMetadataExt) GetRaisedHands() []*RaisedHand {
+	if x != nil {
+		return x.RaisedHands
+	}
+	return nil
+}
+
+func (x *MeetingMetadataExt) GetLiveStream() *MeetingLiveStream {
+	if x != nil {
+		return x.LiveStream
+	}
+	return nil
+}
+
+func (x *MeetingMetadataExt) GetBreakout() *MeetingBreakout {
+	if x != nil {
+		return x.Breakout
+	}
+	return nil
+}
+
+// A participant asking to speak.
+type RaisedHand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID    string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Nickname  string `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname"`
+	RaiseTime int64  `protobuf:"varint,3,opt,name=raiseTime,proto3" json:"raiseTime"`
+}
+
+func (x *RaisedHand) Reset() {
+	*x = RaisedHand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RaisedHand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RaisedHand) ProtoMessage() {}
+
+func (x *RaisedHand) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RaisedHand.ProtoReflect.Descriptor instead.
+func (*RaisedHand) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RaisedHand) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RaisedHand) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *RaisedHand) GetRaiseTime() int64 {
+	if x != nil {
+		return x.RaiseTime
+	}
+	return 0
+}
+
+// Notifies the participants of the speaking queue after it changed.
+type SpeakingQueueData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RaisedHands []*RaisedHand `protobuf:"bytes,1,rep,name=raisedHands,proto3" json:"raisedHands"` // The whole queue in order.
+}
+
+func (x *SpeakingQueueData) Reset() {
+	*x = SpeakingQueueData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpeakingQueueData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpeakingQueueData) ProtoMessage() {}
+
+func (x *SpeakingQueueData) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpeakingQueueData.ProtoReflect.Descriptor instead.
+func (*SpeakingQueueData) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SpeakingQueueData) GetRaisedHands() []*RaisedHand {
+	if x != nil {
+		return x.RaisedHands
+	}
+	return nil
+}
+
+// The role of a participant in the meeting.
+type MeetingParticipantRole struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Role   string `protobuf:"bytes,2,opt,name=role,proto3" json:"role"` // Host, CoHost, Presenter, Attendee or Viewer.
+}
+
+func (x *MeetingParticipantRole) Reset() {
+	*x = MeetingParticipantRole{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeetingParticipantRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeetingParticipantRole) ProtoMessage() {}
+
+func (x *MeetingParticipantRole) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeetingParticipantRole.ProtoReflect.Descriptor instead.
+func (*MeetingParticipantRole) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *MeetingParticipantRole) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *MeetingParticipantRole) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+// The permissions granted to a role.
+type MeetingRolePermission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Role        string   `protobuf:"bytes,1,opt,name=role,proto3" json:"role"`
+	Permissions []string `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions"` // EndMeeting, MuteOthers, Kick, Rename, ShareScreen, ManageRoles, AdmitLobby, LockMeeting, ManageMeeting, Record or LiveStream.
+}
+
+func (x *MeetingRolePermission) Reset() {
+	*x = MeetingRolePermission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeetingRolePermission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeetingRolePermission) ProtoMessage() {}
+
+func (x *MeetingRolePermission) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeetingRolePermission.ProtoReflect.Descriptor instead.
+func (*MeetingRolePermission) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *MeetingRolePermission) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *MeetingRolePermission) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+// Notifies the participants that the roles of some participants changed.
+type MeetingRoleData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Roles []*MeetingParticipantRole `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles"`
+}
+
+func (x *MeetingRoleData) Reset() {
+	*x = MeetingRoleData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeetingRoleData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeetingRoleData) ProtoMessage() {}
+
+func (x *MeetingRoleData) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeetingRoleData.ProtoReflect.Descriptor instead.
+func (*MeetingRoleData) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *MeetingRoleData) GetRoles() []*MeetingParticipantRole {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// Request to grant a role to participants of a meeting in progress. The host is handed over by SetMeetingHostInfo.
+type GrantMeetingRoleReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingID          string   `protobuf:"bytes,1,opt,name=meetingID,proto3" json:"meetingID"`
+	UserID             string   `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	ParticipantUserIDs []string `protobuf:"bytes,3,rep,name=participantUserIDs,proto3" json:"participantUserIDs"`
+	Role               string   `protobuf:"bytes,4,opt,name=role,proto3" json:"role"` // CoHost, Presenter, Attendee or Viewer.
+}
+
+func (x *GrantMeetingRoleReq) Reset() {
+	*x = GrantMeetingRoleReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrantMeetingRoleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantMeetingRoleReq) ProtoMessage() {}
+
+func (x *GrantMeetingRoleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantMeetingRoleReq.ProtoReflect.Descriptor instead.
+func (*GrantMeetingRoleReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GrantMeetingRoleReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *GrantMeetingRoleReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GrantMeetingRoleReq) GetParticipantUserIDs() []string {
+	if x != nil {
+		return x.ParticipantUserIDs
+	}
+	return nil
+}
+
+func (x *GrantMeetingRoleReq) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+// Response after granting the role.
+type GrantMeetingRoleResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GrantMeetingRoleResp) Reset() {
+	*x = GrantMeetingRoleResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrantMeetingRoleResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantMeetingRoleResp) ProtoMessage() {}
+
+func (x *GrantMeetingRoleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantMeetingRoleResp.ProtoReflect.Descriptor instead.
+func (*GrantMeetingRoleResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{63}
+}
+
+// Request to revoke the roles of participants, who become attendees.
+type RevokeMeetingRoleReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingID          string   `protobuf:"bytes,1,opt,name=meetingID,proto3" json:"meetingID"`
+	UserID             string   `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	ParticipantUserIDs []string `protobuf:"bytes,3,rep,name=participantUserIDs,proto3" json:"participantUserIDs"`
+}
+
+func (x *RevokeMeetingRoleReq) Reset() {
+	*x = RevokeMeetingRoleReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeMeetingRoleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeMeetingRoleReq) ProtoMessage() {}
+
+func (x *RevokeMeetingRoleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeMeetingRoleReq.ProtoReflect.Descriptor instead.
+func (*RevokeMeetingRoleReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *RevokeMeetingRoleReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *RevokeMeetingRoleReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RevokeMeetingRoleReq) GetParticipantUserIDs() []string {
+	if x != nil {
+		return x.ParticipantUserIDs
+	}
+	return nil
+}
+
+// Response after revoking the roles.
+type RevokeMeetingRoleResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RevokeMeetingRoleResp) Reset() {
+	*x = RevokeMeetingRoleResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeMeetingRoleResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeMeetingRoleResp) ProtoMessage() {}
+
+func (x *RevokeMeetingRoleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeMeetingRoleResp.ProtoReflect.Descriptor instead.
+func (*RevokeMeetingRoleResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{65}
+}
+
+// Request to get the roles in a meeting in progress.
+type GetMeetingRolesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingID string `protobuf:"bytes,1,opt,name=meetingID,proto3" json:"meetingID"`
+	UserID    string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *GetMeetingRolesReq) Reset() {
+	*x = GetMeetingRolesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMeetingRolesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMeetingRolesReq) ProtoMessage() {}
+
+func (x *GetMeetingRolesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMeetingRolesReq.ProtoReflect.Descriptor instead.
+func (*GetMeetingRolesReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetMeetingRolesReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *GetMeetingRolesReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+// Response with the roles of the participants, the permission matrix and the permissions of the requesting user.
+type GetMeetingRolesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Roles       []*MeetingParticipantRole `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles"`
+	Matrix      []*MeetingRolePermission  `protobuf:"bytes,2,rep,name=matrix,proto3" json:"matrix"`
+	Permissions []string                  `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions"`
+}
+
+func (x *GetMeetingRolesResp) Reset() {
+	*x = GetMeetingRolesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMeetingRolesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMeetingRolesResp) ProtoMessage() {}
+
+func (x *GetMeetingRolesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMeetingRolesResp.ProtoReflect.Descriptor instead.
+func (*GetMeetingRolesResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetMeetingRolesResp) GetRoles() []*MeetingParticipantRole {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *GetMeetingRolesResp) GetMatrix() []*MeetingRolePermission {
+	if x != nil {
+		return x.Matrix
+	}
+	return nil
+}
+
+func (x *GetMeetingRolesResp) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+// Request to raise the hand of the user in a meeting in progress.
+type RaiseHandReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingID string `protobuf:"bytes,1,opt,name=meetingID,proto3" json:"meetingID"`
+	UserID    string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *RaiseHandReq) Reset() {
+	*x = RaiseHandReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RaiseHandReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RaiseHandReq) ProtoMessage() {}
+
+func (x *RaiseHandReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RaiseHandReq.ProtoReflect.Descriptor instead.
+func (*RaiseHandReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RaiseHandReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *RaiseHandReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+// Response with the position of the user in the speaking queue, starting from 1.
+type RaiseHandResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Position int32 `protobuf:"varint,1,opt,name=position,proto3" json:"position"`
+}
+
+func (x *RaiseHandResp) Reset() {
+	*x = RaiseHandResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RaiseHandResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RaiseHandResp) ProtoMessage() {}
+
+func (x *RaiseHandResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RaiseHandResp.ProtoReflect.Descriptor instead.
+func (*RaiseHandResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *RaiseHandResp) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+// Request to lower a raised hand.
+type LowerHandReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingID         string `protobuf:"bytes,1,opt,name=meetingID,proto3" json:"meetingID"`
+	UserID            string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	ParticipantUserID string `protobuf:"bytes,3,opt,name=participantUserID,proto3" json:"participantUserID"` // The hosts could lower the hand of others, empty for the user's own hand.
+}
+
+func (x *LowerHandReq) Reset() {
+	*x = LowerHandReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LowerHandReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LowerHandReq) ProtoMessage() {}
+
+func (x *LowerHandReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LowerHandReq.ProtoReflect.Descriptor instead.
+func (*LowerHandReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *LowerHandReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *LowerHandReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *LowerHandReq) GetParticipantUserID() string {
+	if x != nil {
+		return x.ParticipantUserID
+	}
+	return ""
+}
+
+// Response after lowering the hand.
+type LowerHandResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LowerHandResp) Reset() {
+	*x = LowerHandResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LowerHandResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LowerHandResp) ProtoMessage() {}
+
+func (x *LowerHandResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LowerHandResp.ProtoReflect.Descriptor instead.
+func (*LowerHandResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{71}
+}
+
+// Request to let a participant in the speaking queue speak, the microphone limit of the participant is lifted.
+type ApproveRaisedHandReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingID         string `protobuf:"bytes,1,opt,name=meetingID,proto3" json:"meetingID"`
+	UserID            string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	ParticipantUserID string `protobuf:"bytes,3,opt,name=participantUserID,proto3" json:"participantUserID"`
+}
+
+func (x *ApproveRaisedHandReq) Reset() {
+	*x = ApproveRaisedHandReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveRaisedHandReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveRaisedHandReq) ProtoMessage() {}
+
+func (x *ApproveRaisedHandReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveRaisedHandReq.ProtoReflect.Descriptor instead.
+func (*ApproveRaisedHandReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ApproveRaisedHandReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *ApproveRaisedHandReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ApproveRaisedHandReq) GetParticipantUserID() string {
+	if x != nil {
+		return x.ParticipantUserID
+	}
+	return ""
+}
+
+// Response after approving the raised hand.
+type ApproveRaisedHandResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ApproveRaisedHandResp) Reset() {
+	*x = ApproveRaisedHandResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveRaisedHandResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveRaisedHandResp) ProtoMessage() {}
+
+func (x *ApproveRaisedHandResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveRaisedHandResp.ProtoReflect.Descriptor instead.
+func (*ApproveRaisedHandResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{73}
+}
+
+// Request to clear the speaking queue.
+type ClearRaisedHandsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingID string `protobuf:"bytes,1,opt,name=meetingID,proto3" json:"meetingID"`
+	UserID    string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *ClearRaisedHandsReq) Reset() {
+	*x = ClearRaisedHandsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearRaisedHandsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearRaisedHandsReq) ProtoMessage() {}
+
+func (x *ClearRaisedHandsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearRaisedHandsReq.ProtoReflect.Descriptor instead.
+func (*ClearRaisedHandsReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ClearRaisedHandsReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *ClearRaisedHandsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+// Response after clearing the speaking queue.
+type ClearRaisedHandsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ClearRaisedHandsResp) Reset() {
+	*x = ClearRaisedHandsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearRaisedHandsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearRaisedHandsResp) ProtoMessage() {}
+
+func (x *ClearRaisedHandsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearRaisedHandsResp.ProtoReflect.Descriptor instead.
+func (*ClearRaisedHandsResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{75}
+}
+
+// A chat message in the meeting, sent to the participants on the "chat" data topic.
+type MeetingChatMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageID    string `protobuf:"bytes,1,opt,name=messageID,proto3" json:"messageID"`
+	MeetingID    string `protobuf:"bytes,2,opt,name=meetingID,proto3" json:"meetingID"`
+	OccurrenceID string `protobuf:"bytes,3,opt,name=occurrenceID,proto3" json:"occurrenceID"`
+	SendUserID   string `protobuf:"bytes,4,opt,name=sendUserID,proto3" json:"sendUserID"`
+	SendNickname string `protobuf:"bytes,5,opt,name=sendNickname,proto3" json:"sendNickname"` // The nickname in the meeting when sending.
+	RecvType     string `protobuf:"bytes,6,opt,name=recvType,proto3" json:"recvType"`         // Public, Private or Hosts.
+	RecvUserID   string `protobuf:"bytes,7,opt,name=recvUserID,proto3" json:"recvUserID"`     // The receiver of the private message.
+	Content      string `protobuf:"bytes,8,opt,name=content,proto3" json:"content"`
+	SendTime     int64  `protobuf:"varint,9,opt,name=sendTime,proto3" json:"sendTime"` // Milliseconds.
+}
+
+func (x *MeetingChatMessage) Reset() {
+	*x = MeetingChatMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeetingChatMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeetingChatMessage) ProtoMessage() {}
+
+func (x *MeetingChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeetingChatMessage.ProtoReflect.Descriptor instead.
+func (*MeetingChatMessage) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *MeetingChatMessage) GetMessageID() string {
+	if x != nil {
+		return x.MessageID
+	}
+	return ""
+}
+
+func (x *MeetingChatMessage) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *MeetingChatMessage) GetOccurrenceID() string {
+	if x != nil {
+		return x.OccurrenceID
+	}
+	return ""
+}
+
+func (x *MeetingChatMessage) GetSendUserID() string {
+	if x != nil {
+		return x.SendUserID
+	}
+	return ""
+}
+
+func (x *MeetingChatMessage) GetSendNickname() string {
+	if x != nil {
+		return x.SendNickname
+	}
+	return ""
+}
+
+func (x *MeetingChatMessage) GetRecvType() string {
+	if x != nil {
+		return x.RecvType
+	}
+	return ""
+}
+
+func (x *MeetingChatMessage) GetRecvUserID() string {
+	if x != nil {
+		return x.RecvUserID
+	}
+	return ""
+}
+
+func (x *MeetingChatMessage) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *MeetingChatMessage) GetSendTime() int64 {
+	if x != nil {
+		return x.SendTime
+	}
+	return 0
+}
+
+// Request to send a chat message in a meeting in progress.
+type SendMeetingChatMessageReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingID  string `protobuf:"bytes,1,opt,name=meetingID,proto3" json:"meetingID"`
+	UserID     string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	RecvType   string `protobuf:"bytes,3,opt,name=recvType,proto3" json:"recvType"`     // Public, Private or Hosts.
+	RecvUserID string `protobuf:"bytes,4,opt,name=recvUserID,proto3" json:"recvUserID"` // Required by the private message.
+	Content    string `protobuf:"bytes,5,opt,name=content,proto3" json:"content"`
+}
+
+func (x *SendMeetingChatMessageReq) Reset() {
+	*x = SendMeetingChatMessageReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendMeetingChatMessageReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMeetingChatMessageReq) ProtoMessage() {}
+
+func (x *SendMeetingChatMessageReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMeetingChatMessageReq.ProtoReflect.Descriptor instead.
+func (*SendMeetingChatMessageReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *SendMeetingChatMessageReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *SendMeetingChatMessageReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SendMeetingChatMessageReq) GetRecvType() string {
+	if x != nil {
+		return x.RecvType
+	}
+	return ""
+}
+
+func (x *SendMeetingChatMessageReq) GetRecvUserID() string {
+	if x != nil {
+		return x.RecvUserID
+	}
+	return ""
+}
+
+func (x *SendMeetingChatMessageReq) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// Response with the message sent.
+type SendMeetingChatMessageResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *MeetingChatMessage `protobuf:"bytes,1,opt,name=message,proto3" json:"message"`
+}
+
+func (x *SendMeetingChatMessageResp) Reset() {
+	*x = SendMeetingChatMessageResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendMeetingChatMessageResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMeetingChatMessageResp) ProtoMessage() {}
+
+func (x *SendMeetingChatMessageResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMeetingChatMessageResp.ProtoReflect.Descriptor instead.
+func (*SendMeetingChatMessageResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *SendMeetingChatMessageResp) GetMessage() *MeetingChatMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+// Request to get the chat history visible to the user, during or after the meeting.
+type GetMeetingChatMessagesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingID    string                   `protobuf:"bytes,1,opt,name=meetingID,proto3" json:"meetingID"`
+	UserID       string                   `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	OccurrenceID string                   `protobuf:"bytes,3,opt,name=occurrenceID,proto3" json:"occurrenceID"` // Messages of all the occurrences if empty.
+	Pagination   *sdkws.RequestPagination `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *GetMeetingChatMessagesReq) Reset() {
+	*x = GetMeetingChatMessagesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMeetingChatMessagesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMeetingChatMessagesReq) ProtoMessage() {}
+
+func (x *GetMeetingChatMessagesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMeetingChatMessagesReq.ProtoReflect.Descriptor instead.
+func (*GetMeetingChatMessagesReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetMeetingChatMessagesReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *GetMeetingChatMessagesReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetMeetingChatMessagesReq) GetOccurrenceID() string {
+	if x != nil {
+		return x.OccurrenceID
+	}
+	return ""
+}
+
+func (x *GetMeetingChatMessagesReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// Response with a page of the chat history, the newest first.
+type GetMeetingChatMessagesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total    int64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Messages []*MeetingChatMessage `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages"`
+}
+
+func (x *GetMeetingChatMessagesResp) Reset() {
+	*x = GetMeetingChatMessagesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMeetingChatMessagesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMeetingChatMessagesResp) ProtoMessage() {}
+
+func (x *GetMeetingChatMessagesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMeetingChatMessagesResp.ProtoReflect.Descriptor instead.
+func (*GetMeetingChatMessagesResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetMeetingChatMessagesResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetMeetingChatMessagesResp) GetMessages() []*MeetingChatMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+// A file of a cloud recording, available after the recording completes.
+type MeetingRecordingFile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename"`
+	Location string `protobuf:"bytes,2,opt,name=location,proto3" json:"location"`
+	Size     int64  `protobuf:"varint,3,opt,name=size,proto3" json:"size"`         // Bytes.
+	Duration int64  `protobuf:"varint,4,opt,name=duration,proto3" json:"duration"` // Seconds.
+}
+
+func (x *MeetingRecordingFile) Reset() {
+	*x = MeetingRecordingFile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeetingRecordingFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeetingRecordingFile) ProtoMessage() {}
+
+func (x *MeetingRecordingFile) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeetingRecordingFile.ProtoReflect.Descriptor instead.
+func (*MeetingRecordingFile) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *MeetingRecordingFile) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *MeetingRecordingFile) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *MeetingRecordingFile) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *MeetingRecordingFile) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+// A cloud recording of the meeting room.
+type MeetingRecording struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecordingID  string                  `protobuf:"bytes,1,opt,name=recordingID,proto3" json:"recordingID"`
+	MeetingID    string                  `protobuf:"bytes,2,opt,name=meetingID,proto3" json:"meetingID"`
+	OccurrenceID string                  `protobuf:"bytes,3,opt,name=occurrenceID,proto3" json:"occurrenceID"`
+	StartUserID  string                  `protobuf:"bytes,4,opt,name=startUserID,proto3" json:"startUserID"`
+	StopUserID   string                  `protobuf:"bytes,5,opt,name=stopUserID,proto3" json:"stopUserID"` // Empty if the recording is not stopped by a user, e.g., the meeting ended.
+	Status       string                  `protobuf:"bytes,6,opt,name=status,proto3" json:"status"`         // Starting, Active, Ending, Complete or Failed.
+	StartTime    int64                   `protobuf:"varint,7,opt,name=startTime,proto3" json:"startTime"`
+	EndTime      int64                   `protobuf:"varint,8,opt,name=endTime,proto3" json:"endTime"` // 0 if the recording is in progress.
+	Error        string                  `protobuf:"bytes,9,opt,name=error,proto3" json:"error"`
+	Files        []*MeetingRecordingFile `protobuf:"bytes,10,rep,name=files,proto3" json:"files"`
+}
+
+func (x *MeetingRecording) Reset() {
+	*x = MeetingRecording{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeetingRecording) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeetingRecording) ProtoMessage() {}
+
+func (x *MeetingRecording) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeetingRecording.ProtoReflect.Descriptor instead.
+func (*MeetingRecording) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *MeetingRecording) GetRecordingID() string {
+	if x != nil {
+		return x.RecordingID
+	}
+	return ""
+}
+
+func (x *MeetingRecording) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *MeetingRecording) GetOccurrenceID() string {
+	if x != nil {
+		return x.OccurrenceID
+	}
+	return ""
+}
+
+func (x *MeetingRecording) GetStartUserID() string {
+	if x != nil {
+		return x.StartUserID
+	}
+	return ""
+}
+
+func (x *MeetingRecording) GetStopUserID() string {
+	if x != nil {
+		return x.StopUserID
+	}
+	return ""
+}
+
+func (x *MeetingRecording) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *MeetingRecording) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *MeetingRecording) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *MeetingRecording) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *MeetingRecording) GetFiles() []*MeetingRecordingFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+// Notifies the participants that a recording started or stopped.
+type MeetingRecordingData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Recording *MeetingRecording `protobuf:"bytes,1,opt,name=recording,proto3" json:"recording"`
+}
+
+func (x *MeetingRecordingData) Reset() {
+	*x = MeetingRecordingData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeetingRecordingData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeetingRecordingData) ProtoMessage() {}
+
+func (x *MeetingRecordingData) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeetingRecordingData.ProtoReflect.Descriptor instead.
+func (*MeetingRecordingData) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *MeetingRecordingData) GetRecording() *MeetingRecording {
+	if x != nil {
+		return x.Recording
+	}
+	return nil
+}
+
+// Request to start recording a meeting in progress.
+type StartMeetingRecordingReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingID string `protobuf:"bytes,1,opt,name=meetingID,proto3" json:"meetingID"`
+	UserID    string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *StartMeetingRecordingReq) Reset() {
+	*x = StartMeetingRecordingReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartMeetingRecordingReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartMeetingRecordingReq) ProtoMessage() {}
+
+func (x *StartMeetingRecordingReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartMeetingRecordingReq.ProtoReflect.Descriptor instead.
+func (*StartMeetingRecordingReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *StartMeetingRecordingReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *StartMeetingRecordingReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+// Response with the recording started.
+type StartMeetingRecordingResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Recording *MeetingRecording `protobuf:"bytes,1,opt,name=recording,proto3" json:"recording"`
+}
+
+func (x *StartMeetingRecordingResp) Reset() {
+	*x = StartMeetingRecordingResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartMeetingRecordingResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartMeetingRecordingResp) ProtoMessage() {}
+
+func (x *StartMeetingRecordingResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartMeetingRecordingResp.ProtoReflect.Descriptor instead.
+func (*StartMeetingRecordingResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *StartMeetingRecordingResp) GetRecording() *MeetingRecording {
+	if x != nil {
+		return x.Recording
+	}
+	return nil
+}
+
+// Request to stop the recordings of a meeting in progress.
+type StopMeetingRecordingReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingID   string `protobuf:"bytes,1,opt,name=meetingID,proto3" json:"meetingID"`
+	UserID      string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	RecordingID string `protobuf:"bytes,3,opt,name=recordingID,proto3" json:"recordingID"` // All the recordings in progress if empty.
+}
+
+func (x *StopMeetingRecordingReq) Reset() {
+	*x = StopMeetingRecordingReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopMeetingRecordingReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopMeetingRecordingReq) ProtoMessage() {}
+
+func (x *StopMeetingRecordingReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopMeetingRecordingReq.ProtoReflect.Descriptor instead.
+func (*StopMeetingRecordingReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *StopMeetingRecordingReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *StopMeetingRecordingReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *StopMeetingRecordingReq) GetRecordingID() string {
+	if x != nil {
+		return x.RecordingID
+	}
+	return ""
+}
+
+// Response with the recordings stopped, the files are available after they complete.
+type StopMeetingRecordingResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Recordings []*MeetingRecording `protobuf:"bytes,1,rep,name=recordings,proto3" json:"recordings"`
+}
+
+func (x *StopMeetingRecordingResp) Reset() {
+	*x = StopMeetingRecordingResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopMeetingRecordingResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopMeetingRecordingResp) ProtoMessage() {}
+
+func (x *StopMeetingRecordingResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopMeetingRecordingResp.ProtoReflect.Descriptor instead.
+func (*StopMeetingRecordingResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *StopMeetingRecordingResp) GetRecordings() []*MeetingRecording {
+	if x != nil {
+		return x.Recordings
+	}
+	return nil
+}
+
+// Request to get the recordings of a meeting.
+type GetMeetingRecordingsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingID    string                   `protobuf:"bytes,1,opt,name=meetingID,proto3" json:"meetingID"`
+	UserID       string                   `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	OccurrenceID string                   `protobuf:"bytes,3,opt,name=occurrenceID,proto3" json:"occurrenceID"` // Recordings of all the occurrences if empty.
+	Pagination   *sdkws.RequestPagination `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *GetMeetingRecordingsReq) Reset() {
+	*x = GetMeetingRecordingsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMeetingRecordingsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMeetingRecordingsReq) ProtoMessage() {}
+
+func (x *GetMeetingRecordingsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMeetingRecordingsReq.ProtoReflect.Descriptor instead.
+func (*GetMeetingRecordingsReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetMeetingRecordingsReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *GetMeetingRecordingsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetMeetingRecordingsReq) GetOccurrenceID() string {
+	if x != nil {
+		return x.OccurrenceID
+	}
+	return ""
+}
+
+func (x *GetMeetingRecordingsReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// Response with a page of the recordings, the newest first.
+type GetMeetingRecordingsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total      int64               `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Recordings []*MeetingRecording `protobuf:"bytes,2,rep,name=recordings,proto3" json:"recordings"`
+}
+
+func (x *GetMeetingRecordingsResp) Reset() {
+	*x = GetMeetingRecordingsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMeetingRecordingsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMeetingRecordingsResp) ProtoMessage() {}
+
+func (x *GetMeetingRecordingsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMeetingRecordingsResp.ProtoReflect.Descriptor instead.
+func (*GetMeetingRecordingsResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GetMeetingRecordingsResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetMeetingRecordingsResp) GetRecordings() []*MeetingRecording {
+	if x != nil {
+		return x.Recordings
+	}
+	return nil
+}
+
+// An RTMP output of the live stream, the stream key is not kept.
+type MeetingStreamOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OutputID  string `protobuf:"bytes,1,opt,name=outputID,proto3" json:"outputID"` // Identifies the url of the output.
+	Url       string `protobuf:"bytes,2,opt,name=url,proto3" json:"url"`           // The url with the stream key masked.
+	AddUserID string `protobuf:"bytes,3,opt,name=addUserID,proto3" json:"addUserID"`
+	AddTime   int64  `protobuf:"varint,4,opt,name=addTime,proto3" json:"addTime"`
+}
+
+func (x *MeetingStreamOutput) Reset() {
+	*x = MeetingStreamOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeetingStreamOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeetingStreamOutput) ProtoMessage() {}
+
+func (x *MeetingStreamOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeetingStreamOutput.ProtoReflect.Descriptor instead.
+func (*MeetingStreamOutput) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *MeetingStreamOutput) GetOutputID() string {
+	if x != nil {
+		return x.OutputID
+	}
+	return ""
+}
+
+func (x *MeetingStreamOutput) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *MeetingStreamOutput) GetAddUserID() string {
+	if x != nil {
+		return x.AddUserID
+	}
+	return ""
+}
+
+func (x *MeetingStreamOutput) GetAddTime() int64 {
+	if x != nil {
+		return x.AddTime
+	}
+	return 0
+}
+
+// The live stream of the meeting room pushed to the RTMP outputs.
+type MeetingLiveStream struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamID    string                 `protobuf:"bytes,1,opt,name=streamID,proto3" json:"streamID"`
+	Status      string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status"` // Starting, Active, Ending, Complete or Failed.
+	Error       string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error"`
+	StartUserID string                 `protobuf:"bytes,4,opt,name=startUserID,proto3" json:"startUserID"`
+	StartTime   int64                  `protobuf:"varint,5,opt,name=startTime,proto3" json:"startTime"`
+	Outputs     []*MeetingStreamOutput `protobuf:"bytes,6,rep,name=outputs,proto3" json:"outputs"`
+}
+
+func (x *MeetingLiveStream) Reset() {
+	*x = MeetingLiveStream{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeetingLiveStream) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeetingLiveStream) ProtoMessage() {}
+
+func (x *MeetingLiveStream) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeetingLiveStream.ProtoReflect.Descriptor instead.
+func (*MeetingLiveStream) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *MeetingLiveStream) GetStreamID() string {
+	if x != nil {
+		return x.StreamID
+	}
+	return ""
+}
+
+func (x *MeetingLiveStream) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *MeetingLiveStream) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *MeetingLiveStream) GetStartUserID() string {
+	if x != nil {
+		return x.StartUserID
+	}
+	return ""
+}
+
+func (x *MeetingLiveStream) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *MeetingLiveStream) GetOutputs() []*MeetingStreamOutput {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+// Notifies the participants that the live stream changed, the stream ended if its status is Complete or Failed.
+type MeetingLiveStreamData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LiveStream *MeetingLiveStream `protobuf:"bytes,1,opt,name=liveStream,proto3" json:"liveStream"`
+}
+
+func (x *MeetingLiveStreamData) Reset() {
+	*x = MeetingLiveStreamData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeetingLiveStreamData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeetingLiveStreamData) ProtoMessage() {}
+
+func (x *MeetingLiveStreamData) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeetingLiveStreamData.ProtoReflect.Descriptor instead.
+func (*MeetingLiveStreamData) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *MeetingLiveStreamData) GetLiveStream() *MeetingLiveStream {
+	if x != nil {
+		return x.LiveStream
+	}
+	return nil
+}
+
+// Request to push a meeting in progress to RTMP urls, the live stream starts with the first outputs.
+type AddMeetingStreamOutputsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingID string   `protobuf:"bytes,1,opt,name=meetingID,proto3" json:"meetingID"`
+	UserID    string   `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	Urls      []string `protobuf:"bytes,3,rep,name=urls,proto3" json:"urls"` // rtmp:// or rtmps:// urls with the stream keys.
+}
+
+func (x *AddMeetingStreamOutputsReq) Reset() {
+	*x = AddMeetingStreamOutputsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddMeetingStreamOutputsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMeetingStreamOutputsReq) ProtoMessage() {}
+
+func (x *AddMeetingStreamOutputsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMeetingStreamOutputsReq.ProtoReflect.Descriptor instead.
+func (*AddMeetingStreamOutputsReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *AddMeetingStreamOutputsReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *AddMeetingStreamOutputsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *AddMeetingStreamOutputsReq) GetUrls() []string {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+// Response with the live stream.
+type AddMeetingStreamOutputsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LiveStream *MeetingLiveStream `protobuf:"bytes,1,opt,name=liveStream,proto3" json:"liveStream"`
+}
+
+func (x *AddMeetingStreamOutputsResp) Reset() {
+	*x = AddMeetingStreamOutputsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddMeetingStreamOutputsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMeetingStreamOutputsResp) ProtoMessage() {}
+
+func (x *AddMeetingStreamOutputsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMeetingStreamOutputsResp.ProtoReflect.Descriptor instead.
+func (*AddMeetingStreamOutputsResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *AddMeetingStreamOutputsResp) GetLiveStream() *MeetingLiveStream {
+	if x != nil {
+		return x.LiveStream
+	}
+	return nil
+}
+
+// Request to stop pushing a meeting in progress to RTMP urls, the live stream stops without outputs.
+type RemoveMeetingStreamOutputsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingID string   `protobuf:"bytes,1,opt,name=meetingID,proto3" json:"meetingID"`
+	UserID    string   `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	Urls      []string `protobuf:"bytes,3,rep,name=urls,proto3" json:"urls"` // The urls as they are added, all the outputs if empty.
+}
+
+func (x *RemoveMeetingStreamOutputsReq) Reset() {
+	*x = RemoveMeetingStreamOutputsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveMeetingStreamOutputsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMeetingStreamOutputsReq) ProtoMessage() {}
+
+func (x *RemoveMeetingStreamOutputsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMeetingStreamOutputsReq.ProtoReflect.Descriptor instead.
+func (*RemoveMeetingStreamOutputsReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *RemoveMeetingStreamOutputsReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *RemoveMeetingStreamOutputsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RemoveMeetingStreamOutputsReq) GetUrls() []string {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+// Response with the live stream, nil if it stopped.
+type RemoveMeetingStreamOutputsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LiveStream *MeetingLiveStream `protobuf:"bytes,1,opt,name=liveStream,proto3" json:"liveStream"`
+}
+
+func (x *RemoveMeetingStreamOutputsResp) Reset() {
+	*x = RemoveMeetingStreamOutputsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveMeetingStreamOutputsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMeetingStreamOutputsResp) ProtoMessage() {}
+
+func (x *RemoveMeetingStreamOutputsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMeetingStreamOutputsResp.ProtoReflect.Descriptor instead.
+func (*RemoveMeetingStreamOutputsResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *RemoveMeetingStreamOutputsResp) GetLiveStream() *MeetingLiveStream {
+	if x != nil {
+		return x.LiveStream
+	}
+	return nil
+}
+
+// Request to get the dial-in of a meeting.
+type GetMeetingDialInReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingID string `protobuf:"bytes,1,opt,name=meetingID,proto3" json:"meetingID"`
+	UserID    string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *GetMeetingDialInReq) Reset() {
+	*x = GetMeetingDialInReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMeetingDialInReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMeetingDialInReq) ProtoMessage() {}
+
+func (x *GetMeetingDialInReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMeetingDialInReq.ProtoReflect.Descriptor instead.
+func (*GetMeetingDialInReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *GetMeetingDialInReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *GetMeetingDialInReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+// Response with the phone numbers and the PIN the callers enter to join the meeting.
+type GetMeetingDialInResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Numbers []string `protobuf:"bytes,1,rep,name=numbers,proto3" json:"numbers"`
+	Pin     string   `protobuf:"bytes,2,opt,name=pin,proto3" json:"pin"`
+}
+
+func (x *GetMeetingDialInResp) Reset() {
+	*x = GetMeetingDialInResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMeetingDialInResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMeetingDialInResp) ProtoMessage() {}
+
+func (x *GetMeetingDialInResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMeetingDialInResp.ProtoReflect.Descriptor instead.
+func (*GetMeetingDialInResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *GetMeetingDialInResp) GetNumbers() []string {
+	if x != nil {
+		return x.Numbers
+	}
+	return nil
+}
+
+func (x *GetMeetingDialInResp) GetPin() string {
+	if x != nil {
+		return x.Pin
+	}
+	return ""
+}
+
+// A breakout room of the meeting, a child room of the meeting room.
+type BreakoutRoom struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BreakoutID string   `protobuf:"bytes,1,opt,name=breakoutID,proto3" json:"breakoutID"`
+	Name       string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	RoomID     string   `protobuf:"bytes,3,opt,name=roomID,proto3" json:"roomID"`   // The rtc room to join with the token of JoinBreakoutRoom.
+	UserIDs    []string `protobuf:"bytes,4,rep,name=userIDs,proto3" json:"userIDs"` // The assigned participants, or the ones who selected the room in the SelfSelect mode.
+}
+
+func (x *BreakoutRoom) Reset() {
+	*x = BreakoutRoom{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BreakoutRoom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BreakoutRoom) ProtoMessage() {}
+
+func (x *BreakoutRoom) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BreakoutRoom.ProtoReflect.Descriptor instead.
+func (*BreakoutRoom) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *BreakoutRoom) GetBreakoutID() string {
+	if x != nil {
+		return x.BreakoutID
+	}
+	return ""
+}
+
+func (x *BreakoutRoom) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BreakoutRoom) GetRoomID() string {
+	if x != nil {
+		return x.RoomID
+	}
+	return ""
+}
+
+func (x *BreakoutRoom) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+// The breakout rooms the meeting in progress is split into.
+type MeetingBreakout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode        string          `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode"` // Manual, Random or SelfSelect.
+	Rooms       []*BreakoutRoom `protobuf:"bytes,2,rep,name=rooms,proto3" json:"rooms"`
+	StartUserID string          `protobuf:"bytes,3,opt,name=startUserID,proto3" json:"startUserID"`
+	StartTime   int64           `protobuf:"varint,4,opt,name=startTime,proto3" json:"startTime"`
+	EndTime     int64           `protobuf:"varint,5,opt,name=endTime,proto3" json:"endTime"` // Everyone is pulled back to the main room at the time, 0 if there is no timer.
+}
+
+func (x *MeetingBreakout) Reset() {
+	*x = MeetingBreakout{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeetingBreakout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeetingBreakout) ProtoMessage() {}
+
+func (x *MeetingBreakout) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeetingBreakout.ProtoReflect.Descriptor instead.
+func (*MeetingBreakout) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *MeetingBreakout) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *MeetingBreakout) GetRooms() []*BreakoutRoom {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+func (x *MeetingBreakout) GetStartUserID() string {
+	if x != nil {
+		return x.StartUserID
+	}
+	return ""
+}
+
+func (x *MeetingBreakout) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *MeetingBreakout) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+// Notifies the participants of the meeting room and the breakout rooms that the breakout rooms changed.
+type MeetingBreakoutData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Breakout *MeetingBreakout `protobuf:"bytes,1,opt,name=breakout,proto3" json:"breakout"`
+	Closed   bool             `protobuf:"varint,2,opt,name=closed,proto3" json:"closed"` // The breakout rooms are closed, the participants return to the main room.
+}
+
+func (x *MeetingBreakoutData) Reset() {
+	*x = MeetingBreakoutData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeetingBreakoutData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeetingBreakoutData) ProtoMessage() {}
+
+func (x *MeetingBreakoutData) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeetingBreakoutData.ProtoReflect.Descriptor instead.
+func (*MeetingBreakoutData) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *MeetingBreakoutData) GetBreakout() *MeetingBreakout {
+	if x != nil {
+		return x.Breakout
+	}
+	return nil
+}
+
+func (x *MeetingBreakoutData) GetClosed() bool {
+	if x != nil {
+		return x.Closed
+	}
+	return false
+}
+
+// A message the host broadcasts to all the breakout rooms.
+type BreakoutBroadcastData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message"`
+}
+
+func (x *BreakoutBroadcastData) Reset() {
+	*x = BreakoutBroadcastData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BreakoutBroadcastData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BreakoutBroadcastData) ProtoMessage() {}
+
+func (x *BreakoutBroadcastData) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BreakoutBroadcastData.ProtoReflect.Descriptor instead.
+func (*BreakoutBroadcastData) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *BreakoutBroadcastData) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Request to split a meeting in progress into breakout rooms.
+type StartBreakoutRoomsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingID string          `protobuf:"bytes,1,opt,name=meetingID,proto3" json:"meetingID"`
+	UserID    string          `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	Mode      string          `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode"`          // Manual, Random or SelfSelect.
+	Count     int32           `protobuf:"varint,4,opt,name=count,proto3" json:"count"`       // The number of the rooms in the Random and the SelfSelect modes.
+	Rooms     []*BreakoutRoom `protobuf:"bytes,5,rep,name=rooms,proto3" json:"rooms"`        // The names and the assigned userIDs in the Manual mode, optional names in the other modes.
+	Duration  int64           `protobuf:"varint,6,opt,name=duration,proto3" json:"duration"` // Seconds before everyone is pulled back to the main room, 0 for no timer.
+}
+
+func (x *StartBreakoutRoomsReq) Reset() {
+	*x = StartBreakoutRoomsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartBreakoutRoomsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartBreakoutRoomsReq) ProtoMessage() {}
+
+func (x *StartBreakoutRoomsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartBreakoutRoomsReq.ProtoReflect.Descriptor instead.
+func (*StartBreakoutRoomsReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *StartBreakoutRoomsReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *StartBreakoutRoomsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *StartBreakoutRoomsReq) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *StartBreakoutRoomsReq) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *StartBreakoutRoomsReq) GetRooms() []*BreakoutRoom {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+func (x *StartBreakoutRoomsReq) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+// Response with the breakout rooms.
+type StartBreakoutRoomsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Breakout *MeetingBreakout `protobuf:"bytes,1,opt,name=breakout,proto3" json:"breakout"`
+}
+
+func (x *StartBreakoutRoomsResp) Reset() {
+	*x = StartBreakoutRoomsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartBreakoutRoomsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartBreakoutRoomsResp) ProtoMessage() {}
+
+func (x *StartBreakoutRoomsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartBreakoutRoomsResp.ProtoReflect.Descriptor instead.
+func (*StartBreakoutRoomsResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *StartBreakoutRoomsResp) GetBreakout() *MeetingBreakout {
+	if x != nil {
+		return x.Breakout
+	}
+	return nil
+}
+
+// Request to move participants to a breakout room.
+type AssignBreakoutRoomReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingID          string   `protobuf:"bytes,1,opt,name=meetingID,proto3" json:"meetingID"`
+	UserID             string   `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	BreakoutID         string   `protobuf:"bytes,3,opt,name=breakoutID,proto3" json:"breakoutID"` // Unassigns the participants if empty.
+	ParticipantUserIDs []string `protobuf:"bytes,4,rep,name=participantUserIDs,proto3" json:"participantUserIDs"`
+}
+
+func (x *AssignBreakoutRoomReq) Reset() {
+	*x = AssignBreakoutRoomReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssignBreakoutRoomReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignBreakoutRoomReq) ProtoMessage() {}
+
+func (x *AssignBreakoutRoomReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignBreakoutRoomReq.ProtoReflect.Descriptor instead.
+func (*AssignBreakoutRoomReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *AssignBreakoutRoomReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *AssignBreakoutRoomReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *AssignBreakoutRoomReq) GetBreakoutID() string {
+	if x != nil {
+		return x.BreakoutID
+	}
+	return ""
+}
+
+func (x *AssignBreakoutRoomReq) GetParticipantUserIDs() []string {
+	if x != nil {
+		return x.ParticipantUserIDs
+	}
+	return nil
+}
+
+// Response with the breakout rooms.
+type AssignBreakoutRoomResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Breakout *MeetingBreakout `protobuf:"bytes,1,opt,name=breakout,proto3" json:"breakout"`
+}
+
+func (x *AssignBreakoutRoomResp) Reset() {
+	*x = AssignBreakoutRoomResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssignBreakoutRoomResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignBreakoutRoomResp) ProtoMessage() {}
+
+func (x *AssignBreakoutRoomResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignBreakoutRoomResp.ProtoReflect.Descriptor instead.
+func (*AssignBreakoutRoomResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *AssignBreakoutRoomResp) GetBreakout() *MeetingBreakout {
+	if x != nil {
+		return x.Breakout
+	}
+	return nil
+}
+
+// Request to join a breakout room of a meeting.
+type JoinBreakoutRoomReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingID  string `protobuf:"bytes,1,opt,name=meetingID,proto3" json:"meetingID"`
+	UserID     string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	BreakoutID string `protobuf:"bytes,3,opt,name=breakoutID,proto3" json:"breakoutID"` // Only the assigned room unless in the SelfSelect mode, the hosts join any room.
+}
+
+func (x *JoinBreakoutRoomReq) Reset() {
+	*x = JoinBreakoutRoomReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinBreakoutRoomReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinBreakoutRoomReq) ProtoMessage() {}
+
+func (x *JoinBreakoutRoomReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinBreakoutRoomReq.ProtoReflect.Descriptor instead.
+func (*JoinBreakoutRoomReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *JoinBreakoutRoomReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *JoinBreakoutRoomReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *JoinBreakoutRoomReq) GetBreakoutID() string {
+	if x != nil {
+		return x.BreakoutID
+	}
+	return ""
+}
+
+// Response with the token to join the breakout room.
+type JoinBreakoutRoomResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomID  string `protobuf:"bytes,1,opt,name=roomID,proto3" json:"roomID"`
+	Token   string `protobuf:"bytes,2,opt,name=token,proto3" json:"token"`
+	LiveURL string `protobuf:"bytes,3,opt,name=liveURL,proto3" json:"liveURL"`
+}
+
+func (x *JoinBreakoutRoomResp) Reset() {
+	*x = JoinBreakoutRoomResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinBreakoutRoomResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinBreakoutRoomResp) ProtoMessage() {}
+
+func (x *JoinBreakoutRoomResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinBreakoutRoomResp.ProtoReflect.Descriptor instead.
+func (*JoinBreakoutRoomResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *JoinBreakoutRoomResp) GetRoomID() string {
+	if x != nil {
+		return x.RoomID
+	}
+	return ""
+}
+
+func (x *JoinBreakoutRoomResp) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *JoinBreakoutRoomResp) GetLiveURL() string {
+	if x != nil {
+		return x.LiveURL
+	}
+	return ""
+}
+
+// Request to broadcast a message to all the breakout rooms of a meeting.
+type BroadcastBreakoutMessageReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingID string `protobuf:"bytes,1,opt,name=meetingID,proto3" json:"meetingID"`
+	UserID    string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	Message   string `protobuf:"bytes,3,opt,name=message,proto3" json:"message"`
+}
+
+func (x *BroadcastBreakoutMessageReq) Reset() {
+	*x = BroadcastBreakoutMessageReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BroadcastBreakoutMessageReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastBreakoutMessageReq) ProtoMessage() {}
+
+func (x *BroadcastBreakoutMessageReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastBreakoutMessageReq.ProtoReflect.Descriptor instead.
+func (*BroadcastBreakoutMessageReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *BroadcastBreakoutMessageReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *BroadcastBreakoutMessageReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *BroadcastBreakoutMessageReq) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Response after broadcasting the message.
+type BroadcastBreakoutMessageResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BroadcastBreakoutMessageResp) Reset() {
+	*x = BroadcastBreakoutMessageResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BroadcastBreakoutMessageResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastBreakoutMessageResp) ProtoMessage() {}
+
+func (x *BroadcastBreakoutMessageResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastBreakoutMessageResp.ProtoReflect.Descriptor instead.
+func (*BroadcastBreakoutMessageResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{110}
+}
+
+// Request to close the breakout rooms of a meeting and pull everyone back to the main room.
+type CloseBreakoutRoomsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingID string `protobuf:"bytes,1,opt,name=meetingID,proto3" json:"meetingID"`
+	UserID    string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *CloseBreakoutRoomsReq) Reset() {
+	*x = CloseBreakoutRoomsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseBreakoutRoomsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseBreakoutRoomsReq) ProtoMessage() {}
+
+func (x *CloseBreakoutRoomsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseBreakoutRoomsReq.ProtoReflect.Descriptor instead.
+func (*CloseBreakoutRoomsReq) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *CloseBreakoutRoomsReq) GetMeetingID() string {
+	if x != nil {
+		return x.MeetingID
+	}
+	return ""
+}
+
+func (x *CloseBreakoutRoomsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+// Response after closing the breakout rooms.
+type CloseBreakoutRoomsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CloseBreakoutRoomsResp) Reset() {
+	*x = CloseBreakoutRoomsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meetingext_meetingext_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseBreakoutRoomsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseBreakoutRoomsResp) ProtoMessage() {}
+
+func (x *CloseBreakoutRoomsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_meetingext_meetingext_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseBreakoutRoomsResp.ProtoReflect.Descriptor instead.
+func (*CloseBreakoutRoomsResp) Descriptor() ([]byte, []int) {
+	return file_meetingext_meetingext_proto_rawDescGZIP(), []int{112}
+}
+
+var File_meetingext_meetingext_proto protoreflect.FileDescriptor
+
+var file_meetingext_meetingext_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2f, 0x6d, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x6f,
+	0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x65, 0x78, 0x74, 0x1a, 0x1b, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x70,
+	0x62, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x21, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2f,
+	0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2f, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2f, 0x73, 0x64, 0x6b,
+	0x77, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbe, 0x03, 0x0a, 0x11, 0x4d, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x22,
+	0x0a, 0x0c, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44,
+	0x12, 0x2a, 0x0a, 0x10, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x45, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x61,
+	0x63, 0x74, 0x75, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x24,
+	0x0a, 0x0d, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x45, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2e, 0x0a, 0x12,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x4d, 0x0a, 0x0d,
+	0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x6d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x2c, 0x0a, 0x11, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xa0, 0x01, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c,
+	0x0a, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x68, 0x0a, 0x19,
+	0x47, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4b, 0x0a, 0x0b, 0x6f, 0x63, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x4f,
+	0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x43, 0x0a, 0x11, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72,
+	0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x72, 0x75, 0x6c,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x44, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x03, 0x52, 0x07, 0x65, 0x78, 0x44, 0x61, 0x74, 0x65, 0x73, 0x22, 0xa3, 0x01, 0x0a, 0x17,
+	0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x12, 0x3d, 0x0a, 0x07, 0x6d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x42,
+	0x6f, 0x6f, 0x6b, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x52, 0x07, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x49, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x65, 0x78, 0x74, 0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x22, 0xad, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x12, 0x26,
+	0x0a, 0x0e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x49, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x65, 0x78, 0x74, 0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x22, 0x1d, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x37, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x22, 0x65, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x49, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65,
+	0x78, 0x74, 0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x22, 0x88, 0x03, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12,
+	0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x12, 0x26, 0x0a,
+	0x0e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x11, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x11, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74,
+	0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x45, 0x0a, 0x0f, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x6d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x38, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x68, 0x0a, 0x1b, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x63, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x49, 0x0a, 0x0a, 0x6f, 0x63,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x4f,
+	0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x80, 0x01, 0x0a, 0x1a, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x11, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x1d, 0x0a, 0x1b, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x4e, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65,
+	0x71, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x35, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x22, 0x47,
+	0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65,
+	0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x22, 0x38, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x65, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x32, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
+	0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x64, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x65, 0x65, 0x64,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x31, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x22, 0xca, 0x01, 0x0a, 0x0e, 0x4d, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x77, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x4d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c,
+	0x0a, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x18,
+	0x0a, 0x16, 0x41, 0x64, 0x64, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x7a, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x73, 0x22, 0x1b, 0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x6f, 0x0a, 0x1b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x4d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1e, 0x0a, 0x1c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x4d, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x4d, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x22, 0x5c, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x42, 0x0a, 0x08, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x73, 0x22,
+	0xa7, 0x01, 0x0a, 0x0e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x12, 0x4d, 0x0a, 0x0d, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x0d, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x12, 0x46, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x52, 0x0a, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x65, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73,
+	0x22, 0x5c, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x64, 0x4d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x42, 0x0a, 0x08, 0x6d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x4b,
+	0x0a, 0x17, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x4b, 0x69, 0x74, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x1a, 0x0a, 0x18, 0x48,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x4b, 0x69, 0x74, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x22, 0xae, 0x01, 0x0a, 0x10, 0x41, 0x74, 0x74, 0x65,
+	0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x22, 0x0a, 0x0c,
+	0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x44,
+	0x12, 0x1a, 0x0a, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x6c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x65,
+	0x61, 0x76, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xfb, 0x01, 0x0a, 0x0f, 0x4d, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4a,
+	0x6f, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d,
+	0x6c, 0x61, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x42, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x41, 0x74, 0x74,
+	0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x73, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x63, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f,
+	0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x22, 0x61, 0x0a, 0x18, 0x47,
+	0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x45, 0x0a, 0x09, 0x61, 0x74, 0x74, 0x65, 0x6e,
+	0x64, 0x65, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x65, 0x78, 0x74, 0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x74, 0x65, 0x6e,
+	0x64, 0x65, 0x65, 0x52, 0x09, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x65, 0x73, 0x22, 0xc7,
+	0x01, 0x0a, 0x0b, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x26, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x4c, 0x0a, 0x0f, 0x57, 0x61, 0x69, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x12, 0x39, 0x0a, 0x05, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x65, 0x78, 0x74, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x29, 0x0a, 0x0f, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x4c, 0x6f, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x22, 0xba, 0x06, 0x0a, 0x14, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x45, 0x78, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x26, 0x0a, 0x0e, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x53, 0x0a, 0x0f, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6f,
+	0x6d, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x65, 0x78, 0x74, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6f, 0x6d,
+	0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0f, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x6f, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x12, 0x53, 0x0a, 0x0f, 0x6d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x4c, 0x6f, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x4c, 0x6f, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0f, 0x6d, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x4c, 0x6f, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x12, 0x53, 0x0a, 0x0f,
+	0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00,
+	0x52, 0x0f, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6c, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x59, 0x0a, 0x11, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x44, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x70, 0x65, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x11, 0x73, 0x70, 0x65, 0x61, 0x6b,
+	0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x75, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x62, 0x0a, 0x14,
+	0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67,
+	0x44, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x65, 0x78, 0x74, 0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x14, 0x6d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x65, 0x0a, 0x15, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x76, 0x65, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x4c, 0x69, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00,
+	0x52, 0x15, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x76, 0x65, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x12, 0x5f, 0x0a, 0x13, 0x6d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x48, 0x00, 0x52, 0x13, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x72, 0x65, 0x61,
+	0x6b, 0x6f, 0x75, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x65, 0x0a, 0x15, 0x62, 0x72, 0x65, 0x61,
+	0x6b, 0x6f, 0x75, 0x74, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74,
+	0x2e, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x15, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x6f,
+	0x75, 0x74, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x42,
+	0x0d, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x68,
+	0x0a, 0x18, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x57, 0x61, 0x69, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x4d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6f,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x22, 0x49, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x57, 0x61, 0x69, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x22, 0x67, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x6f,
+	0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x39,
+	0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x16, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x64, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x77, 0x61,
+	0x69, 0x74, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0e, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x03, 0x61, 0x6c, 0x6c, 0x22, 0x33, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x57,
+	0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x6d, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x61, 0x69, 0x74, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x77, 0x61, 0x69,
+	0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x66, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x57,
+	0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x36, 0x0a, 0x07, 0x6c, 0x69, 0x76, 0x65,
+	0x4b, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x4c, 0x69, 0x76, 0x65, 0x4b, 0x69, 0x74, 0x52, 0x07, 0x6c, 0x69, 0x76, 0x65, 0x4b, 0x69, 0x74,
+	0x22, 0x61, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x22, 0x7e, 0x0a, 0x0c, 0x4d, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x61, 0x78,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x61,
+	0x78, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x91, 0x02, 0x0a, 0x12, 0x53, 0x65,
+	0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71,
+	0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x45, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x6d, 0x61,
+	0x78, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x3f, 0x0a,
+	0x0c, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x0c, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x3d,
+	0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x0b, 0x6d, 0x61, 0x78, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x15, 0x0a,
+	0x13, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x32, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x22, 0x9f, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x3a, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x4c, 0x0a, 0x0e,
+	0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x0e, 0x65, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xf1, 0x02, 0x0a, 0x12, 0x4d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x78,
+	0x74, 0x12, 0x4b, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x35, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x78, 0x74, 0x2e, 0x52, 0x6f, 0x6c,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x44,
+	0x0a, 0x0b, 0x72, 0x61, 0x69, 0x73, 0x65, 0x64, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x61, 0x69,
+	0x73, 0x65, 0x64, 0x48, 0x61, 0x6e, 0x64, 0x52, 0x0b, 0x72, 0x61, 0x69, 0x73, 0x65, 0x64, 0x48,
+	0x61, 0x6e, 0x64, 0x73, 0x12, 0x49, 0x0a, 0x0a, 0x6c, 0x69, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78,
+	0x74, 0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x76, 0x65, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x0a, 0x6c, 0x69, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12,
+	0x43, 0x0a, 0x08, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x08, 0x62, 0x72, 0x65, 0x61,
+	0x6b, 0x6f, 0x75, 0x74, 0x1a, 0x38, 0x0a, 0x0a, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5e,
+	0x0a, 0x0a, 0x52, 0x61, 0x69, 0x73, 0x65, 0x64, 0x48, 0x61, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x61, 0x69, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x61, 0x69, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x59,
+	0x0a, 0x11, 0x53, 0x70, 0x65, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x75, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x44, 0x0a, 0x0b, 0x72, 0x61, 0x69, 0x73, 0x65, 0x64, 0x48, 0x61, 0x6e,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78,
+	0x74, 0x2e, 0x52, 0x61, 0x69, 0x73, 0x65, 0x64, 0x48, 0x61, 0x6e, 0x64, 0x52, 0x0b, 0x72, 0x61,
+	0x69, 0x73, 0x65, 0x64, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x22, 0x44, 0x0a, 0x16, 0x4d, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52,
+	0x6f, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22,
+	0x4d, 0x0a, 0x15, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6c, 0x65, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x57,
+	0x0a, 0x0f, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6c, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x44, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65,
+	0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x13, 0x47, 0x72, 0x61, 0x6e,
+	0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12,
+	0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x12, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x12, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x7c, 0x0a, 0x14, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x2e, 0x0a, 0x12, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22,
+	0x17, 0x0a, 0x15, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x4a, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c,
+	0x0a, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x22, 0xc4, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x44, 0x0a, 0x05,
+	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x6c,
+	0x65, 0x73, 0x12, 0x45, 0x0a, 0x06, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6c, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x06, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
+	0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x44, 0x0a, 0x0c, 0x52,
+	0x61, 0x69, 0x73, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x22, 0x2b, 0x0a, 0x0d, 0x52, 0x61, 0x69, 0x73, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x72,
+	0x0a, 0x0c, 0x4c, 0x6f, 0x77, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12, 0x1c,
+	0x0a, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x11, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x22, 0x0f, 0x0a, 0x0d, 0x4c, 0x6f, 0x77, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x7a, 0x0a, 0x14, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x61,
+	0x69, 0x73, 0x65, 0x64, 0x48, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x2c, 0x0a, 0x11, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22,
+	0x17, 0x0a, 0x15, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x61, 0x69, 0x73, 0x65, 0x64,
+	0x48, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x4b, 0x0a, 0x13, 0x43, 0x6c, 0x65, 0x61,
+	0x72, 0x52, 0x61, 0x69, 0x73, 0x65, 0x64, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x16, 0x0a, 0x14, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x61,
+	0x69, 0x73, 0x65, 0x64, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0xaa, 0x02,
+	0x0a, 0x12, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44,
+	0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x6e, 0x64, 0x4e, 0x69, 0x63, 0x6b,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x6e, 0x64,
+	0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x76,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x76,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x76, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x76, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xa7, 0x01, 0x0a, 0x19, 0x53,
+	0x65, 0x6e, 0x64, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x63, 0x76, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x65, 0x63, 0x76, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65,
+	0x63, 0x76, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x72, 0x65, 0x63, 0x76, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x22, 0x62, 0x0a, 0x1a, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x44, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xb6, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c,
+	0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x44,
+	0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64,
+	0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x7a, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x43,
+	0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x46, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74,
+	0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x7e, 0x0a,
+	0x14, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e,
+	0x67, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xe2, 0x02,
+	0x0a, 0x10, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69,
+	0x6e, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69,
+	0x6e, 0x67, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x6f, 0x70,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74,
+	0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x42,
+	0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x22, 0x5e, 0x0a, 0x14, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x12, 0x46, 0x0a, 0x09, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69,
+	0x6e, 0x67, 0x22, 0x50, 0x0a, 0x18, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x12, 0x1c,
+	0x0a, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x22, 0x63, 0x0a, 0x19, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x46, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x09,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x71, 0x0a, 0x17, 0x53, 0x74, 0x6f,
+	0x70, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x22, 0x64, 0x0a, 0x18,
+	0x53, 0x74, 0x6f, 0x70, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x48, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e,
+	0x67, 0x73, 0x22, 0xb4, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c,
+	0x0a, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x63, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x7a, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x48, 0x0a, 0x0a, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x7b, 0x0a, 0x13, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64,
+	0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x64, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0xe4, 0x01, 0x0a, 0x11, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69,
+	0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x45, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x22, 0x62, 0x0a, 0x15, 0x4d, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x49, 0x0a, 0x0a, 0x6c, 0x69, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e,
+	0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x0a, 0x6c, 0x69, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x22, 0x66, 0x0a,
+	0x1a, 0x41, 0x64, 0x64, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x75, 0x72, 0x6c, 0x73, 0x22, 0x68, 0x0a, 0x1b, 0x41, 0x64, 0x64, 0x4d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x49, 0x0a, 0x0a, 0x6c, 0x69, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78,
+	0x74, 0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x76, 0x65, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x0a, 0x6c, 0x69, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x22,
+	0x69, 0x0a, 0x1d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x22, 0x6b, 0x0a, 0x1e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x49, 0x0a, 0x0a,
+	0x6c, 0x69, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x4c, 0x69, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x0a, 0x6c, 0x69, 0x76,
+	0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x22, 0x4b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x1c,
+	0x0a, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x22, 0x42, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x44, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x69, 0x6e, 0x22, 0x74, 0x0a, 0x0c, 0x42, 0x72, 0x65, 0x61,
+	0x6b, 0x6f, 0x75, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x72, 0x65, 0x61,
+	0x6b, 0x6f, 0x75, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x72,
+	0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f,
+	0x6f, 0x6d, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0xbb,
+	0x01, 0x0a, 0x0f, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f,
+	0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x42,
+	0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x05, 0x72, 0x6f, 0x6f,
+	0x6d, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x72, 0x0a, 0x13,
+	0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x43, 0x0a, 0x08, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x08,
+	0x62, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x6f, 0x73,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64,
+	0x22, 0x31, 0x0a, 0x15, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x42, 0x72, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0xcf, 0x01, 0x0a, 0x15, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x72, 0x65,
+	0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a,
+	0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3a, 0x0a,
+	0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x6f,
+	0x6f, 0x6d, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5d, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x72,
+	0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x43, 0x0a, 0x08, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x08, 0x62, 0x72, 0x65, 0x61,
+	0x6b, 0x6f, 0x75, 0x74, 0x22, 0x9d, 0x01, 0x0a, 0x15, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x42,
+	0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x1c,
+	0x0a, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x6f,
+	0x75, 0x74, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x12, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x12, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x73, 0x22, 0x5d, 0x0a, 0x16, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x42, 0x72,
+	0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x12, 0x43,
+	0x0a, 0x08, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x08, 0x62, 0x72, 0x65, 0x61, 0x6b,
+	0x6f, 0x75, 0x74, 0x22, 0x6b, 0x0a, 0x13, 0x4a, 0x6f, 0x69, 0x6e, 0x42, 0x72, 0x65, 0x61, 0x6b,
+	0x6f, 0x75, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x49, 0x44, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x49, 0x44,
+	0x22, 0x5e, 0x0a, 0x14, 0x4a, 0x6f, 0x69, 0x6e, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74,
+	0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6f, 0x6d,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x69, 0x76, 0x65, 0x55, 0x52,
+	0x4c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x69, 0x76, 0x65, 0x55, 0x52, 0x4c,
+	0x22, 0x6d, 0x0a, 0x1b, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x42, 0x72, 0x65,
+	0x61, 0x6b, 0x6f, 0x75, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12,
+	0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x1e, 0x0a, 0x1c, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x42, 0x72, 0x65, 0x61,
+	0x6b, 0x6f, 0x75, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x4d, 0x0a, 0x15, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74,
+	0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x18,
+	0x0a, 0x16, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52,
+	0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x32, 0xf6, 0x28, 0x0a, 0x11, 0x4d, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7c,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x63, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x30, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x63, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x31, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65,
+	0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x63, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6d, 0x0a, 0x14,
+	0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x12, 0x2f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x42, 0x6f,
+	0x6f, 0x6b, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x42, 0x6f, 0x6f, 0x6b,
+	0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x82, 0x01, 0x0a, 0x17,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x33, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x65, 0x78, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x79, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x30, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65,
+	0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x82, 0x01, 0x0a, 0x17,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x63, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x63,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x33, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x65, 0x78, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x82, 0x01, 0x0a, 0x17, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x1a, 0x33, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x76, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x49, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x2e, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x49, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x2f, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x49, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x79, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x30, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43,
+	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x12, 0x2a, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
+	0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x73, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x4d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x73, 0x12, 0x2d, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x65, 0x78, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2e, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65,
+	0x78, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x7c, 0x0a, 0x15, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x65, 0x73, 0x12, 0x30, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x31, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x85, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x64, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x64, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x34, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65,
+	0x78, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x73, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x65, 0x73, 0x12, 0x2d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47,
+	0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x2e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x73, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x64, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2d, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x64, 0x4d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2e, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65,
+	0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x79, 0x0a, 0x14, 0x48, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x4b, 0x69, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x12, 0x2f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x4c, 0x69, 0x76, 0x65, 0x4b, 0x69, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52,
+	0x65, 0x71, 0x1a, 0x30, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x48, 0x61, 0x6e, 0x64,
+	0x6c, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x4b, 0x69, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x79, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2f, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x30, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x7c, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x57, 0x61, 0x69,
+	0x74, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x30, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78,
+	0x74, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x57, 0x61, 0x69, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x1a, 0x31, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x57, 0x61,
+	0x69, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x12, 0x67, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6f, 0x6d, 0x12,
+	0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x61, 0x69, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x1a, 0x2a, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x6f,
+	0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x12, 0x74, 0x0a, 0x11, 0x41, 0x64, 0x6d, 0x69, 0x74, 0x57,
+	0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x57, 0x61, 0x69, 0x74,
+	0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2f, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x57, 0x61, 0x69, 0x74,
+	0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x73, 0x0a, 0x10,
+	0x44, 0x65, 0x6e, 0x79, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x12, 0x2e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x64, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x1a, 0x2f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x64, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x6d, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47,
+	0x65, 0x74, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x2c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x57,
+	0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x67, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x6f,
+	0x63, 0x6b, 0x12, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x4d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x2a, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6a, 0x0a, 0x0f, 0x53, 0x65, 0x74,
+	0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2a, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78,
+	0x74, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x6d, 0x0a, 0x10, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x2b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47,
+	0x72, 0x61, 0x6e, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x1a, 0x2c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x72, 0x61, 0x6e,
+	0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x70, 0x0a, 0x11, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x2c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x6a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47,
+	0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x2b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x58,
+	0x0a, 0x09, 0x52, 0x61, 0x69, 0x73, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x12, 0x24, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x61, 0x69, 0x73, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x52, 0x65,
+	0x71, 0x1a, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x61, 0x69, 0x73, 0x65,
+	0x48, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x58, 0x0a, 0x09, 0x4c, 0x6f, 0x77, 0x65,
+	0x72, 0x48, 0x61, 0x6e, 0x64, 0x12, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4c,
+	0x6f, 0x77, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x65, 0x78, 0x74, 0x2e, 0x4c, 0x6f, 0x77, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x70, 0x0a, 0x11, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x61, 0x69,
+	0x73, 0x65, 0x64, 0x48, 0x61, 0x6e, 0x64, 0x12, 0x2c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74,
+	0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x61, 0x69, 0x73, 0x65, 0x64, 0x48, 0x61,
+	0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x61, 0x69, 0x73, 0x65, 0x64, 0x48, 0x61, 0x6e, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x6d, 0x0a, 0x10, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x61, 0x69,
+	0x73, 0x65, 0x64, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x2b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78,
+	0x74, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x61, 0x69, 0x73, 0x65, 0x64, 0x48, 0x61, 0x6e,
+	0x64, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x43,
+	0x6c, 0x65, 0x61, 0x72, 0x52, 0x61, 0x69, 0x73, 0x65, 0x64, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x7f, 0x0a, 0x16, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x1a, 0x32, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x7f, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x31,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x32, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x7c, 0x0a, 0x15, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x30,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x1a, 0x31, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x79, 0x0a, 0x14, 0x53, 0x74, 0x6f, 0x70, 0x4d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x2f, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x30, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x79,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e,
+	0x47, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x30, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x82, 0x01, 0x0a, 0x17, 0x41, 0x64,
+	0x64, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x32, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x41,
+	0x64, 0x64, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x33, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65,
+	0x78, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x8b,
+	0x01, 0x0a, 0x1a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x35, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x36, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6d, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x61, 0x6c, 0x49, 0x6e,
+	0x12, 0x2b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x2c, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x44, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x73, 0x0a, 0x12, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x6f, 0x6f, 0x6d,
+	0x73, 0x12, 0x2d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x71,
+	0x1a, 0x2e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42,
+	0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x73, 0x0a, 0x12, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f,
+	0x75, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x2d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x6f,
+	0x6f, 0x6d, 0x52, 0x65, 0x71, 0x1a, 0x2e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x6f, 0x6f,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6d, 0x0a, 0x10, 0x4a, 0x6f, 0x69, 0x6e, 0x42, 0x72, 0x65,
+	0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x2b, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65,
+	0x78, 0x74, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52,
+	0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x1a, 0x2c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e,
+	0x4a, 0x6f, 0x69, 0x6e, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x6f, 0x6f, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x85, 0x01, 0x0a, 0x18, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x73, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x33, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64,
+	0x63, 0x61, 0x73, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x34, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e,
+	0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x73, 0x0a, 0x12,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x6f, 0x6f,
+	0x6d, 0x73, 0x12, 0x2d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x2e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x73, 0x64, 0x6b, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x65, 0x78, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_meetingext_meetingext_proto_rawDescOnce sync.Once
+	file_meetingext_meetingext_proto_rawDescData = file_meetingext_meetingext_proto_rawDesc
+)
+
+func file_meetingext_meetingext_proto_rawDescGZIP() []byte {
+	file_meetingext_meetingext_proto_rawDescOnce.Do(func() {
+		file_meetingext_meetingext_proto_rawDescData = protoimpl.X.CompressGZIP(file_meetingext_meetingext_proto_rawDescData)
+	})
+	return file_meetingext_meetingext_proto_rawDescData
+}
+
+var file_meetingext_meetingext_proto_msgTypes = make([]protoimpl.MessageInfo, 114)
+var file_meetingext_meetingext_proto_goTypes = []interface{}{
+	(*MeetingOccurrence)(nil),              // 0: openmeeting.meetingext.MeetingOccurrence
+	(*GetMeetingOccurrencesReq)(nil),       // 1: openmeeting.meetingext.GetMeetingOccurrencesReq
+	(*GetMeetingOccurrencesResp)(nil),      // 2: openmeeting.meetingext.GetMeetingOccurrencesResp
+	(*MeetingRecurrence)(nil),              // 3: openmeeting.meetingext.MeetingRecurrence
+	(*BookRecurringMeetingReq)(nil),        // 4: openmeeting.meetingext.BookRecurringMeetingReq
+	(*UpdateMeetingRecurrenceReq)(nil),     // 5: openmeeting.meetingext.UpdateMeetingRecurrenceReq
+	(*UpdateMeetingRecurrenceResp)(nil),    // 6: openmeeting.meetingext.UpdateMeetingRecurrenceResp
+	(*GetMeetingRecurrenceReq)(nil),        // 7: openmeeting.meetingext.GetMeetingRecurrenceReq
+	(*GetMeetingRecurrenceResp)(nil),       // 8: openmeeting.meetingext.GetMeetingRecurrenceResp
+	(*UpdateMeetingOccurrenceReq)(nil),     // 9: openmeeting.meetingext.UpdateMeetingOccurrenceReq
+	(*UpdateMeetingOccurrenceResp)(nil),    // 10: openmeeting.meetingext.UpdateMeetingOccurrenceResp
+	(*CancelMeetingOccurrenceReq)(nil),     // 11: openmeeting.meetingext.CancelMeetingOccurrenceReq
+	(*CancelMeetingOccurrenceResp)(nil),    // 12: openmeeting.meetingext.CancelMeetingOccurrenceResp
+	(*GetMeetingICalendarReq)(nil),         // 13: openmeeting.meetingext.GetMeetingICalendarReq
+	(*GetMeetingICalendarResp)(nil),        // 14: openmeeting.meetingext.GetMeetingICalendarResp
+	(*GetCalendarFeedTokenReq)(nil),        // 15: openmeeting.meetingext.GetCalendarFeedTokenReq
+	(*GetCalendarFeedTokenResp)(nil),       // 16: openmeeting.meetingext.GetCalendarFeedTokenResp
+	(*GetCalendarFeedReq)(nil),             // 17: openmeeting.meetingext.GetCalendarFeedReq
+	(*GetCalendarFeedResp)(nil),            // 18: openmeeting.meetingext.GetCalendarFeedResp
+	(*MeetingInvitee)(nil),                 // 19: openmeeting.meetingext.MeetingInvitee
+	(*AddMeetingInviteesReq)(nil),          // 20: openmeeting.meetingext.AddMeetingInviteesReq
+	(*AddMeetingInviteesResp)(nil),         // 21: openmeeting.meetingext.AddMeetingInviteesResp
+	(*RemoveMeetingInviteesReq)(nil),       // 22: openmeeting.meetingext.RemoveMeetingInviteesReq
+	(*RemoveMeetingInviteesResp)(nil),      // 23: openmeeting.meetingext.RemoveMeetingInviteesResp
+	(*RespondMeetingInvitationReq)(nil),    // 24: openmeeting.meetingext.RespondMeetingInvitationReq
+	(*RespondMeetingInvitationResp)(nil),   // 25: openmeeting.meetingext.RespondMeetingInvitationResp
+	(*GetMeetingInviteesReq)(nil),          // 26: openmeeting.meetingext.GetMeetingInviteesReq
+	(*GetMeetingInviteesResp)(nil),         // 27: openmeeting.meetingext.GetMeetingInviteesResp
+	(*InvitedMeeting)(nil),                 // 28: openmeeting.meetingext.InvitedMeeting
+	(*GetInvitedMeetingsReq)(nil),          // 29: openmeeting.meetingext.GetInvitedMeetingsReq
+	(*GetInvitedMeetingsResp)(nil),         // 30: openmeeting.meetingext.GetInvitedMeetingsResp
+	(*HandleLiveKitWebhookReq)(nil),        // 31: openmeeting.meetingext.HandleLiveKitWebhookReq
+	(*HandleLiveKitWebhookResp)(nil),       // 32: openmeeting.meetingext.HandleLiveKitWebhookResp
+	(*AttendanceRecord)(nil),               // 33: openmeeting.meetingext.AttendanceRecord
+	(*MeetingAttendee)(nil),                // 34: openmeeting.meetingext.MeetingAttendee
+	(*GetMeetingAttendanceReq)(nil),        // 35: openmeeting.meetingext.GetMeetingAttendanceReq
+	(*GetMeetingAttendanceResp)(nil),       // 36: openmeeting.meetingext.GetMeetingAttendanceResp
+	(*WaitingUser)(nil),                    // 37: openmeeting.meetingext.WaitingUser
+	(*WaitingRoomData)(nil),                // 38: openmeeting.meetingext.WaitingRoomData
+	(*MeetingLockData)(nil),                // 39: openmeeting.meetingext.MeetingLockData
+	(*NotifyMeetingExtData)(nil),           // 40: openmeeting.meetingext.NotifyMeetingExtData
+	(*SetMeetingWaitingRoomReq)(nil),       // 41: openmeeting.meetingext.SetMeetingWaitingRoomReq
+	(*SetMeetingWaitingRoomResp)(nil),      // 42: openmeeting.meetingext.SetMeetingWaitingRoomResp
+	(*GetWaitingRoomReq)(nil),              // 43: openmeeting.meetingext.GetWaitingRoomReq
+	(*GetWaitingRoomResp)(nil),             // 44: openmeeting.meetingext.GetWaitingRoomResp
+	(*RespondWaitingUsersReq)(nil),         // 45: openmeeting.meetingext.RespondWaitingUsersReq
+	(*RespondWaitingUsersResp)(nil),        // 46: openmeeting.meetingext.RespondWaitingUsersResp
+	(*GetWaitingStatusReq)(nil),            // 47: openmeeting.meetingext.GetWaitingStatusReq
+	(*GetWaitingStatusResp)(nil),           // 48: openmeeting.meetingext.GetWaitingStatusResp
+	(*SetMeetingLockReq)(nil),              // 49: openmeeting.meetingext.SetMeetingLockReq
+	(*SetMeetingLockResp)(nil),             // 50: openmeeting.meetingext.SetMeetingLockResp
+	(*MeetingLimit)(nil),                   // 51: openmeeting.meetingext.MeetingLimit
+	(*SetMeetingLimitReq)(nil),             // 52: openmeeting.meetingext.SetMeetingLimitReq
+	(*SetMeetingLimitResp)(nil),            // 53: openmeeting.meetingext.SetMeetingLimitResp
+	(*GetMeetingLimitReq)(nil),             // 54: openmeeting.meetingext.GetMeetingLimitReq
+	(*GetMeetingLimitResp)(nil),            // 55: openmeeting.meetingext.GetMeetingLimitResp
+	(*MeetingMetadataExt)(nil),             // 56: openmeeting.meetingext.MeetingMetadataExt
+	(*RaisedHand)(nil),                     // 57: openmeeting.meetingext.RaisedHand
+	(*SpeakingQueueData)(nil),              // 58: openmeeting.meetingext.SpeakingQueueData
+	(*MeetingParticipantRole)(nil),         // 59: openmeeting.meetingext.MeetingParticipantRole
+	(*MeetingRolePermission)(nil),          // 60: openmeeting.meetingext.MeetingRolePermission
+	(*MeetingRoleData)(nil),                // 61: openmeeting.meetingext.MeetingRoleData
+	(*GrantMeetingRoleReq)(nil),            // 62: openmeeting.meetingext.GrantMeetingRoleReq
+	(*GrantMeetingRoleResp)(nil),           // 63: openmeeting.meetingext.GrantMeetingRoleResp
+	(*RevokeMeetingRoleReq)(nil),           // 64: openmeeting.meetingext.RevokeMeetingRoleReq
+	(*RevokeMeetingRoleResp)(nil),          // 65: openmeeting.meetingext.RevokeMeetingRoleResp
+	(*GetMeetingRolesReq)(nil),             // 66: openmeeting.meetingext.GetMeetingRolesReq
+	(*GetMeetingRolesResp)(nil),            // 67: openmeeting.meetingext.GetMeetingRolesResp
+	(*RaiseHandReq)(nil),                   // 68: openmeeting.meetingext.RaiseHandReq
+	(*RaiseHandResp)(nil),                  // 69: openmeeting.meetingext.RaiseHandResp
+	(*LowerHandReq)(nil),                   // 70: openmeeting.meetingext.LowerHandReq
+	(*LowerHandResp)(nil),                  // 71: openmeeting.meetingext.LowerHandResp
+	(*ApproveRaisedHandReq)(nil),           // 72: openmeeting.meetingext.ApproveRaisedHandReq
+	(*ApproveRaisedHandResp)(nil),          // 73: openmeeting.meetingext.ApproveRaisedHandResp
+	(*ClearRaisedHandsReq)(nil),            // 74: openmeeting.meetingext.ClearRaisedHandsReq
+	(*ClearRaisedHandsResp)(nil),           // 75: openmeeting.meetingext.ClearRaisedHandsResp
+	(*MeetingChatMessage)(nil),             // 76: openmeeting.meetingext.MeetingChatMessage
+	(*SendMeetingChatMessageReq)(nil),      // 77: openmeeting.meetingext.SendMeetingChatMessageReq
+	(*SendMeetingChatMessageResp)(nil),     // 78: openmeeting.meetingext.SendMeetingChatMessageResp
+	(*GetMeetingChatMessagesReq)(nil),      // 79: openmeeting.meetingext.GetMeetingChatMessagesReq
+	(*GetMeetingChatMessagesResp)(nil),     // 80: openmeeting.meetingext.GetMeetingChatMessagesResp
+	(*MeetingRecordingFile)(nil),           // 81: openmeeting.meetingext.MeetingRecordingFile
+	(*MeetingRecording)(nil),               // 82: openmeeting.meetingext.MeetingRecording
+	(*MeetingRecordingData)(nil),           // 83: openmeeting.meetingext.MeetingRecordingData
+	(*StartMeetingRecordingReq)(nil),       // 84: openmeeting.meetingext.StartMeetingRecordingReq
+	(*StartMeetingRecordingResp)(nil),      // 85: openmeeting.meetingext.StartMeetingRecordingResp
+	(*StopMeetingRecordingReq)(nil),        // 86: openmeeting.meetingext.StopMeetingRecordingReq
+	(*StopMeetingRecordingResp)(nil),       // 87: openmeeting.meetingext.StopMeetingRecordingResp
+	(*GetMeetingRecordingsReq)(nil),        // 88: openmeeting.meetingext.GetMeetingRecordingsReq
+	(*GetMeetingRecordingsResp)(nil),       // 89: openmeeting.meetingext.GetMeetingRecordingsResp
+	(*MeetingStreamOutput)(nil),            // 90: openmeeting.meetingext.MeetingStreamOutput
+	(*MeetingLiveStream)(nil),              // 91: openmeeting.meetingext.MeetingLiveStream
+	(*MeetingLiveStreamData)(nil),          // 92: openmeeting.meetingext.MeetingLiveStreamData
+	(*AddMeetingStreamOutputsReq)(nil),     // 93: openmeeting.meetingext.AddMeetingStreamOutputsReq
+	(*AddMeetingStreamOutputsResp)(nil),    // 94: openmeeting.meetingext.AddMeetingStreamOutputsResp
+	(*RemoveMeetingStreamOutputsReq)(nil),  // 95: openmeeting.meetingext.RemoveMeetingStreamOutputsReq
+	(*RemoveMeetingStreamOutputsResp)(nil), // 96: openmeeting.meetingext.RemoveMeetingStreamOutputsResp
+	(*GetMeetingDialInReq)(nil),            // 97: openmeeting.meetingext.GetMeetingDialInReq
+	(*GetMeetingDialInResp)(nil),           // 98: openmeeting.meetingext.GetMeetingDialInResp
+	(*BreakoutRoom)(nil),                   // 99: openmeeting.meetingext.BreakoutRoom
+	(*MeetingBreakout)(nil),                // 100: openmeeting.meetingext.MeetingBreakout
+	(*MeetingBreakoutData)(nil),            // 101: openmeeting.meetingext.MeetingBreakoutData
+	(*BreakoutBroadcastData)(nil),          // 102: openmeeting.meetingext.BreakoutBroadcastData
+	(*StartBreakoutRoomsReq)(nil),          // 103: openmeeting.meetingext.StartBreakoutRoomsReq
+	(*StartBreakoutRoomsResp)(nil),         // 104: openmeeting.meetingext.StartBreakoutRoomsResp
+	(*AssignBreakoutRoomReq)(nil),          // 105: openmeeting.meetingext.AssignBreakoutRoomReq
+	(*AssignBreakoutRoomResp)(nil),         // 106: openmeeting.meetingext.AssignBreakoutRoomResp
+	(*JoinBreakoutRoomReq)(nil),            // 107: openmeeting.meetingext.JoinBreakoutRoomReq
+	(*JoinBreakoutRoomResp)(nil),           // 108: openmeeting.meetingext.JoinBreakoutRoomResp
+	(*BroadcastBreakoutMessageReq)(nil),    // 109: openmeeting.meetingext.BroadcastBreakoutMessageReq
+	(*BroadcastBreakoutMessageResp)(nil),   // 110: openmeeting.meetingext.BroadcastBreakoutMessageResp
+	(*CloseBreakoutRoomsReq)(nil),          // 111: openmeeting.meetingext.CloseBreakoutRoomsReq
+	(*CloseBreakoutRoomsResp)(nil),         // 112: openmeeting.meetingext.CloseBreakoutRoomsResp
+	nil,                                    // 113: openmeeting.meetingext.MeetingMetadataExt.RolesEntry
+	(*meeting.MeetingInfoSetting)(nil),     // 114: openmeeting.meeting.MeetingInfoSetting
+	(*meeting.BookMeetingReq)(nil),         // 115: openmeeting.meeting.BookMeetingReq
+	(*wrapperspb.Int64Value)(nil),          // 116: openim.protobuf.Int64Value
+	(*wrapperspb.StringValue)(nil),         // 117: openim.protobuf.StringValue
+	(*meeting.LiveKit)(nil),                // 118: openmeeting.meeting.LiveKit
+	(*wrapperspb.Int32Value)(nil),          // 119: openim.protobuf.Int32Value
+	(*sdkws.RequestPagination)(nil),        // 120: openim.sdkws.RequestPagination
+	(*meeting.BookMeetingResp)(nil),        // 121: openmeeting.meeting.BookMeetingResp
+}
+var file_meetingext_meetingext_proto_depIdxs = []int32{
+	114, // 0: openmeeting.meetingext.MeetingOccurrence.meetingDetail:type_name -> openmeeting.meeting.MeetingInfoSetting
+	0,   // 1: openmeeting.meetingext.GetMeetingOccurrencesResp.occurrences:type_name -> openmeeting.meetingext.MeetingOccurrence
+	115, // 2: openmeeting.meetingext.BookRecurringMeetingReq.meeting:type_name -> openmeeting.meeting.BookMeetingReq
+	3,   // 3: openmeeting.meetingext.BookRecurringMeetingReq.recurrence:type_name -> openmeeting.meetingext.MeetingRecurrence
+	3,   // 4: openmeeting.meetingext.UpdateMeetingRecurrenceReq.recurrence:type_name -> openmeeting.meetingext.MeetingRecurrence
+	3,   // 5: openmeeting.meetingext.GetMeetingRecurrenceResp.recurrence:type_name -> openmeeting.meetingext.MeetingRecurrence
+	116, // 6: openmeeting.meetingext.UpdateMeetingOccurrenceReq.scheduledTime:type_name -> openim.protobuf.Int64Value
+	116, // 7: openmeeting.meetingext.UpdateMeetingOccurrenceReq.meetingDuration:type_name -> openim.protobuf.Int64Value
+	117, // 8: openmeeting.meetingext.UpdateMeetingOccurrenceReq.title:type_name -> openim.protobuf.StringValue
+	117, // 9: openmeeting.meetingext.UpdateMeetingOccurrenceReq.password:type_name -> openim.protobuf.StringValue
+	0,   // 10: openmeeting.meetingext.UpdateMeetingOccurrenceResp.occurrence:type_name -> openmeeting.meetingext.MeetingOccurrence
+	19,  // 11: openmeeting.meetingext.GetMeetingInviteesResp.invitees:type_name -> openmeeting.meetingext.MeetingInvitee
+	114, // 12: openmeeting.meetingext.InvitedMeeting.meetingDetail:type_name -> openmeeting.meeting.MeetingInfoSetting
+	19,  // 13: openmeeting.meetingext.InvitedMeeting.invitation:type_name -> openmeeting.meetingext.MeetingInvitee
+	28,  // 14: openmeeting.meetingext.GetInvitedMeetingsResp.meetings:type_name -> openmeeting.meetingext.InvitedMeeting
+	33,  // 15: openmeeting.meetingext.MeetingAttendee.records:type_name -> openmeeting.meetingext.AttendanceRecord
+	34,  // 16: openmeeting.meetingext.GetMeetingAttendanceResp.attendees:type_name -> openmeeting.meetingext.MeetingAttendee
+	37,  // 17: openmeeting.meetingext.WaitingRoomData.users:type_name -> openmeeting.meetingext.WaitingUser
+	38,  // 18: openmeeting.meetingext.NotifyMeetingExtData.waitingRoomData:type_name -> openmeeting.meetingext.WaitingRoomData
+	39,  // 19: openmeeting.meetingext.NotifyMeetingExtData.meetingLockData:type_name -> openmeeting.meetingext.MeetingLockData
+	61,  // 20: openmeeting.meetingext.NotifyMeetingExtData.meetingRoleData:type_name -> openmeeting.meetingext.MeetingRoleData
+	58,  // 21: openmeeting.meetingext.NotifyMeetingExtData.speakingQueueData:type_name -> openmeeting.meetingext.SpeakingQueueData
+	83,  // 22: openmeeting.meetingext.NotifyMeetingExtData.meetingRecordingData:type_name -> openmeeting.meetingext.MeetingRecordingData
+	92,  // 23: openmeeting.meetingext.NotifyMeetingExtData.meetingLiveStreamData:type_name -> openmeeting.meetingext.MeetingLiveStreamData
+	101, // 24: openmeeting.meetingext.NotifyMeetingExtData.meetingBreakoutData:type_name -> openmeeting.meetingext.MeetingBreakoutData
+	102, // 25: openmeeting.meetingext.NotifyMeetingExtData.breakoutBroadcastData:type_name -> openmeeting.meetingext.BreakoutBroadcastData
+	37,  // 26: openmeeting.meetingext.GetWaitingRoomResp.users:type_name -> openmeeting.meetingext.WaitingUser
+	118, // 27: openmeeting.meetingext.GetWaitingStatusResp.liveKit:type_name -> openmeeting.meeting.LiveKit
+	119, // 28: openmeeting.meetingext.SetMeetingLimitReq.maxParticipants:type_name -> openim.protobuf.Int32Value
+	119, // 29: openmeeting.meetingext.SetMeetingLimitReq.emptyTimeout:type_name -> openim.protobuf.Int32Value
+	116, // 30: openmeeting.meetingext.SetMeetingLimitReq.maxDuration:type_name -> openim.protobuf.Int64Value
+	51,  // 31: openmeeting.meetingext.GetMeetingLimitResp.limit:type_name -> openmeeting.meetingext.MeetingLimit
+	51,  // 32: openmeeting.meetingext.GetMeetingLimitResp.effectiveLimit:type_name -> openmeeting.meetingext.MeetingLimit
+	113, // 33: openmeeting.meetingext.MeetingMetadataExt.roles:type_name -> openmeeting.meetingext.MeetingMetadataExt.RolesEntry
+	57,  // 34: openmeeting.meetingext.MeetingMetadataExt.raisedHands:type_name -> openmeeting.meetingext.RaisedHand
+	91,  // 35: openmeeting.meetingext.MeetingMetadataExt.liveStream:type_name -> openmeeting.meetingext.MeetingLiveStream
+	100, // 36: openmeeting.meetingext.MeetingMetadataExt.breakout:type_name -> openmeeting.meetingext.MeetingBreakout
+	57,  // 37: openmeeting.meetingext.SpeakingQueueData.raisedHands:type_name -> openmeeting.meetingext.RaisedHand
+	59,  // 38: openmeeting.meetingext.MeetingRoleData.roles:type_name -> openmeeting.meetingext.MeetingParticipantRole
+	59,  // 39: openmeeting.meetingext.GetMeetingRolesResp.roles:type_name -> openmeeting.meetingext.MeetingParticipantRole
+	60,  // 40: openmeeting.meetingext.GetMeetingRolesResp.matrix:type_name -> openmeeting.meetingext.MeetingRolePermission
+	76,  // 41: openmeeting.meetingext.SendMeetingChatMessageResp.message:type_name -> openmeeting.meetingext.MeetingChatMessage
+	120, // 42: openmeeting.meetingext.GetMeetingChatMessagesReq.pagination:type_name -> openim.sdkws.RequestPagination
+	76,  // 43: openmeeting.meetingext.GetMeetingChatMessagesResp.messages:type_name -> openmeeting.meetingext.MeetingChatMessage
+	81,  // 44: openmeeting.meetingext.MeetingRecording.files:type_name -> openmeeting.meetingext.MeetingRecordingFile
+	82,  // 45: openmeeting.meetingext.MeetingRecordingData.recording:type_name -> openmeeting.meetingext.MeetingRecording
+	82,  // 46: openmeeting.meetingext.StartMeetingRecordingResp.recording:type_name -> openmeeting.meetingext.MeetingRecording
+	82,  // 47: openmeeting.meetingext.StopMeetingRecordingResp.recordings:type_name -> openmeeting.meetingext.MeetingRecording
+	120, // 48: openmeeting.meetingext.GetMeetingRecordingsReq.pagination:type_name -> openim.sdkws.RequestPagination
+	82,  // 49: openmeeting.meetingext.GetMeetingRecordingsResp.recordings:type_name -> openmeeting.meetingext.MeetingRecording
+	90,  // 50: openmeeting.meetingext.MeetingLiveStream.outputs:type_name -> openmeeting.meetingext.MeetingStreamOutput
+	91,  // 51: openmeeting.meetingext.MeetingLiveStreamData.liveStream:type_name -> openmeeting.meetingext.MeetingLiveStream
+	91,  // 52: openmeeting.meetingext.AddMeetingStreamOutputsResp.liveStream:type_name -> openmeeting.meetingext.MeetingLiveStream
+	91,  // 53: openmeeting.meetingext.RemoveMeetingStreamOutputsResp.liveStream:type_name -> openmeeting.meetingext.MeetingLiveStream
+	99,  // 54: openmeeting.meetingext.MeetingBreakout.rooms:type_name -> openmeeting.meetingext.BreakoutRoom
+	100, // 55: openmeeting.meetingext.MeetingBreakoutData.breakout:type_name -> openmeeting.meetingext.MeetingBreakout
+	99,  // 56: openmeeting.meetingext.StartBreakoutRoomsReq.rooms:type_name -> openmeeting.meetingext.BreakoutRoom
+	100, // 57: openmeeting.meetingext.StartBreakoutRoomsResp.breakout:type_name -> openmeeting.meetingext.MeetingBreakout
+	100, // 58: openmeeting.meetingext.AssignBreakoutRoomResp.breakout:type_name -> openmeeting.meetingext.MeetingBreakout
+	1,   // 59: openmeeting.meetingext.MeetingExtService.GetMeetingOccurrences:input_type -> openmeeting.meetingext.GetMeetingOccurrencesReq
+	4,   // 60: openmeeting.meetingext.MeetingExtService.BookRecurringMeeting:input_type -> openmeeting.meetingext.BookRecurringMeetingReq
+	5,   // 61: openmeeting.meetingext.MeetingExtService.UpdateMeetingRecurrence:input_type -> openmeeting.meetingext.UpdateMeetingRecurrenceReq
+	7,   // 62: openmeeting.meetingext.MeetingExtService.GetMeetingRecurrence:input_type -> openmeeting.meetingext.GetMeetingRecurrenceReq
+	9,   // 63: openmeeting.meetingext.MeetingExtService.UpdateMeetingOccurrence:input_type -> openmeeting.meetingext.UpdateMeetingOccurrenceReq
+	11,  // 64: openmeeting.meetingext.MeetingExtService.CancelMeetingOccurrence:input_type -> openmeeting.meetingext.CancelMeetingOccurrenceReq
+	13,  // 65: openmeeting.meetingext.MeetingExtService.GetMeetingICalendar:input_type -> openmeeting.meetingext.GetMeetingICalendarReq
+	15,  // 66: openmeeting.meetingext.MeetingExtService.GetCalendarFeedToken:input_type -> openmeeting.meetingext.GetCalendarFeedTokenReq
+	17,  // 67: openmeeting.meetingext.MeetingExtService.GetCalendarFeed:input_type -> openmeeting.meetingext.GetCalendarFeedReq
+	20,  // 68: openmeeting.meetingext.MeetingExtService.AddMeetingInvitees:input_type -> openmeeting.meetingext.AddMeetingInviteesReq
+	22,  // 69: openmeeting.meetingext.MeetingExtService.RemoveMeetingInvitees:input_type -> openmeeting.meetingext.RemoveMeetingInviteesReq
+	24,  // 70: openmeeting.meetingext.MeetingExtService.RespondMeetingInvitation:input_type -> openmeeting.meetingext.RespondMeetingInvitationReq
+	26,  // 71: openmeeting.meetingext.MeetingExtService.GetMeetingInvitees:input_type -> openmeeting.meetingext.GetMeetingInviteesReq
+	29,  // 72: openmeeting.meetingext.MeetingExtService.GetInvitedMeetings:input_type -> openmeeting.meetingext.GetInvitedMeetingsReq
+	31,  // 73: openmeeting.meetingext.MeetingExtService.HandleLiveKitWebhook:input_type -> openmeeting.meetingext.HandleLiveKitWebhookReq
+	35,  // 74: openmeeting.meetingext.MeetingExtService.GetMeetingAttendance:input_type -> openmeeting.meetingext.GetMeetingAttendanceReq
+	41,  // 75: openmeeting.meetingext.MeetingExtService.SetMeetingWaitingRoom:input_type -> openmeeting.meetingext.SetMeetingWaitingRoomReq
+	43,  // 76: openmeeting.meetingext.MeetingExtService.GetWaitingRoom:input_type -> openmeeting.meetingext.GetWaitingRoomReq
+	45,  // 77: openmeeting.meetingext.MeetingExtService.AdmitWaitingUsers:input_type -> openmeeting.meetingext.RespondWaitingUsersReq
+	45,  // 78: openmeeting.meetingext.MeetingExtService.DenyWaitingUsers:input_type -> openmeeting.meetingext.RespondWaitingUsersReq
+	47,  // 79: openmeeting.meetingext.MeetingExtService.GetWaitingStatus:input_type -> openmeeting.meetingext.GetWaitingStatusReq
+	49,  // 80: openmeeting.meetingext.MeetingExtService.SetMeetingLock:input_type -> openmeeting.meetingext.SetMeetingLockReq
+	52,  // 81: openmeeting.meetingext.MeetingExtService.SetMeetingLimit:input_type -> openmeeting.meetingext.SetMeetingLimitReq
+	54,  // 82: openmeeting.meetingext.MeetingExtService.GetMeetingLimit:input_type -> openmeeting.meetingext.GetMeetingLimitReq
+	62,  // 83: openmeeting.meetingext.MeetingExtService.GrantMeetingRole:input_type -> openmeeting.meetingext.GrantMeetingRoleReq
+	64,  // 84: openmeeting.meetingext.MeetingExtService.RevokeMeetingRole:input_type -> openmeeting.meetingext.RevokeMeetingRoleReq
+	66,  // 85: openmeeting.meetingext.MeetingExtService.GetMeetingRoles:input_type -> openmeeting.meetingext.GetMeetingRolesReq
+	68,  // 86: openmeeting.meetingext.MeetingExtService.RaiseHand:input_type -> openmeeting.meetingext.RaiseHandReq
+	70,  // 87: openmeeting.meetingext.MeetingExtService.LowerHand:input_type -> openmeeting.meetingext.LowerHandReq
+	72,  // 88: openmeeting.meetingext.MeetingExtService.ApproveRaisedHand:input_type -> openmeeting.meetingext.ApproveRaisedHandReq
+	74,  // 89: openmeeting.meetingext.MeetingExtService.ClearRaisedHands:input_type -> openmeeting.meetingext.ClearRaisedHandsReq
+	77,  // 90: openmeeting.meetingext.MeetingExtService.SendMeetingChatMessage:input_type -> openmeeting.meetingext.SendMeetingChatMessageReq
+	79,  // 91: openmeeting.meetingext.MeetingExtService.GetMeetingChatMessages:input_type -> openmeeting.meetingext.GetMeetingChatMessagesReq
+	84,  // 92: openmeeting.meetingext.MeetingExtService.StartMeetingRecording:input_type -> openmeeting.meetingext.StartMeetingRecordingReq
+	86,  // 93: openmeeting.meetingext.MeetingExtService.StopMeetingRecording:input_type -> openmeeting.meetingext.StopMeetingRecordingReq
+	88,  // 94: openmeeting.meetingext.MeetingExtService.GetMeetingRecordings:input_type -> openmeeting.meetingext.GetMeetingRecordingsReq
+	93,  // 95: openmeeting.meetingext.MeetingExtService.AddMeetingStreamOutputs:input_type -> openmeeting.meetingext.AddMeetingStreamOutputsReq
+	95,  // 96: openmeeting.meetingext.MeetingExtService.RemoveMeetingStreamOutputs:input_type -> openmeeting.meetingext.RemoveMeetingStreamOutputsReq
+	97,  // 97: openmeeting.meetingext.MeetingExtService.GetMeetingDialIn:input_type -> openmeeting.meetingext.GetMeetingDialInReq
+	103, // 98: openmeeting.meetingext.MeetingExtService.StartBreakoutRooms:input_type -> openmeeting.meetingext.StartBreakoutRoomsReq
+	105, // 99: openmeeting.meetingext.MeetingExtService.AssignBreakoutRoom:input_type -> openmeeting.meetingext.AssignBreakoutRoomReq
+	107, // 100: openmeeting.meetingext.MeetingExtService.JoinBreakoutRoom:input_type -> openmeeting.meetingext.JoinBreakoutRoomReq
+	109, // 101: openmeeting.meetingext.MeetingExtService.BroadcastBreakoutMessage:input_type -> openmeeting.meetingext.BroadcastBreakoutMessageReq
+	111, // 102: openmeeting.meetingext.MeetingExtService.CloseBreakoutRooms:input_type -> openmeeting.meetingext.CloseBreakoutRoomsReq
+	2,   // 103: openmeeting.meetingext.MeetingExtService.GetMeetingOccurrences:output_type -> openmeeting.meetingext.GetMeetingOccurrencesResp
+	121, // 104: openmeeting.meetingext.MeetingExtService.BookRecurringMeeting:output_type -> openmeeting.meeting.BookMeetingResp
+	6,   // 105: openmeeting.meetingext.MeetingExtService.UpdateMeetingRecurrence:output_type -> openmeeting.meetingext.UpdateMeetingRecurrenceResp
+	8,   // 106: openmeeting.meetingext.MeetingExtService.GetMeetingRecurrence:output_type -> openmeeting.meetingext.GetMeetingRecurrenceResp
+	10,  // 107: openmeeting.meetingext.MeetingExtService.UpdateMeetingOccurrence:output_type -> openmeeting.meetingext.UpdateMeetingOccurrenceResp
+	12,  // 108: openmeeting.meetingext.MeetingExtService.CancelMeetingOccurrence:output_type -> openmeeting.meetingext.CancelMeetingOccurrenceResp
+	14,  // 109: openmeeting.meetingext.MeetingExtService.GetMeetingICalendar:output_type -> openmeeting.meetingext.GetMeetingICalendarResp
+	16,  // 110: openmeeting.meetingext.MeetingExtService.GetCalendarFeedToken:output_type -> openmeeting.meetingext.GetCalendarFeedTokenResp
+	18,  // 111: openmeeting.meetingext.MeetingExtService.GetCalendarFeed:output_type -> openmeeting.meetingext.GetCalendarFeedResp
+	21,  // 112: openmeeting.meetingext.MeetingExtService.AddMeetingInvitees:output_type -> openmeeting.meetingext.AddMeetingInviteesResp
+	23,  // 113: openmeeting.meetingext.MeetingExtService.RemoveMeetingInvitees:output_type -> openmeeting.meetingext.RemoveMeetingInviteesResp
+	25,  // 114: openmeeting.meetingext.MeetingExtService.RespondMeetingInvitation:output_type -> openmeeting.meetingext.RespondMeetingInvitationResp
+	27,  // 115: openmeeting.meetingext.MeetingExtService.GetMeetingInvitees:output_type -> openmeeting.meetingext.GetMeetingInviteesResp
+	30,  // 116: openmeeting.meetingext.MeetingExtService.GetInvitedMeetings:output_type -> openmeeting.meetingext.GetInvitedMeetingsResp
+	32,  // 117: openmeeting.meetingext.MeetingExtService.HandleLiveKitWebhook:output_type -> openmeeting.meetingext.HandleLiveKitWebhookResp
+	36,  // 118: openmeeting.meetingext.MeetingExtService.GetMeetingAttendance:output_type -> openmeeting.meetingext.GetMeetingAttendanceResp
+	42,  // 119: openmeeting.meetingext.MeetingExtService.SetMeetingWaitingRoom:output_type -> openmeeting.meetingext.SetMeetingWaitingRoomResp
+	44,  // 120: openmeeting.meetingext.MeetingExtService.GetWaitingRoom:output_type -> openmeeting.meetingext.GetWaitingRoomResp
+	46,  // 121: openmeeting.meetingext.MeetingExtService.AdmitWaitingUsers:output_type -> openmeeting.meetingext.RespondWaitingUsersResp
+	46,  // 122: openmeeting.meetingext.MeetingExtService.DenyWaitingUsers:output_type -> openmeeting.meetingext.RespondWaitingUsersResp
+	48,  // 123: openmeeting.meetingext.MeetingExtService.GetWaitingStatus:output_type -> openmeeting.meetingext.GetWaitingStatusResp
+	50,  // 124: openmeeting.meetingext.MeetingExtService.SetMeetingLock:output_type -> openmeeting.meetingext.SetMeetingLockResp
+	53,  // 125: openmeeting.meetingext.MeetingExtService.SetMeetingLimit:output_type -> openmeeting.meetingext.SetMeetingLimitResp
+	55,  // 126: openmeeting.meetingext.MeetingExtService.GetMeetingLimit:output_type -> openmeeting.meetingext.GetMeetingLimitResp
+	63,  // 127: openmeeting.meetingext.MeetingExtService.GrantMeetingRole:output_type -> openmeeting.meetingext.GrantMeetingRoleResp
+	65,  // 128: openmeeting.meetingext.MeetingExtService.RevokeMeetingRole:output_type -> openmeeting.meetingext.RevokeMeetingRoleResp
+	67,  // 129: openmeeting.meetingext.MeetingExtService.GetMeetingRoles:output_type -> openmeeting.meetingext.GetMeetingRolesResp
+	69,  // 130: openmeeting.meetingext.MeetingExtService.RaiseHand:output_type -> openmeeting.meetingext.RaiseHandResp
+	71,  // 131: openmeeting.meetingext.MeetingExtService.LowerHand:output_type -> openmeeting.meetingext.LowerHandResp
+	73,  // 132: openmeeting.meetingext.MeetingExtService.ApproveRaisedHand:output_type -> openmeeting.meetingext.ApproveRaisedHandResp
+	75,  // 133: openmeeting.meetingext.MeetingExtService.ClearRaisedHands:output_type -> openmeeting.meetingext.ClearRaisedHandsResp
+	78,  // 134: openmeeting.meetingext.MeetingExtService.SendMeetingChatMessage:output_type -> openmeeting.meetingext.SendMeetingChatMessageResp
+	80,  // 135: openmeeting.meetingext.MeetingExtService.GetMeetingChatMessages:output_type -> openmeeting.meetingext.GetMeetingChatMessagesResp
+	85,  // 136: openmeeting.meetingext.MeetingExtService.StartMeetingRecording:output_type -> openmeeting.meetingext.StartMeetingRecordingResp
+	87,  // 137: openmeeting.meetingext.MeetingExtService.StopMeetingRecording:output_type -> openmeeting.meetingext.StopMeetingRecordingResp
+	89,  // 138: openmeeting.meetingext.MeetingExtService.GetMeetingRecordings:output_type -> openmeeting.meetingext.GetMeetingRecordingsResp
+	94,  // 139: openmeeting.meetingext.MeetingExtService.AddMeetingStreamOutputs:output_type -> openmeeting.meetingext.AddMeetingStreamOutputsResp
+	96,  // 140: openmeeting.meetingext.MeetingExtService.RemoveMeetingStreamOutputs:output_type -> openmeeting.meetingext.RemoveMeetingStreamOutputsResp
+	98,  // 141: openmeeting.meetingext.MeetingExtService.GetMeetingDialIn:output_type -> openmeeting.meetingext.GetMeetingDialInResp
+	104, // 142: openmeeting.meetingext.MeetingExtService.StartBreakoutRooms:output_type -> openmeeting.meetingext.StartBreakoutRoomsResp
+	106, // 143: openmeeting.meetingext.MeetingExtService.AssignBreakoutRoom:output_type -> openmeeting.meetingext.AssignBreakoutRoomResp
+	108, // 144: openmeeting.meetingext.MeetingExtService.JoinBreakoutRoom:output_type -> openmeeting.meetingext.JoinBreakoutRoomResp
+	110, // 145: openmeeting.meetingext.MeetingExtService.BroadcastBreakoutMessage:output_type -> openmeeting.meetingext.BroadcastBreakoutMessageResp
+	112, // 146: openmeeting.meetingext.MeetingExtService.CloseBreakoutRooms:output_type -> openmeeting.meetingext.CloseBreakoutRoomsResp
+	103, // [103:147] is the sub-list for method output_type
+	59,  // [59:103] is the sub-list for method input_type
+	59,  // [59:59] is the sub-list for extension type_name
+	59,  // [59:59] is the sub-list for extension extendee
+	0,   // [0:59] is the sub-list for field type_name
+}
+
+func init() { file_meetingext_meetingext_proto_init() }
+func file_meetingext_meetingext_proto_init() {
+	if File_meetingext_meetingext_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_meetingext_meetingext_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeetingOccurrence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMeetingOccurrencesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMeetingOccurrencesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeetingRecurrence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BookRecurringMeetingReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateMeetingRecurrenceReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateMeetingRecurrenceResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMeetingRecurrenceReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMeetingRecurrenceResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateMeetingOccurrenceReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateMeetingOccurrenceResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelMeetingOccurrenceReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelMeetingOccurrenceResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMeetingICalendarReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMeetingICalendarResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCalendarFeedTokenReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCalendarFeedTokenResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCalendarFeedReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCalendarFeedResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeetingInvitee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddMeetingInviteesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddMeetingInviteesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveMeetingInviteesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveMeetingInviteesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondMeetingInvitationReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondMeetingInvitationResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMeetingInviteesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMeetingInviteesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvitedMeeting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInvitedMeetingsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInvitedMeetingsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandleLiveKitWebhookReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandleLiveKitWebhookResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttendanceRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeetingAttendee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMeetingAttendanceReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMeetingAttendanceResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaitingUser); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaitingRoomData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeetingLockData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotifyMeetingExtData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMeetingWaitingRoomReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMeetingWaitingRoomResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWaitingRoomReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWaitingRoomResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondWaitingUsersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondWaitingUsersResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWaitingStatusReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWaitingStatusResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMeetingLockReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMeetingLockResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeetingLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMeetingLimitReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMeetingLimitResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMeetingLimitReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMeetingLimitResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeetingMetadataExt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RaisedHand); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpeakingQueueData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeetingParticipantRole); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeetingRolePermission); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeetingRoleData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrantMeetingRoleReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrantMeetingRoleResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeMeetingRoleReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeMeetingRoleResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMeetingRolesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMeetingRolesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RaiseHandReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RaiseHandResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LowerHandReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LowerHandResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveRaisedHandReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveRaisedHandResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearRaisedHandsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearRaisedHandsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeetingChatMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendMeetingChatMessageReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendMeetingChatMessageResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMeetingChatMessagesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMeetingChatMessagesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeetingRecordingFile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeetingRecording); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeetingRecordingData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartMeetingRecordingReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartMeetingRecordingResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopMeetingRecordingReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopMeetingRecordingResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMeetingRecordingsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMeetingRecordingsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeetingStreamOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeetingLiveStream); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeetingLiveStreamData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddMeetingStreamOutputsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddMeetingStreamOutputsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveMeetingStreamOutputsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveMeetingStreamOutputsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMeetingDialInReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMeetingDialInResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BreakoutRoom); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeetingBreakout); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeetingBreakoutData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BreakoutBroadcastData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartBreakoutRoomsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartBreakoutRoomsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssignBreakoutRoomReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssignBreakoutRoomResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinBreakoutRoomReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinBreakoutRoomResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BroadcastBreakoutMessageReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BroadcastBreakoutMessageResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseBreakoutRoomsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meetingext_meetingext_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseBreakoutRoomsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_meetingext_meetingext_proto_msgTypes[40].OneofWrappers = []interface{}{
+		(*NotifyMeetingExtData_WaitingRoomData)(nil),
+		(*NotifyMeetingExtData_MeetingLockData)(nil),
+		(*NotifyMeetingExtData_MeetingRoleData)(nil),
+		(*NotifyMeetingExtData_SpeakingQueueData)(nil),
+		(*NotifyMeetingExtData_MeetingRecordingData)(nil),
+		(*NotifyMeetingExtData_MeetingLiveStreamData)(nil),
+		(*NotifyMeetingExtData_MeetingBreakoutData)(nil),
+		(*NotifyMeetingExtData_BreakoutBroadcastData)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_meetingext_meetingext_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   114,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_meetingext_meetingext_proto_goTypes,
+		DependencyIndexes: file_meetingext_meetingext_proto_depIdxs,
+		MessageInfos:      file_meetingext_meetingext_proto_msgTypes,
+	}.Build()
+	File_meetingext_meetingext_proto = out.File
+	file_meetingext_meetingext_proto_rawDesc = nil
+	file_meetingext_meetingext_proto_goTypes = nil
+	file_meetingext_meetingext_proto_depIdxs = nil
+}
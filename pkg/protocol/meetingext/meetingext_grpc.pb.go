@@ -0,0 +1,1787 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.0
+// source: meetingext/meetingext.proto
+
+package meetingext
+
+import (
+	context "context"
+	meeting "github.com/openimsdk/protocol/openmeeting/meeting"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	MeetingExtService_GetMeetingOccurrences_FullMethodName      = "/openmeeting.meetingext.MeetingExtService/GetMeetingOccurrences"
+	MeetingExtService_BookRecurringMeeting_FullMethodName       = "/openmeeting.meetingext.MeetingExtService/BookRecurringMeeting"
+	MeetingExtService_UpdateMeetingRecurrence_FullMethodName    = "/openmeeting.meetingext.MeetingExtService/UpdateMeetingRecurrence"
+	MeetingExtService_GetMeetingRecurrence_FullMethodName       = "/openmeeting.meetingext.MeetingExtService/GetMeetingRecurrence"
+	MeetingExtService_UpdateMeetingOccurrence_FullMethodName    = "/openmeeting.meetingext.MeetingExtService/UpdateMeetingOccurrence"
+	MeetingExtService_CancelMeetingOccurrence_FullMethodName    = "/openmeeting.meetingext.MeetingExtService/CancelMeetingOccurrence"
+	MeetingExtService_GetMeetingICalendar_FullMethodName        = "/openmeeting.meetingext.MeetingExtService/GetMeetingICalendar"
+	MeetingExtService_GetCalendarFeedToken_FullMethodName       = "/openmeeting.meetingext.MeetingExtService/GetCalendarFeedToken"
+	MeetingExtService_GetCalendarFeed_FullMethodName            = "/openmeeting.meetingext.MeetingExtService/GetCalendarFeed"
+	MeetingExtService_AddMeetingInvitees_FullMethodName         = "/openmeeting.meetingext.MeetingExtService/AddMeetingInvitees"
+	MeetingExtService_RemoveMeetingInvitees_FullMethodName      = "/openmeeting.meetingext.MeetingExtService/RemoveMeetingInvitees"
+	MeetingExtService_RespondMeetingInvitation_FullMethodName   = "/openmeeting.meetingext.MeetingExtService/RespondMeetingInvitation"
+	MeetingExtService_GetMeetingInvitees_FullMethodName         = "/openmeeting.meetingext.MeetingExtService/GetMeetingInvitees"
+	MeetingExtService_GetInvitedMeetings_FullMethodName         = "/openmeeting.meetingext.MeetingExtService/GetInvitedMeetings"
+	MeetingExtService_HandleLiveKitWebhook_FullMethodName       = "/openmeeting.meetingext.MeetingExtService/HandleLiveKitWebhook"
+	MeetingExtService_GetMeetingAttendance_FullMethodName       = "/openmeeting.meetingext.MeetingExtService/GetMeetingAttendance"
+	MeetingExtService_SetMeetingWaitingRoom_FullMethodName      = "/openmeeting.meetingext.MeetingExtService/SetMeetingWaitingRoom"
+	MeetingExtService_GetWaitingRoom_FullMethodName             = "/openmeeting.meetingext.MeetingExtService/GetWaitingRoom"
+	MeetingExtService_AdmitWaitingUsers_FullMethodName          = "/openmeeting.meetingext.MeetingExtService/AdmitWaitingUsers"
+	MeetingExtService_DenyWaitingUsers_FullMethodName           = "/openmeeting.meetingext.MeetingExtService/DenyWaitingUsers"
+	MeetingExtService_GetWaitingStatus_FullMethodName           = "/openmeeting.meetingext.MeetingExtService/GetWaitingStatus"
+	MeetingExtService_SetMeetingLock_FullMethodName             = "/openmeeting.meetingext.MeetingExtService/SetMeetingLock"
+	MeetingExtService_SetMeetingLimit_FullMethodName            = "/openmeeting.meetingext.MeetingExtService/SetMeetingLimit"
+	MeetingExtService_GetMeetingLimit_FullMethodName            = "/openmeeting.meetingext.MeetingExtService/GetMeetingLimit"
+	MeetingExtService_GrantMeetingRole_FullMethodName           = "/openmeeting.meetingext.MeetingExtService/GrantMeetingRole"
+	MeetingExtService_RevokeMeetingRole_FullMethodName          = "/openmeeting.meetingext.MeetingExtService/RevokeMeetingRole"
+	MeetingExtService_GetMeetingRoles_FullMethodName            = "/openmeeting.meetingext.MeetingExtService/GetMeetingRoles"
+	MeetingExtService_RaiseHand_FullMethodName                  = "/openmeeting.meetingext.MeetingExtService/RaiseHand"
+	MeetingExtService_LowerHand_FullMethodName                  = "/openmeeting.meetingext.MeetingExtService/LowerHand"
+	MeetingExtService_ApproveRaisedHand_FullMethodName          = "/openmeeting.meetingext.MeetingExtService/ApproveRaisedHand"
+	MeetingExtService_ClearRaisedHands_FullMethodName           = "/openmeeting.meetingext.MeetingExtService/ClearRaisedHands"
+	MeetingExtService_SendMeetingChatMessage_FullMethodName     = "/openmeeting.meetingext.MeetingExtService/SendMeetingChatMessage"
+	MeetingExtService_GetMeetingChatMessages_FullMethodName     = "/openmeeting.meetingext.MeetingExtService/GetMeetingChatMessages"
+	MeetingExtService_StartMeetingRecording_FullMethodName      = "/openmeeting.meetingext.MeetingExtService/StartMeetingRecording"
+	MeetingExtService_StopMeetingRecording_FullMethodName       = "/openmeeting.meetingext.MeetingExtService/StopMeetingRecording"
+	MeetingExtService_GetMeetingRecordings_FullMethodName       = "/openmeeting.meetingext.MeetingExtService/GetMeetingRecordings"
+	MeetingExtService_AddMeetingStreamOutputs_FullMethodName    = "/openmeeting.meetingext.MeetingExtService/AddMeetingStreamOutputs"
+	MeetingExtService_RemoveMeetingStreamOutputs_FullMethodName = "/openmeeting.meetingext.MeetingExtService/RemoveMeetingStreamOutputs"
+	MeetingExtService_GetMeetingDialIn_FullMethodName           = "/openmeeting.meetingext.MeetingExtService/GetMeetingDialIn"
+	MeetingExtService_StartBreakoutRooms_FullMethodName         = "/openmeeting.meetingext.MeetingExtService/StartBreakoutRooms"
+	MeetingExtService_AssignBreakoutRoom_FullMethodName         = "/openmeeting.meetingext.MeetingExtService/AssignBreakoutRoom"
+	MeetingExtService_JoinBreakoutRoom_FullMethodName           = "/openmeeting.meetingext.MeetingExtService/JoinBreakoutRoom"
+	MeetingExtService_BroadcastBreakoutMessage_FullMethodName   = "/openmeeting.meetingext.MeetingExtService/BroadcastBreakoutMessage"
+	MeetingExtService_CloseBreakoutRooms_FullMethodName         = "/openmeeting.meetingext.MeetingExtService/CloseBreakoutRooms"
+)
+
+// MeetingExtServiceClient is the client API for MeetingExtService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MeetingExtServiceClient interface {
+	// Gets the occurrences of meetings within a time window.
+	GetMeetingOccurrences(ctx context.Context, in *GetMeetingOccurrencesReq, opts ...grpc.CallOption) (*GetMeetingOccurrencesResp, error)
+	// Books a meeting repeating by an RRULE.
+	BookRecurringMeeting(ctx context.Context, in *BookRecurringMeetingReq, opts ...grpc.CallOption) (*meeting.BookMeetingResp, error)
+	// Replaces the recurrence of a meeting with an RRULE and exception dates.
+	UpdateMeetingRecurrence(ctx context.Context, in *UpdateMeetingRecurrenceReq, opts ...grpc.CallOption) (*UpdateMeetingRecurrenceResp, error)
+	// Gets the effective recurrence of a meeting.
+	GetMeetingRecurrence(ctx context.Context, in *GetMeetingRecurrenceReq, opts ...grpc.CallOption) (*GetMeetingRecurrenceResp, error)
+	// Reschedules one occurrence of a recurring meeting or changes its title or password.
+	UpdateMeetingOccurrence(ctx context.Context, in *UpdateMeetingOccurrenceReq, opts ...grpc.CallOption) (*UpdateMeetingOccurrenceResp, error)
+	// Cancels one occurrence of a recurring meeting.
+	CancelMeetingOccurrence(ctx context.Context, in *CancelMeetingOccurrenceReq, opts ...grpc.CallOption) (*CancelMeetingOccurrenceResp, error)
+	// Exports a meeting as an iCalendar.
+	GetMeetingICalendar(ctx context.Context, in *GetMeetingICalendarReq, opts ...grpc.CallOption) (*GetMeetingICalendarResp, error)
+	// Gets or renews the calendar feed token of the user.
+	GetCalendarFeedToken(ctx context.Context, in *GetCalendarFeedTokenReq, opts ...grpc.CallOption) (*GetCalendarFeedTokenResp, error)
+	// Gets the calendar feed by the feed token.
+	GetCalendarFeed(ctx context.Context, in *GetCalendarFeedReq, opts ...grpc.CallOption) (*GetCalendarFeedResp, error)
+	// Invites users to a meeting.
+	AddMeetingInvitees(ctx context.Context, in *AddMeetingInviteesReq, opts ...grpc.CallOption) (*AddMeetingInviteesResp, error)
+	// Withdraws the invitations of users.
+	RemoveMeetingInvitees(ctx context.Context, in *RemoveMeetingInviteesReq, opts ...grpc.CallOption) (*RemoveMeetingInviteesResp, error)
+	// Responds the invitation of a meeting.
+	RespondMeetingInvitation(ctx context.Context, in *RespondMeetingInvitationReq, opts ...grpc.CallOption) (*RespondMeetingInvitationResp, error)
+	// Gets the invitees of a meeting.
+	GetMeetingInvitees(ctx context.Context, in *GetMeetingInviteesReq, opts ...grpc.CallOption) (*GetMeetingInviteesResp, error)
+	// Gets the meetings the user is invited to.
+	GetInvitedMeetings(ctx context.Context, in *GetInvitedMeetingsReq, opts ...grpc.CallOption) (*GetInvitedMeetingsResp, error)
+	// Verifies a LiveKit server webhook and applies the room or participant event.
+	HandleLiveKitWebhook(ctx context.Context, in *HandleLiveKitWebhookReq, opts ...grpc.CallOption) (*HandleLiveKitWebhookResp, error)
+	// Gets who attended the meeting and for how long.
+	GetMeetingAttendance(ctx context.Context, in *GetMeetingAttendanceReq, opts ...grpc.CallOption) (*GetMeetingAttendanceResp, error)
+	// Turns the waiting room of a meeting on or off.
+	SetMeetingWaitingRoom(ctx context.Context, in *SetMeetingWaitingRoomReq, opts ...grpc.CallOption) (*SetMeetingWaitingRoomResp, error)
+	// Gets the users in the waiting room.
+	GetWaitingRoom(ctx context.Context, in *GetWaitingRoomReq, opts ...grpc.CallOption) (*GetWaitingRoomResp, error)
+	// Admits users in the waiting room into the meeting.
+	AdmitWaitingUsers(ctx context.Context, in *RespondWaitingUsersReq, opts ...grpc.CallOption) (*RespondWaitingUsersResp, error)
+	// Denies users in the waiting room.
+	DenyWaitingUsers(ctx context.Context, in *RespondWaitingUsersReq, opts ...grpc.CallOption) (*RespondWaitingUsersResp, error)
+	// Long-polls the admission of a waiting user, the token is returned once admitted.
+	GetWaitingStatus(ctx context.Context, in *GetWaitingStatusReq, opts ...grpc.CallOption) (*GetWaitingStatusResp, error)
+	// Locks a meeting in progress so that new participants could not join, or unlocks it.
+	SetMeetingLock(ctx context.Context, in *SetMeetingLockReq, opts ...grpc.CallOption) (*SetMeetingLockResp, error)
+	// Sets the participant cap, the empty room timeout and the maximum duration of a meeting.
+	SetMeetingLimit(ctx context.Context, in *SetMeetingLimitReq, opts ...grpc.CallOption) (*SetMeetingLimitResp, error)
+	// Gets the limits of a meeting.
+	GetMeetingLimit(ctx context.Context, in *GetMeetingLimitReq, opts ...grpc.CallOption) (*GetMeetingLimitResp, error)
+	// Grants a role to participants of a meeting in progress.
+	GrantMeetingRole(ctx context.Context, in *GrantMeetingRoleReq, opts ...grpc.CallOption) (*GrantMeetingRoleResp, error)
+	// Revokes the roles of participants, who become attendees.
+	RevokeMeetingRole(ctx context.Context, in *RevokeMeetingRoleReq, opts ...grpc.CallOption) (*RevokeMeetingRoleResp, error)
+	// Gets the roles of the participants and the permissions of the roles.
+	GetMeetingRoles(ctx context.Context, in *GetMeetingRolesReq, opts ...grpc.CallOption) (*GetMeetingRolesResp, error)
+	// Raises the hand of the user to ask to speak.
+	RaiseHand(ctx context.Context, in *RaiseHandReq, opts ...grpc.CallOption) (*RaiseHandResp, error)
+	// Lowers a raised hand.
+	LowerHand(ctx context.Context, in *LowerHandReq, opts ...grpc.CallOption) (*LowerHandResp, error)
+	// Lets a participant in the speaking queue speak.
+	ApproveRaisedHand(ctx context.Context, in *ApproveRaisedHandReq, opts ...grpc.CallOption) (*ApproveRaisedHandResp, error)
+	// Clears the speaking queue.
+	ClearRaisedHands(ctx context.Context, in *ClearRaisedHandsReq, opts ...grpc.CallOption) (*ClearRaisedHandsResp, error)
+	// Sends a chat message, which is kept after the meeting.
+	SendMeetingChatMessage(ctx context.Context, in *SendMeetingChatMessageReq, opts ...grpc.CallOption) (*SendMeetingChatMessageResp, error)
+	// Gets the chat history of a meeting page by page.
+	GetMeetingChatMessages(ctx context.Context, in *GetMeetingChatMessagesReq, opts ...grpc.CallOption) (*GetMeetingChatMessagesResp, error)
+	// Starts recording a meeting in progress into a file, host only.
+	StartMeetingRecording(ctx context.Context, in *StartMeetingRecordingReq, opts ...grpc.CallOption) (*StartMeetingRecordingResp, error)
+	// Stops the recordings of a meeting in progress, host only.
+	StopMeetingRecording(ctx context.Context, in *StopMeetingRecordingReq, opts ...grpc.CallOption) (*StopMeetingRecordingResp, error)
+	// Gets the recordings of a meeting page by page.
+	GetMeetingRecordings(ctx context.Context, in *GetMeetingRecordingsReq, opts ...grpc.CallOption) (*GetMeetingRecordingsResp, error)
+	// Adds RTMP outputs to the live stream of a meeting in progress, host only.
+	AddMeetingStreamOutputs(ctx context.Context, in *AddMeetingStreamOutputsReq, opts ...grpc.CallOption) (*AddMeetingStreamOutputsResp, error)
+	// Removes RTMP outputs from the live stream of a meeting in progress, host only.
+	RemoveMeetingStreamOutputs(ctx context.Context, in *RemoveMeetingStreamOutputsReq, opts ...grpc.CallOption) (*RemoveMeetingStreamOutputsResp, error)
+	// Gets the phone numbers and the PIN to dial in to a meeting, the PIN is allocated at the first time.
+	GetMeetingDialIn(ctx context.Context, in *GetMeetingDialInReq, opts ...grpc.CallOption) (*GetMeetingDialInResp, error)
+	// Splits a meeting in progress into breakout rooms, host only.
+	StartBreakoutRooms(ctx context.Context, in *StartBreakoutRoomsReq, opts ...grpc.CallOption) (*StartBreakoutRoomsResp, error)
+	// Moves participants to a breakout room, host only.
+	AssignBreakoutRoom(ctx context.Context, in *AssignBreakoutRoomReq, opts ...grpc.CallOption) (*AssignBreakoutRoomResp, error)
+	// Gets the token to join a breakout room of a meeting.
+	JoinBreakoutRoom(ctx context.Context, in *JoinBreakoutRoomReq, opts ...grpc.CallOption) (*JoinBreakoutRoomResp, error)
+	// Broadcasts a message to all the breakout rooms of a meeting, host only.
+	BroadcastBreakoutMessage(ctx context.Context, in *BroadcastBreakoutMessageReq, opts ...grpc.CallOption) (*BroadcastBreakoutMessageResp, error)
+	// Closes the breakout rooms of a meeting and pulls everyone back to the main room, host only.
+	CloseBreakoutRooms(ctx context.Context, in *CloseBreakoutRoomsReq, opts ...grpc.CallOption) (*CloseBreakoutRoomsResp, error)
+}
+
+type meetingExtServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMeetingExtServiceClient(cc grpc.ClientConnInterface) MeetingExtServiceClient {
+	return &meetingExtServiceClient{cc}
+}
+
+func (c *meetingExtServiceClient) GetMeetingOccurrences(ctx context.Context, in *GetMeetingOccurrencesReq, opts ...grpc.CallOption) (*GetMeetingOccurrencesResp, error) {
+	out := new(GetMeetingOccurrencesResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_GetMeetingOccurrences_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) BookRecurringMeeting(ctx context.Context, in *BookRecurringMeetingReq, opts ...grpc.CallOption) (*meeting.BookMeetingResp, error) {
+	out := new(meeting.BookMeetingResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_BookRecurringMeeting_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) UpdateMeetingRecurrence(ctx context.Context, in *UpdateMeetingRecurrenceReq, opts ...grpc.CallOption) (*UpdateMeetingRecurrenceResp, error) {
+	out := new(UpdateMeetingRecurrenceResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_UpdateMeetingRecurrence_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) GetMeetingRecurrence(ctx context.Context, in *GetMeetingRecurrenceReq, opts ...grpc.CallOption) (*GetMeetingRecurrenceResp, error) {
+	out := new(GetMeetingRecurrenceResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_GetMeetingRecurrence_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) UpdateMeetingOccurrence(ctx context.Context, in *UpdateMeetingOccurrenceReq, opts ...grpc.CallOption) (*UpdateMeetingOccurrenceResp, error) {
+	out := new(UpdateMeetingOccurrenceResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_UpdateMeetingOccurrence_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) CancelMeetingOccurrence(ctx context.Context, in *CancelMeetingOccurrenceReq, opts ...grpc.CallOption) (*CancelMeetingOccurrenceResp, error) {
+	out := new(CancelMeetingOccurrenceResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_CancelMeetingOccurrence_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) GetMeetingICalendar(ctx context.Context, in *GetMeetingICalendarReq, opts ...grpc.CallOption) (*GetMeetingICalendarResp, error) {
+	out := new(GetMeetingICalendarResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_GetMeetingICalendar_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) GetCalendarFeedToken(ctx context.Context, in *GetCalendarFeedTokenReq, opts ...grpc.CallOption) (*GetCalendarFeedTokenResp, error) {
+	out := new(GetCalendarFeedTokenResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_GetCalendarFeedToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) GetCalendarFeed(ctx context.Context, in *GetCalendarFeedReq, opts ...grpc.CallOption) (*GetCalendarFeedResp, error) {
+	out := new(GetCalendarFeedResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_GetCalendarFeed_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) AddMeetingInvitees(ctx context.Context, in *AddMeetingInviteesReq, opts ...grpc.CallOption) (*AddMeetingInviteesResp, error) {
+	out := new(AddMeetingInviteesResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_AddMeetingInvitees_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) RemoveMeetingInvitees(ctx context.Context, in *RemoveMeetingInviteesReq, opts ...grpc.CallOption) (*RemoveMeetingInviteesResp, error) {
+	out := new(RemoveMeetingInviteesResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_RemoveMeetingInvitees_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) RespondMeetingInvitation(ctx context.Context, in *RespondMeetingInvitationReq, opts ...grpc.CallOption) (*RespondMeetingInvitationResp, error) {
+	out := new(RespondMeetingInvitationResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_RespondMeetingInvitation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) GetMeetingInvitees(ctx context.Context, in *GetMeetingInviteesReq, opts ...grpc.CallOption) (*GetMeetingInviteesResp, error) {
+	out := new(GetMeetingInviteesResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_GetMeetingInvitees_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) GetInvitedMeetings(ctx context.Context, in *GetInvitedMeetingsReq, opts ...grpc.CallOption) (*GetInvitedMeetingsResp, error) {
+	out := new(GetInvitedMeetingsResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_GetInvitedMeetings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) HandleLiveKitWebhook(ctx context.Context, in *HandleLiveKitWebhookReq, opts ...grpc.CallOption) (*HandleLiveKitWebhookResp, error) {
+	out := new(HandleLiveKitWebhookResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_HandleLiveKitWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) GetMeetingAttendance(ctx context.Context, in *GetMeetingAttendanceReq, opts ...grpc.CallOption) (*GetMeetingAttendanceResp, error) {
+	out := new(GetMeetingAttendanceResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_GetMeetingAttendance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) SetMeetingWaitingRoom(ctx context.Context, in *SetMeetingWaitingRoomReq, opts ...grpc.CallOption) (*SetMeetingWaitingRoomResp, error) {
+	out := new(SetMeetingWaitingRoomResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_SetMeetingWaitingRoom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) GetWaitingRoom(ctx context.Context, in *GetWaitingRoomReq, opts ...grpc.CallOption) (*GetWaitingRoomResp, error) {
+	out := new(GetWaitingRoomResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_GetWaitingRoom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) AdmitWaitingUsers(ctx context.Context, in *RespondWaitingUsersReq, opts ...grpc.CallOption) (*RespondWaitingUsersResp, error) {
+	out := new(RespondWaitingUsersResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_AdmitWaitingUsers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) DenyWaitingUsers(ctx context.Context, in *RespondWaitingUsersReq, opts ...grpc.CallOption) (*RespondWaitingUsersResp, error) {
+	out := new(RespondWaitingUsersResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_DenyWaitingUsers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) GetWaitingStatus(ctx context.Context, in *GetWaitingStatusReq, opts ...grpc.CallOption) (*GetWaitingStatusResp, error) {
+	out := new(GetWaitingStatusResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_GetWaitingStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) SetMeetingLock(ctx context.Context, in *SetMeetingLockReq, opts ...grpc.CallOption) (*SetMeetingLockResp, error) {
+	out := new(SetMeetingLockResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_SetMeetingLock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) SetMeetingLimit(ctx context.Context, in *SetMeetingLimitReq, opts ...grpc.CallOption) (*SetMeetingLimitResp, error) {
+	out := new(SetMeetingLimitResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_SetMeetingLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) GetMeetingLimit(ctx context.Context, in *GetMeetingLimitReq, opts ...grpc.CallOption) (*GetMeetingLimitResp, error) {
+	out := new(GetMeetingLimitResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_GetMeetingLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) GrantMeetingRole(ctx context.Context, in *GrantMeetingRoleReq, opts ...grpc.CallOption) (*GrantMeetingRoleResp, error) {
+	out := new(GrantMeetingRoleResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_GrantMeetingRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) RevokeMeetingRole(ctx context.Context, in *RevokeMeetingRoleReq, opts ...grpc.CallOption) (*RevokeMeetingRoleResp, error) {
+	out := new(RevokeMeetingRoleResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_RevokeMeetingRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) GetMeetingRoles(ctx context.Context, in *GetMeetingRolesReq, opts ...grpc.CallOption) (*GetMeetingRolesResp, error) {
+	out := new(GetMeetingRolesResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_GetMeetingRoles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) RaiseHand(ctx context.Context, in *RaiseHandReq, opts ...grpc.CallOption) (*RaiseHandResp, error) {
+	out := new(RaiseHandResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_RaiseHand_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) LowerHand(ctx context.Context, in *LowerHandReq, opts ...grpc.CallOption) (*LowerHandResp, error) {
+	out := new(LowerHandResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_LowerHand_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) ApproveRaisedHand(ctx context.Context, in *ApproveRaisedHandReq, opts ...grpc.CallOption) (*ApproveRaisedHandResp, error) {
+	out := new(ApproveRaisedHandResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_ApproveRaisedHand_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) ClearRaisedHands(ctx context.Context, in *ClearRaisedHandsReq, opts ...grpc.CallOption) (*ClearRaisedHandsResp, error) {
+	out := new(ClearRaisedHandsResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_ClearRaisedHands_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) SendMeetingChatMessage(ctx context.Context, in *SendMeetingChatMessageReq, opts ...grpc.CallOption) (*SendMeetingChatMessageResp, error) {
+	out := new(SendMeetingChatMessageResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_SendMeetingChatMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) GetMeetingChatMessages(ctx context.Context, in *GetMeetingChatMessagesReq, opts ...grpc.CallOption) (*GetMeetingChatMessagesResp, error) {
+	out := new(GetMeetingChatMessagesResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_GetMeetingChatMessages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) StartMeetingRecording(ctx context.Context, in *StartMeetingRecordingReq, opts ...grpc.CallOption) (*StartMeetingRecordingResp, error) {
+	out := new(StartMeetingRecordingResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_StartMeetingRecording_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) StopMeetingRecording(ctx context.Context, in *StopMeetingRecordingReq, opts ...grpc.CallOption) (*StopMeetingRecordingResp, error) {
+	out := new(StopMeetingRecordingResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_StopMeetingRecording_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) GetMeetingRecordings(ctx context.Context, in *GetMeetingRecordingsReq, opts ...grpc.CallOption) (*GetMeetingRecordingsResp, error) {
+	out := new(GetMeetingRecordingsResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_GetMeetingRecordings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) AddMeetingStreamOutputs(ctx context.Context, in *AddMeetingStreamOutputsReq, opts ...grpc.CallOption) (*AddMeetingStreamOutputsResp, error) {
+	out := new(AddMeetingStreamOutputsResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_AddMeetingStreamOutputs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) RemoveMeetingStreamOutputs(ctx context.Context, in *RemoveMeetingStreamOutputsReq, opts ...grpc.CallOption) (*RemoveMeetingStreamOutputsResp, error) {
+	out := new(RemoveMeetingStreamOutputsResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_RemoveMeetingStreamOutputs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) GetMeetingDialIn(ctx context.Context, in *GetMeetingDialInReq, opts ...grpc.CallOption) (*GetMeetingDialInResp, error) {
+	out := new(GetMeetingDialInResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_GetMeetingDialIn_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) StartBreakoutRooms(ctx context.Context, in *StartBreakoutRoomsReq, opts ...grpc.CallOption) (*StartBreakoutRoomsResp, error) {
+	out := new(StartBreakoutRoomsResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_StartBreakoutRooms_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) AssignBreakoutRoom(ctx context.Context, in *AssignBreakoutRoomReq, opts ...grpc.CallOption) (*AssignBreakoutRoomResp, error) {
+	out := new(AssignBreakoutRoomResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_AssignBreakoutRoom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) JoinBreakoutRoom(ctx context.Context, in *JoinBreakoutRoomReq, opts ...grpc.CallOption) (*JoinBreakoutRoomResp, error) {
+	out := new(JoinBreakoutRoomResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_JoinBreakoutRoom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) BroadcastBreakoutMessage(ctx context.Context, in *BroadcastBreakoutMessageReq, opts ...grpc.CallOption) (*BroadcastBreakoutMessageResp, error) {
+	out := new(BroadcastBreakoutMessageResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_BroadcastBreakoutMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingExtServiceClient) CloseBreakoutRooms(ctx context.Context, in *CloseBreakoutRoomsReq, opts ...grpc.CallOption) (*CloseBreakoutRoomsResp, error) {
+	out := new(CloseBreakoutRoomsResp)
+	err := c.cc.Invoke(ctx, MeetingExtService_CloseBreakoutRooms_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MeetingExtServiceServer is the server API for MeetingExtService service.
+// All implementations should embed UnimplementedMeetingExtServiceServer
+// for forward compatibility
+type MeetingExtServiceServer interface {
+	// Gets the occurrences of meetings within a time window.
+	GetMeetingOccurrences(context.Context, *GetMeetingOccurrencesReq) (*GetMeetingOccurrencesResp, error)
+	// Books a meeting repeating by an RRULE.
+	BookRecurringMeeting(context.Context, *BookRecurringMeetingReq) (*meeting.BookMeetingResp, error)
+	// Replaces the recurrence of a meeting with an RRULE and exception dates.
+	UpdateMeetingRecurrence(context.Context, *UpdateMeetingRecurrenceReq) (*UpdateMeetingRecurrenceResp, error)
+	// Gets the effective recurrence of a meeting.
+	GetMeetingRecurrence(context.Context, *GetMeetingRecurrenceReq) (*GetMeetingRecurrenceResp, error)
+	// Reschedules one occurrence of a recurring meeting or changes its title or password.
+	UpdateMeetingOccurrence(context.Context, *UpdateMeetingOccurrenceReq) (*UpdateMeetingOccurrenceResp, error)
+	// Cancels one occurrence of a recurring meeting.
+	CancelMeetingOccurrence(context.Context, *CancelMeetingOccurrenceReq) (*CancelMeetingOccurrenceResp, error)
+	// Exports a meeting as an iCalendar.
+	GetMeetingICalendar(context.Context, *GetMeetingICalendarReq) (*GetMeetingICalendarResp, error)
+	// Gets or renews the calendar feed token of the user.
+	GetCalendarFeedToken(context.Context, *GetCalendarFeedTokenReq) (*GetCalendarFeedTokenResp, error)
+	// Gets the calendar feed by the feed token.
+	GetCalendarFeed(context.Context, *GetCalendarFeedReq) (*GetCalendarFeedResp, error)
+	// Invites users to a meeting.
+	AddMeetingInvitees(context.Context, *AddMeetingInviteesReq) (*AddMeetingInviteesResp, error)
+	// Withdraws the invitations of users.
+	RemoveMeetingInvitees(context.Context, *RemoveMeetingInviteesReq) (*RemoveMeetingInviteesResp, error)
+	// Responds the invitation of a meeting.
+	RespondMeetingInvitation(context.Context, *RespondMeetingInvitationReq) (*RespondMeetingInvitationResp, error)
+	// Gets the invitees of a meeting.
+	GetMeetingInvitees(context.Context, *GetMeetingInviteesReq) (*GetMeetingInviteesResp, error)
+	// Gets the meetings the user is invited to.
+	GetInvitedMeetings(context.Context, *GetInvitedMeetingsReq) (*GetInvitedMeetingsResp, error)
+	// Verifies a LiveKit server webhook and applies the room or participant event.
+	HandleLiveKitWebhook(context.Context, *HandleLiveKitWebhookReq) (*HandleLiveKitWebhookResp, error)
+	// Gets who attended the meeting and for how long.
+	GetMeetingAttendance(context.Context, *GetMeetingAttendanceReq) (*GetMeetingAttendanceResp, error)
+	// Turns the waiting room of a meeting on or off.
+	SetMeetingWaitingRoom(context.Context, *SetMeetingWaitingRoomReq) (*SetMeetingWaitingRoomResp, error)
+	// Gets the users in the waiting room.
+	GetWaitingRoom(context.Context, *GetWaitingRoomReq) (*GetWaitingRoomResp, error)
+	// Admits users in the waiting room into the meeting.
+	AdmitWaitingUsers(context.Context, *RespondWaitingUsersReq) (*RespondWaitingUsersResp, error)
+	// Denies users in the waiting room.
+	DenyWaitingUsers(context.Context, *RespondWaitingUsersReq) (*RespondWaitingUsersResp, error)
+	// Long-polls the admission of a waiting user, the token is returned once admitted.
+	GetWaitingStatus(context.Context, *GetWaitingStatusReq) (*GetWaitingStatusResp, error)
+	// Locks a meeting in progress so that new participants could not join, or unlocks it.
+	SetMeetingLock(context.Context, *SetMeetingLockReq) (*SetMeetingLockResp, error)
+	// Sets the participant cap, the empty room timeout and the maximum duration of a meeting.
+	SetMeetingLimit(context.Context, *SetMeetingLimitReq) (*SetMeetingLimitResp, error)
+	// Gets the limits of a meeting.
+	GetMeetingLimit(context.Context, *GetMeetingLimitReq) (*GetMeetingLimitResp, error)
+	// Grants a role to participants of a meeting in progress.
+	GrantMeetingRole(context.Context, *GrantMeetingRoleReq) (*GrantMeetingRoleResp, error)
+	// Revokes the roles of participants, who become attendees.
+	RevokeMeetingRole(context.Context, *RevokeMeetingRoleReq) (*RevokeMeetingRoleResp, error)
+	// Gets the roles of the participants and the permissions of the roles.
+	GetMeetingRoles(context.Context, *GetMeetingRolesReq) (*GetMeetingRolesResp, error)
+	// Raises the hand of the user to ask to speak.
+	RaiseHand(context.Context, *RaiseHandReq) (*RaiseHandResp, error)
+	// Lowers a raised hand.
+	LowerHand(context.Context, *LowerHandReq) (*LowerHandResp, error)
+	// Lets a participant in the speaking queue speak.
+	ApproveRaisedHand(context.Context, *ApproveRaisedHandReq) (*ApproveRaisedHandResp, error)
+	// Clears the speaking queue.
+	ClearRaisedHands(context.Context, *ClearRaisedHandsReq) (*ClearRaisedHandsResp, error)
+	// Sends a chat message, which is kept after the meeting.
+	SendMeetingChatMessage(context.Context, *SendMeetingChatMessageReq) (*SendMeetingChatMessageResp, error)
+	// Gets the chat history of a meeting page by page.
+	GetMeetingChatMessages(context.Context, *GetMeetingChatMessagesReq) (*GetMeetingChatMessagesResp, error)
+	// Starts recording a meeting in progress into a file, host only.
+	StartMeetingRecording(context.Context, *StartMeetingRecordingReq) (*StartMeetingRecordingResp, error)
+	// Stops the recordings of a meeting in progress, host only.
+	StopMeetingRecording(context.Context, *StopMeetingRecordingReq) (*StopMeetingRecordingResp, error)
+	// Gets the recordings of a meeting page by page.
+	GetMeetingRecordings(context.Context, *GetMeetingRecordingsReq) (*GetMeetingRecordingsResp, error)
+	// Adds RTMP outputs to the live stream of a meeting in progress, host only.
+	AddMeetingStreamOutputs(context.Context, *AddMeetingStreamOutputsReq) (*AddMeetingStreamOutputsResp, error)
+	// Removes RTMP outputs from the live stream of a meeting in progress, host only.
+	RemoveMeetingStreamOutputs(context.Context, *RemoveMeetingStreamOutputsReq) (*RemoveMeetingStreamOutputsResp, error)
+	// Gets the phone numbers and the PIN to dial in to a meeting, the PIN is allocated at the first time.
+	GetMeetingDialIn(context.Context, *GetMeetingDialInReq) (*GetMeetingDialInResp, error)
+	// Splits a meeting in progress into breakout rooms, host only.
+	StartBreakoutRooms(context.Context, *StartBreakoutRoomsReq) (*StartBreakoutRoomsResp, error)
+	// Moves participants to a breakout room, host only.
+	AssignBreakoutRoom(context.Context, *AssignBreakoutRoomReq) (*AssignBreakoutRoomResp, error)
+	// Gets the token to join a breakout room of a meeting.
+	JoinBreakoutRoom(context.Context, *JoinBreakoutRoomReq) (*JoinBreakoutRoomResp, error)
+	// Broadcasts a message to all the breakout rooms of a meeting, host only.
+	BroadcastBreakoutMessage(context.Context, *BroadcastBreakoutMessageReq) (*BroadcastBreakoutMessageResp, error)
+	// Closes the breakout rooms of a meeting and pulls everyone back to the main room, host only.
+	CloseBreakoutRooms(context.Context, *CloseBreakoutRoomsReq) (*CloseBreakoutRoomsResp, error)
+}
+
+// UnimplementedMeetingExtServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedMeetingExtServiceServer struct {
+}
+
+func (UnimplementedMeetingExtServiceServer) GetMeetingOccurrences(context.Context, *GetMeetingOccurrencesReq) (*GetMeetingOccurrencesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMeetingOccurrences not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) BookRecurringMeeting(context.Context, *BookRecurringMeetingReq) (*meeting.BookMeetingResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BookRecurringMeeting not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) UpdateMeetingRecurrence(context.Context, *UpdateMeetingRecurrenceReq) (*UpdateMeetingRecurrenceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMeetingRecurrence not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) GetMeetingRecurrence(context.Context, *GetMeetingRecurrenceReq) (*GetMeetingRecurrenceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMeetingRecurrence not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) UpdateMeetingOccurrence(context.Context, *UpdateMeetingOccurrenceReq) (*UpdateMeetingOccurrenceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMeetingOccurrence not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) CancelMeetingOccurrence(context.Context, *CancelMeetingOccurrenceReq) (*CancelMeetingOccurrenceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelMeetingOccurrence not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) GetMeetingICalendar(context.Context, *GetMeetingICalendarReq) (*GetMeetingICalendarResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMeetingICalendar not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) GetCalendarFeedToken(context.Context, *GetCalendarFeedTokenReq) (*GetCalendarFeedTokenResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendarFeedToken not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) GetCalendarFeed(context.Context, *GetCalendarFeedReq) (*GetCalendarFeedResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendarFeed not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) AddMeetingInvitees(context.Context, *AddMeetingInviteesReq) (*AddMeetingInviteesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMeetingInvitees not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) RemoveMeetingInvitees(context.Context, *RemoveMeetingInviteesReq) (*RemoveMeetingInviteesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMeetingInvitees not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) RespondMeetingInvitation(context.Context, *RespondMeetingInvitationReq) (*RespondMeetingInvitationResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondMeetingInvitation not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) GetMeetingInvitees(context.Context, *GetMeetingInviteesReq) (*GetMeetingInviteesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMeetingInvitees not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) GetInvitedMeetings(context.Context, *GetInvitedMeetingsReq) (*GetInvitedMeetingsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvitedMeetings not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) HandleLiveKitWebhook(context.Context, *HandleLiveKitWebhookReq) (*HandleLiveKitWebhookResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleLiveKitWebhook not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) GetMeetingAttendance(context.Context, *GetMeetingAttendanceReq) (*GetMeetingAttendanceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMeetingAttendance not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) SetMeetingWaitingRoom(context.Context, *SetMeetingWaitingRoomReq) (*SetMeetingWaitingRoomResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMeetingWaitingRoom not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) GetWaitingRoom(context.Context, *GetWaitingRoomReq) (*GetWaitingRoomResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWaitingRoom not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) AdmitWaitingUsers(context.Context, *RespondWaitingUsersReq) (*RespondWaitingUsersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdmitWaitingUsers not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) DenyWaitingUsers(context.Context, *RespondWaitingUsersReq) (*RespondWaitingUsersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenyWaitingUsers not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) GetWaitingStatus(context.Context, *GetWaitingStatusReq) (*GetWaitingStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWaitingStatus not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) SetMeetingLock(context.Context, *SetMeetingLockReq) (*SetMeetingLockResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMeetingLock not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) SetMeetingLimit(context.Context, *SetMeetingLimitReq) (*SetMeetingLimitResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMeetingLimit not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) GetMeetingLimit(context.Context, *GetMeetingLimitReq) (*GetMeetingLimitResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMeetingLimit not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) GrantMeetingRole(context.Context, *GrantMeetingRoleReq) (*GrantMeetingRoleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantMeetingRole not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) RevokeMeetingRole(context.Context, *RevokeMeetingRoleReq) (*RevokeMeetingRoleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeMeetingRole not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) GetMeetingRoles(context.Context, *GetMeetingRolesReq) (*GetMeetingRolesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMeetingRoles not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) RaiseHand(context.Context, *RaiseHandReq) (*RaiseHandResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RaiseHand not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) LowerHand(context.Context, *LowerHandReq) (*LowerHandResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LowerHand not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) ApproveRaisedHand(context.Context, *ApproveRaisedHandReq) (*ApproveRaisedHandResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveRaisedHand not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) ClearRaisedHands(context.Context, *ClearRaisedHandsReq) (*ClearRaisedHandsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearRaisedHands not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) SendMeetingChatMessage(context.Context, *SendMeetingChatMessageReq) (*SendMeetingChatMessageResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendMeetingChatMessage not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) GetMeetingChatMessages(context.Context, *GetMeetingChatMessagesReq) (*GetMeetingChatMessagesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMeetingChatMessages not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) StartMeetingRecording(context.Context, *StartMeetingRecordingReq) (*StartMeetingRecordingResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartMeetingRecording not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) StopMeetingRecording(context.Context, *StopMeetingRecordingReq) (*StopMeetingRecordingResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopMeetingRecording not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) GetMeetingRecordings(context.Context, *GetMeetingRecordingsReq) (*GetMeetingRecordingsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMeetingRecordings not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) AddMeetingStreamOutputs(context.Context, *AddMeetingStreamOutputsReq) (*AddMeetingStreamOutputsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMeetingStreamOutputs not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) RemoveMeetingStreamOutputs(context.Context, *RemoveMeetingStreamOutputsReq) (*RemoveMeetingStreamOutputsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMeetingStreamOutputs not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) GetMeetingDialIn(context.Context, *GetMeetingDialInReq) (*GetMeetingDialInResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMeetingDialIn not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) StartBreakoutRooms(context.Context, *StartBreakoutRoomsReq) (*StartBreakoutRoomsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartBreakoutRooms not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) AssignBreakoutRoom(context.Context, *AssignBreakoutRoomReq) (*AssignBreakoutRoomResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignBreakoutRoom not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) JoinBreakoutRoom(context.Context, *JoinBreakoutRoomReq) (*JoinBreakoutRoomResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinBreakoutRoom not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) BroadcastBreakoutMessage(context.Context, *BroadcastBreakoutMessageReq) (*BroadcastBreakoutMessageResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BroadcastBreakoutMessage not implemented")
+}
+func (UnimplementedMeetingExtServiceServer) CloseBreakoutRooms(context.Context, *CloseBreakoutRoomsReq) (*CloseBreakoutRoomsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseBreakoutRooms not implemented")
+}
+
+// UnsafeMeetingExtServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MeetingExtServiceServer will
+// result in compilation errors.
+type UnsafeMeetingExtServiceServer interface {
+	mustEmbedUnimplementedMeetingExtServiceServer()
+}
+
+func RegisterMeetingExtServiceServer(s grpc.ServiceRegistrar, srv MeetingExtServiceServer) {
+	s.RegisterService(&MeetingExtService_ServiceDesc, srv)
+}
+
+func _MeetingExtService_GetMeetingOccurrences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMeetingOccurrencesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).GetMeetingOccurrences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_GetMeetingOccurrences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).GetMeetingOccurrences(ctx, req.(*GetMeetingOccurrencesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_BookRecurringMeeting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookRecurringMeetingReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).BookRecurringMeeting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_BookRecurringMeeting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).BookRecurringMeeting(ctx, req.(*BookRecurringMeetingReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_UpdateMeetingRecurrence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMeetingRecurrenceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).UpdateMeetingRecurrence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_UpdateMeetingRecurrence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).UpdateMeetingRecurrence(ctx, req.(*UpdateMeetingRecurrenceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_GetMeetingRecurrence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMeetingRecurrenceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).GetMeetingRecurrence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_GetMeetingRecurrence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).GetMeetingRecurrence(ctx, req.(*GetMeetingRecurrenceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_UpdateMeetingOccurrence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMeetingOccurrenceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).UpdateMeetingOccurrence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_UpdateMeetingOccurrence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).UpdateMeetingOccurrence(ctx, req.(*UpdateMeetingOccurrenceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_CancelMeetingOccurrence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelMeetingOccurrenceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).CancelMeetingOccurrence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_CancelMeetingOccurrence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).CancelMeetingOccurrence(ctx, req.(*CancelMeetingOccurrenceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_GetMeetingICalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMeetingICalendarReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).GetMeetingICalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_GetMeetingICalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).GetMeetingICalendar(ctx, req.(*GetMeetingICalendarReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_GetCalendarFeedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCalendarFeedTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).GetCalendarFeedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_GetCalendarFeedToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).GetCalendarFeedToken(ctx, req.(*GetCalendarFeedTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_GetCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCalendarFeedReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).GetCalendarFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_GetCalendarFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).GetCalendarFeed(ctx, req.(*GetCalendarFeedReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_AddMeetingInvitees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMeetingInviteesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).AddMeetingInvitees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_AddMeetingInvitees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).AddMeetingInvitees(ctx, req.(*AddMeetingInviteesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_RemoveMeetingInvitees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMeetingInviteesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).RemoveMeetingInvitees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_RemoveMeetingInvitees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).RemoveMeetingInvitees(ctx, req.(*RemoveMeetingInviteesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_RespondMeetingInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondMeetingInvitationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).RespondMeetingInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_RespondMeetingInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).RespondMeetingInvitation(ctx, req.(*RespondMeetingInvitationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_GetMeetingInvitees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMeetingInviteesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).GetMeetingInvitees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_GetMeetingInvitees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).GetMeetingInvitees(ctx, req.(*GetMeetingInviteesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_GetInvitedMeetings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvitedMeetingsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).GetInvitedMeetings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_GetInvitedMeetings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).GetInvitedMeetings(ctx, req.(*GetInvitedMeetingsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_HandleLiveKitWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandleLiveKitWebhookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).HandleLiveKitWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_HandleLiveKitWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).HandleLiveKitWebhook(ctx, req.(*HandleLiveKitWebhookReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_GetMeetingAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMeetingAttendanceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).GetMeetingAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_GetMeetingAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).GetMeetingAttendance(ctx, req.(*GetMeetingAttendanceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_SetMeetingWaitingRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMeetingWaitingRoomReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).SetMeetingWaitingRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_SetMeetingWaitingRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).SetMeetingWaitingRoom(ctx, req.(*SetMeetingWaitingRoomReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_GetWaitingRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWaitingRoomReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).GetWaitingRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_GetWaitingRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).GetWaitingRoom(ctx, req.(*GetWaitingRoomReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_AdmitWaitingUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondWaitingUsersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).AdmitWaitingUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_AdmitWaitingUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).AdmitWaitingUsers(ctx, req.(*RespondWaitingUsersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_DenyWaitingUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondWaitingUsersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).DenyWaitingUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_DenyWaitingUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).DenyWaitingUsers(ctx, req.(*RespondWaitingUsersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_GetWaitingStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWaitingStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).GetWaitingStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_GetWaitingStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).GetWaitingStatus(ctx, req.(*GetWaitingStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_SetMeetingLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMeetingLockReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).SetMeetingLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_SetMeetingLock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).SetMeetingLock(ctx, req.(*SetMeetingLockReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_SetMeetingLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMeetingLimitReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).SetMeetingLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_SetMeetingLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).SetMeetingLimit(ctx, req.(*SetMeetingLimitReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_GetMeetingLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMeetingLimitReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).GetMeetingLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_GetMeetingLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).GetMeetingLimit(ctx, req.(*GetMeetingLimitReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_GrantMeetingRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantMeetingRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).GrantMeetingRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_GrantMeetingRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).GrantMeetingRole(ctx, req.(*GrantMeetingRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_RevokeMeetingRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeMeetingRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).RevokeMeetingRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_RevokeMeetingRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).RevokeMeetingRole(ctx, req.(*RevokeMeetingRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_GetMeetingRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMeetingRolesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).GetMeetingRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_GetMeetingRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).GetMeetingRoles(ctx, req.(*GetMeetingRolesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_RaiseHand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RaiseHandReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).RaiseHand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_RaiseHand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).RaiseHand(ctx, req.(*RaiseHandReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_LowerHand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LowerHandReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).LowerHand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_LowerHand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).LowerHand(ctx, req.(*LowerHandReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_ApproveRaisedHand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveRaisedHandReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).ApproveRaisedHand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_ApproveRaisedHand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).ApproveRaisedHand(ctx, req.(*ApproveRaisedHandReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_ClearRaisedHands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearRaisedHandsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).ClearRaisedHands(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_ClearRaisedHands_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).ClearRaisedHands(ctx, req.(*ClearRaisedHandsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_SendMeetingChatMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendMeetingChatMessageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).SendMeetingChatMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_SendMeetingChatMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).SendMeetingChatMessage(ctx, req.(*SendMeetingChatMessageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_GetMeetingChatMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMeetingChatMessagesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).GetMeetingChatMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_GetMeetingChatMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).GetMeetingChatMessages(ctx, req.(*GetMeetingChatMessagesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_StartMeetingRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartMeetingRecordingReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).StartMeetingRecording(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_StartMeetingRecording_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).StartMeetingRecording(ctx, req.(*StartMeetingRecordingReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_StopMeetingRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopMeetingRecordingReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).StopMeetingRecording(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_StopMeetingRecording_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).StopMeetingRecording(ctx, req.(*StopMeetingRecordingReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_GetMeetingRecordings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMeetingRecordingsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).GetMeetingRecordings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_GetMeetingRecordings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).GetMeetingRecordings(ctx, req.(*GetMeetingRecordingsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_AddMeetingStreamOutputs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMeetingStreamOutputsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).AddMeetingStreamOutputs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_AddMeetingStreamOutputs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).AddMeetingStreamOutputs(ctx, req.(*AddMeetingStreamOutputsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_RemoveMeetingStreamOutputs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMeetingStreamOutputsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).RemoveMeetingStreamOutputs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_RemoveMeetingStreamOutputs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).RemoveMeetingStreamOutputs(ctx, req.(*RemoveMeetingStreamOutputsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_GetMeetingDialIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMeetingDialInReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).GetMeetingDialIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_GetMeetingDialIn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).GetMeetingDialIn(ctx, req.(*GetMeetingDialInReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_StartBreakoutRooms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartBreakoutRoomsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).StartBreakoutRooms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_StartBreakoutRooms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).StartBreakoutRooms(ctx, req.(*StartBreakoutRoomsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_AssignBreakoutRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignBreakoutRoomReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).AssignBreakoutRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_AssignBreakoutRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).AssignBreakoutRoom(ctx, req.(*AssignBreakoutRoomReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_JoinBreakoutRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinBreakoutRoomReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).JoinBreakoutRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_JoinBreakoutRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).JoinBreakoutRoom(ctx, req.(*JoinBreakoutRoomReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_BroadcastBreakoutMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BroadcastBreakoutMessageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).BroadcastBreakoutMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_BroadcastBreakoutMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).BroadcastBreakoutMessage(ctx, req.(*BroadcastBreakoutMessageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingExtService_CloseBreakoutRooms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseBreakoutRoomsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingExtServiceServer).CloseBreakoutRooms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingExtService_CloseBreakoutRooms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingExtServiceServer).CloseBreakoutRooms(ctx, req.(*CloseBreakoutRoomsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MeetingExtService_ServiceDesc is the grpc.ServiceDesc for MeetingExtService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MeetingExtService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openmeeting.meetingext.MeetingExtService",
+	HandlerType: (*MeetingExtServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMeetingOccurrences",
+			Handler:    _MeetingExtService_GetMeetingOccurrences_Handler,
+		},
+		{
+			MethodName: "BookRecurringMeeting",
+			Handler:    _MeetingExtService_BookRecurringMeeting_Handler,
+		},
+		{
+			MethodName: "UpdateMeetingRecurrence",
+			Handler:    _MeetingExtService_UpdateMeetingRecurrence_Handler,
+		},
+		{
+			MethodName: "GetMeetingRecurrence",
+			Handler:    _MeetingExtService_GetMeetingRecurrence_Handler,
+		},
+		{
+			MethodName: "UpdateMeetingOccurrence",
+			Handler:    _MeetingExtService_UpdateMeetingOccurrence_Handler,
+		},
+		{
+			MethodName: "CancelMeetingOccurrence",
+			Handler:    _MeetingExtService_CancelMeetingOccurrence_Handler,
+		},
+		{
+			MethodName: "GetMeetingICalendar",
+			Handler:    _MeetingExtService_GetMeetingICalendar_Handler,
+		},
+		{
+			MethodName: "GetCalendarFeedToken",
+			Handler:    _MeetingExtService_GetCalendarFeedToken_Handler,
+		},
+		{
+			MethodName: "GetCalendarFeed",
+			Handler:    _MeetingExtService_GetCalendarFeed_Handler,
+		},
+		{
+			MethodName: "AddMeetingInvitees",
+			Handler:    _MeetingExtService_AddMeetingInvitees_Handler,
+		},
+		{
+			MethodName: "RemoveMeetingInvitees",
+			Handler:    _MeetingExtService_RemoveMeetingInvitees_Handler,
+		},
+		{
+			MethodName: "RespondMeetingInvitation",
+			Handler:    _MeetingExtService_RespondMeetingInvitation_Handler,
+		},
+		{
+			MethodName: "GetMeetingInvitees",
+			Handler:    _MeetingExtService_GetMeetingInvitees_Handler,
+		},
+		{
+			MethodName: "GetInvitedMeetings",
+			Handler:    _MeetingExtService_GetInvitedMeetings_Handler,
+		},
+		{
+			MethodName: "HandleLiveKitWebhook",
+			Handler:    _MeetingExtService_HandleLiveKitWebhook_Handler,
+		},
+		{
+			MethodName: "GetMeetingAttendance",
+			Handler:    _MeetingExtService_GetMeetingAttendance_Handler,
+		},
+		{
+			MethodName: "SetMeetingWaitingRoom",
+			Handler:    _MeetingExtService_SetMeetingWaitingRoom_Handler,
+		},
+		{
+			MethodName: "GetWaitingRoom",
+			Handler:    _MeetingExtService_GetWaitingRoom_Handler,
+		},
+		{
+			MethodName: "AdmitWaitingUsers",
+			Handler:    _MeetingExtService_AdmitWaitingUsers_Handler,
+		},
+		{
+			MethodName: "DenyWaitingUsers",
+			Handler:    _MeetingExtService_DenyWaitingUsers_Handler,
+		},
+		{
+			MethodName: "GetWaitingStatus",
+			Handler:    _MeetingExtService_GetWaitingStatus_Handler,
+		},
+		{
+			MethodName: "SetMeetingLock",
+			Handler:    _MeetingExtService_SetMeetingLock_Handler,
+		},
+		{
+			MethodName: "SetMeetingLimit",
+			Handler:    _MeetingExtService_SetMeetingLimit_Handler,
+		},
+		{
+			MethodName: "GetMeetingLimit",
+			Handler:    _MeetingExtService_GetMeetingLimit_Handler,
+		},
+		{
+			MethodName: "GrantMeetingRole",
+			Handler:    _MeetingExtService_GrantMeetingRole_Handler,
+		},
+		{
+			MethodName: "RevokeMeetingRole",
+			Handler:    _MeetingExtService_RevokeMeetingRole_Handler,
+		},
+		{
+			MethodName: "GetMeetingRoles",
+			Handler:    _MeetingExtService_GetMeetingRoles_Handler,
+		},
+		{
+			MethodName: "RaiseHand",
+			Handler:    _MeetingExtService_RaiseHand_Handler,
+		},
+		{
+			MethodName: "LowerHand",
+			Handler:    _MeetingExtService_LowerHand_Handler,
+		},
+		{
+			MethodName: "ApproveRaisedHand",
+			Handler:    _MeetingExtService_ApproveRaisedHand_Handler,
+		},
+		{
+			MethodName: "ClearRaisedHands",
+			Handler:    _MeetingExtService_ClearRaisedHands_Handler,
+		},
+		{
+			MethodName: "SendMeetingChatMessage",
+			Handler:    _MeetingExtService_SendMeetingChatMessage_Handler,
+		},
+		{
+			MethodName: "GetMeetingChatMessages",
+			Handler:    _MeetingExtService_GetMeetingChatMessages_Handler,
+		},
+		{
+			MethodName: "StartMeetingRecording",
+			Handler:    _MeetingExtService_StartMeetingRecording_Handler,
+		},
+		{
+			MethodName: "StopMeetingRecording",
+			Handler:    _MeetingExtService_StopMeetingRecording_Handler,
+		},
+		{
+			MethodName: "GetMeetingRecordings",
+			Handler:    _MeetingExtService_GetMeetingRecordings_Handler,
+		},
+		{
+			MethodName: "AddMeetingStreamOutputs",
+			Handler:    _MeetingExtService_AddMeetingStreamOutputs_Handler,
+		},
+		{
+			MethodName: "RemoveMeetingStreamOutputs",
+			Handler:    _MeetingExtService_RemoveMeetingStreamOutputs_Handler,
+		},
+		{
+			MethodName: "GetMeetingDialIn",
+			Handler:    _MeetingExtService_GetMeetingDialIn_Handler,
+		},
+		{
+			MethodName: "StartBreakoutRooms",
+			Handler:    _MeetingExtService_StartBreakoutRooms_Handler,
+		},
+		{
+			MethodName: "AssignBreakoutRoom",
+			Handler:    _MeetingExtService_AssignBreakoutRoom_Handler,
+		},
+		{
+			MethodName: "JoinBreakoutRoom",
+			Handler:    _MeetingExtService_JoinBreakoutRoom_Handler,
+		},
+		{
+			MethodName: "BroadcastBreakoutMessage",
+			Handler:    _MeetingExtService_BroadcastBreakoutMessage_Handler,
+		},
+		{
+			MethodName: "CloseBreakoutRooms",
+			Handler:    _MeetingExtService_CloseBreakoutRooms_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "meetingext/meetingext.proto",
+}
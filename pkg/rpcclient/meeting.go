@@ -2,6 +2,7 @@ package rpcclient
 
 import (
 	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/protocol/meetingext"
 	"github.com/openimsdk/protocol/openmeeting/meeting"
 	"github.com/openimsdk/tools/discovery"
 	"github.com/openimsdk/tools/system/program"
@@ -11,6 +12,7 @@ import (
 type Meeting struct {
 	conn      grpc.ClientConnInterface
 	Client    meeting.MeetingServiceClient
+	ExtClient meetingext.MeetingExtServiceClient
 	Discovery discovery.SvcDiscoveryRegistry
 }
 
@@ -22,6 +24,7 @@ func NewMeeting(discovery discovery.SvcDiscoveryRegistry, rpcRegisterName string
 	}
 	client := meeting.NewMeetingServiceClient(conn)
 	return &Meeting{Discovery: discovery, Client: client,
-		conn: conn,
+		ExtClient: meetingext.NewMeetingExtServiceClient(conn),
+		conn:      conn,
 	}
 }
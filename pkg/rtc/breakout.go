@@ -0,0 +1,22 @@
+package rtc
+
+import (
+	"strings"
+)
+
+// breakoutRoomSeparator the meeting IDs are numeric, so the separator never appears in the room of a meeting
+const breakoutRoomSeparator = "-breakout-"
+
+// BreakoutRoomID the breakout rooms are named after the meeting they belong to
+func BreakoutRoomID(meetingID, breakoutID string) string {
+	return meetingID + breakoutRoomSeparator + breakoutID
+}
+
+// ParseBreakoutRoomID ok is false if the room is the room of a meeting rather than one of its breakout rooms
+func ParseBreakoutRoomID(roomID string) (meetingID, breakoutID string, ok bool) {
+	meetingID, breakoutID, ok = strings.Cut(roomID, breakoutRoomSeparator)
+	if !ok || meetingID == "" || breakoutID == "" {
+		return "", "", false
+	}
+	return meetingID, breakoutID, true
+}
@@ -7,7 +7,11 @@ import (
 	"github.com/openimsdk/tools/log"
 )
 
+// HostChangedFunc is notified of the host handed over by the room callback.
+type HostChangedFunc func(ctx context.Context, previousHostUserID, hostUserID string)
+
 type CallbackInterface interface {
+	OnRoomStarted(ctx context.Context)
 	OnRoomParticipantConnected(ctx context.Context, userID string)
 	OnRoomParticipantDisconnected(ctx context.Context, userID string)
 	OnRoomDisconnected(ctx context.Context)
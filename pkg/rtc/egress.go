@@ -0,0 +1,63 @@
+package rtc
+
+import (
+	"github.com/livekit/protocol/livekit"
+	"github.com/openimsdk/openmeeting-server/pkg/common/constant"
+	"time"
+)
+
+// NewRecording converts the egress info returned by the egress service or carried by the webhook of the LiveKit server.
+func NewRecording(info *livekit.EgressInfo) *Recording {
+	recording := &Recording{
+		RecordingID: info.GetEgressId(),
+		RoomID:      info.GetRoomName(),
+		Status:      egressStatus(info.GetStatus()),
+		StartTime:   nanoToSecond(info.GetStartedAt()),
+		EndTime:     nanoToSecond(info.GetEndedAt()),
+		Error:       info.GetError(),
+	}
+	for _, file := range info.GetFileResults() {
+		recording.Files = append(recording.Files, &RecordingFile{
+			Filename: file.GetFilename(),
+			Location: file.GetLocation(),
+			Size:     file.GetSize(),
+			Duration: nanoToSecond(file.GetDuration()),
+		})
+	}
+	return recording
+}
+
+// NewStream converts the egress info of the stream returned by the egress service or carried by the webhook of the LiveKit server.
+func NewStream(info *livekit.EgressInfo) *Stream {
+	return &Stream{
+		StreamID: info.GetEgressId(),
+		RoomID:   info.GetRoomName(),
+		Status:   egressStatus(info.GetStatus()),
+		Error:    info.GetError(),
+	}
+}
+
+// IsStream the egress streams the room rather than records it into files
+func IsStream(info *livekit.EgressInfo) bool {
+	return len(info.GetRoomComposite().GetStreamOutputs()) > 0 || len(info.GetStreamResults()) > 0
+}
+
+func egressStatus(status livekit.EgressStatus) string {
+	switch status {
+	case livekit.EgressStatus_EGRESS_STARTING:
+		return constant.RecordingStatusStarting
+	case livekit.EgressStatus_EGRESS_ACTIVE:
+		return constant.RecordingStatusActive
+	case livekit.EgressStatus_EGRESS_ENDING:
+		return constant.RecordingStatusEnding
+	case livekit.EgressStatus_EGRESS_COMPLETE:
+		return constant.RecordingStatusComplete
+	default:
+		return constant.RecordingStatusFailed
+	}
+}
+
+// nanoToSecond the times of the egress service are in nanoseconds
+func nanoToSecond(nano int64) int64 {
+	return nano / int64(time.Second)
+}
@@ -3,85 +3,107 @@ package livekit
 import (
 	"context"
 	"github.com/openimsdk/openmeeting-server/pkg/rtc"
+	"github.com/openimsdk/openmeeting-server/pkg/webhook"
+	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
 )
 
 type CallbackLiveKit struct {
-	roomID  string
-	liveKit *LiveKit
+	roomID        string
+	liveKit       *LiveKit
+	onHostChanged rtc.HostChangedFunc
 }
 
-func NewRTC(roomID string, liveKit *LiveKit) rtc.CallbackInterface {
+func NewRTC(roomID string, liveKit *LiveKit, onHostChanged rtc.HostChangedFunc) rtc.CallbackInterface {
 	return &CallbackLiveKit{
-		roomID:  roomID,
-		liveKit: liveKit,
+		roomID:        roomID,
+		liveKit:       liveKit,
+		onHostChanged: onHostChanged,
 	}
 }
 
+// hostChanged notifies the host handed over by the callback.
+func (r *CallbackLiveKit) hostChanged(ctx context.Context, previousHostUserID, hostUserID string) {
+	if r.onHostChanged != nil {
+		r.onHostChanged(ctx, previousHostUserID, hostUserID)
+	}
+}
+
+func (r *CallbackLiveKit) OnRoomStarted(ctx context.Context) {
+	log.ZDebug(ctx, "OnRoomStarted", "roomID:", r.roomID)
+}
+
 func (r *CallbackLiveKit) OnRoomParticipantConnected(ctx context.Context, userID string) {
 	log.ZDebug(ctx, "OnRoomParticipantConnected", "roomID:", r.roomID, "userID:", userID)
+	r.liveKit.publishEvent(ctx, &webhook.Event{Type: webhook.EventParticipantJoined, MeetingID: r.roomID, UserIDs: []string{userID}})
 	// set default host when the first one coming in
-	metaData, err := r.liveKit.GetRoomData(ctx, r.roomID)
-	if err != nil {
-		return
-	}
-	hostUserID := metaData.Detail.Info.CreatorDefinedMeeting.HostUserID
-
 	participants, err := r.liveKit.ListParticipants(ctx, r.roomID)
 	if err != nil {
 		return
 	}
-	log.ZDebug(ctx, "OnRoomParticipantConnected",
-		"room participant number:", len(participants),
-		"hostID", hostUserID)
+	var previousHostUserID, newHostUserID string
+	err = r.liveKit.ModifyRoomData(ctx, r.roomID, func(data *rtc.RoomData) (bool, error) {
+		newHostUserID = ""
+		hostUserID := data.MetaData.Detail.Info.CreatorDefinedMeeting.HostUserID
+		creatorUserID := data.MetaData.Detail.Info.SystemGenerated.CreatorUserID
+		log.ZDebug(ctx, "OnRoomParticipantConnected",
+			"room participant number:", len(participants),
+			"hostID", hostUserID)
 
-	// when first coming delete auto change host
-	if len(participants) == 2 {
+		// when first coming delete auto change host, no participant of the server joins the room
 		// when first comer is creator, he is not host, so set him as the host
-		if userID == metaData.Detail.Info.SystemGenerated.CreatorUserID && userID != hostUserID {
-			metaData.Detail.Info.CreatorDefinedMeeting.HostUserID = userID
+		if len(participants) == 1 && userID == creatorUserID && userID != hostUserID {
 			log.CInfo(ctx, "set host info as default when creator is the first one to come in",
-				"roomID:", r.roomID, "new host:", metaData.Detail.Info.SystemGenerated.CreatorUserID)
-			if err := r.liveKit.UpdateMetaData(ctx, metaData); err != nil {
-				log.ZError(ctx, "update meta room data change host info failed", err,
-					"new host:", metaData.Detail.Info.SystemGenerated.CreatorUserID)
-			}
+				"roomID:", r.roomID, "new host:", creatorUserID)
+		} else if hostUserID == "" {
+			log.CInfo(ctx, "set host info as default when last host is nil",
+				"roomID:", r.roomID, "new host:", creatorUserID)
+		} else {
+			return false, nil
 		}
+		data.MetaData.Detail.Info.CreatorDefinedMeeting.HostUserID = creatorUserID
+		previousHostUserID, newHostUserID = hostUserID, creatorUserID
+		return true, nil
+	})
+	if err != nil && !errs.ErrRecordNotFound.Is(err) {
+		log.ZError(ctx, "update meta room data change host info failed", err, "roomID", r.roomID)
 	}
-	if hostUserID == "" {
-		metaData.Detail.Info.CreatorDefinedMeeting.HostUserID = metaData.Detail.Info.SystemGenerated.CreatorUserID
-		log.CInfo(ctx, "set host info as default when last host is nil",
-			"roomID:", r.roomID, "new host:", metaData.Detail.Info.SystemGenerated.CreatorUserID)
-		if err := r.liveKit.UpdateMetaData(ctx, metaData); err != nil {
-			log.ZError(ctx, "update meta room data change host info failed", err,
-				"new host:", metaData.Detail.Info.SystemGenerated.CreatorUserID)
-		}
+	if err == nil && newHostUserID != "" {
+		r.hostChanged(ctx, previousHostUserID, newHostUserID)
 	}
 }
 
 func (r *CallbackLiveKit) OnRoomParticipantDisconnected(ctx context.Context, userID string) {
 	log.ZWarn(ctx, "OnRoomParticipantDisconnected", nil, "userID:", userID)
+	r.liveKit.publishEvent(ctx, &webhook.Event{Type: webhook.EventParticipantLeft, MeetingID: r.roomID, UserIDs: []string{userID}})
 	if err := r.liveKit.RemoveParticipant(ctx, r.roomID, userID); err != nil {
 		log.ZWarn(ctx, "remove participant failed", err)
 	}
 	// auto change host to creator
-	metaData, err := r.liveKit.GetRoomData(ctx, r.roomID)
-	if err != nil {
-		return
-	}
-	hostUserID := metaData.Detail.Info.CreatorDefinedMeeting.HostUserID
-	creatorUserID := metaData.Detail.Info.SystemGenerated.CreatorUserID
-	if hostUserID == userID && creatorUserID != hostUserID {
-		log.CInfo(ctx, "change host info when last host disconnected", "roomID:", r.roomID, "old host:", hostUserID, "default host:", creatorUserID)
-		metaData.Detail.Info.CreatorDefinedMeeting.HostUserID = creatorUserID
-		if err := r.liveKit.UpdateMetaData(ctx, metaData); err != nil {
-			log.ZError(ctx, "update meta room data change host info failed", err, "old host:", hostUserID, "default host:", creatorUserID)
+	var newHostUserID string
+	err := r.liveKit.ModifyRoomData(ctx, r.roomID, func(data *rtc.RoomData) (bool, error) {
+		newHostUserID = ""
+		hostUserID := data.MetaData.Detail.Info.CreatorDefinedMeeting.HostUserID
+		creatorUserID := data.MetaData.Detail.Info.SystemGenerated.CreatorUserID
+		if hostUserID != userID || creatorUserID == hostUserID {
+			return false, nil
 		}
+		log.CInfo(ctx, "change host info when last host disconnected", "roomID:", r.roomID, "old host:", hostUserID, "default host:", creatorUserID)
+		data.MetaData.Detail.Info.CreatorDefinedMeeting.HostUserID = creatorUserID
+		newHostUserID = creatorUserID
+		return true, nil
+	})
+	if err != nil && !errs.ErrRecordNotFound.Is(err) {
+		log.ZError(ctx, "update meta room data change host info failed", err, "roomID", r.roomID, "old host:", userID)
+	}
+	if err == nil && newHostUserID != "" {
+		r.hostChanged(ctx, userID, newHostUserID)
 	}
 }
 
 func (r *CallbackLiveKit) OnRoomDisconnected(ctx context.Context) {
 	log.ZWarn(ctx, "OnRoomDisconnected", nil, "roomID", r.roomID)
+	r.liveKit.publishEvent(ctx, &webhook.Event{Type: webhook.EventRoomFinished, MeetingID: r.roomID})
 	participants, err := r.liveKit.ListParticipants(ctx, r.roomID)
 	if err != nil {
 		log.ZWarn(ctx, "remove participant failed", err, r.roomID)
@@ -1,12 +1,30 @@
 package livekit
 
 import (
+	"context"
 	lksdk "github.com/livekit/server-sdk-go"
 	"github.com/openimsdk/openmeeting-server/pkg/common/config"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/cache"
+	"github.com/openimsdk/openmeeting-server/pkg/webhook"
 )
 
 type LiveKit struct {
 	roomClient *lksdk.RoomServiceClient
-	index      uint64
-	conf       *config.RTC
+	// egressClient records the rooms
+	egressClient *lksdk.EgressClient
+	// sipClient lets the phone participants dial in to the rooms
+	sipClient *lksdk.SIPClient
+	index     uint64
+	conf      *config.RTC
+	// publisher is nil if the outbound webhooks are disabled
+	publisher webhook.Publisher
+	// roomLock guards the version check and the write of the room metadata across the replicas
+	roomLock  cache.RoomLock
+	lockIndex uint64
+}
+
+func (x *LiveKit) publishEvent(ctx context.Context, event *webhook.Event) {
+	if x.publisher != nil {
+		x.publisher.Publish(ctx, event)
+	}
 }
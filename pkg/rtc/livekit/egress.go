@@ -0,0 +1,106 @@
+package livekit
+
+import (
+	"context"
+	"github.com/livekit/protocol/livekit"
+	"github.com/openimsdk/openmeeting-server/pkg/rtc"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+)
+
+// defaultRecordingLayout the layout of the composed video if it is not configured
+const defaultRecordingLayout = "grid"
+
+// StartRecording composes the room into a mp4 file, the storage of the file is configured on the egress server.
+func (x *LiveKit) StartRecording(ctx context.Context, roomID string) (*rtc.Recording, error) {
+	layout := x.conf.Recording.Layout
+	if layout == "" {
+		layout = defaultRecordingLayout
+	}
+	req := &livekit.RoomCompositeEgressRequest{
+		RoomName:  roomID,
+		Layout:    layout,
+		AudioOnly: x.conf.Recording.AudioOnly,
+		FileOutputs: []*livekit.EncodedFileOutput{{
+			FileType: livekit.EncodedFileType_MP4,
+			Filepath: x.conf.Recording.FilePath,
+		}},
+	}
+	info, err := x.egressClient.StartRoomCompositeEgress(ctx, req)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "start livekit room composite egress failed", "roomID", roomID)
+	}
+	log.ZInfo(ctx, "recording started", "roomID", roomID, "egressID", info.GetEgressId())
+	return rtc.NewRecording(info), nil
+}
+
+func (x *LiveKit) StopRecording(ctx context.Context, recordingID string) (*rtc.Recording, error) {
+	info, err := x.egressClient.StopEgress(ctx, &livekit.StopEgressRequest{EgressId: recordingID})
+	if err != nil {
+		if x.IsNotFound(err) {
+			return nil, errs.ErrRecordNotFound.WrapMsg("recording not found", "recordingID", recordingID)
+		}
+		return nil, errs.WrapMsg(err, "stop livekit egress failed", "egressID", recordingID)
+	}
+	return rtc.NewRecording(info), nil
+}
+
+func (x *LiveKit) ListRecordings(ctx context.Context, roomID string, active bool) ([]*rtc.Recording, error) {
+	resp, err := x.egressClient.ListEgress(ctx, &livekit.ListEgressRequest{RoomName: roomID, Active: active})
+	if err != nil {
+		return nil, errs.WrapMsg(err, "list livekit egress failed", "roomID", roomID)
+	}
+	recordings := make([]*rtc.Recording, 0, len(resp.GetItems()))
+	for _, info := range resp.GetItems() {
+		// the streams are not recordings
+		if _, ok := info.GetRequest().(*livekit.EgressInfo_RoomComposite); !ok || rtc.IsStream(info) {
+			continue
+		}
+		recordings = append(recordings, rtc.NewRecording(info))
+	}
+	return recordings, nil
+}
+
+// StartStream composes the room the same way as the recording and pushes it to the rtmp urls.
+func (x *LiveKit) StartStream(ctx context.Context, roomID string, urls []string) (*rtc.Stream, error) {
+	layout := x.conf.Recording.Layout
+	if layout == "" {
+		layout = defaultRecordingLayout
+	}
+	req := &livekit.RoomCompositeEgressRequest{
+		RoomName: roomID,
+		Layout:   layout,
+		StreamOutputs: []*livekit.StreamOutput{{
+			Protocol: livekit.StreamProtocol_RTMP,
+			Urls:     urls,
+		}},
+	}
+	info, err := x.egressClient.StartRoomCompositeEgress(ctx, req)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "start livekit stream egress failed", "roomID", roomID)
+	}
+	log.ZInfo(ctx, "stream started", "roomID", roomID, "egressID", info.GetEgressId())
+	return rtc.NewStream(info), nil
+}
+
+func (x *LiveKit) UpdateStream(ctx context.Context, streamID string, addURLs, removeURLs []string) (*rtc.Stream, error) {
+	req := &livekit.UpdateStreamRequest{EgressId: streamID, AddOutputUrls: addURLs, RemoveOutputUrls: removeURLs}
+	info, err := x.egressClient.UpdateStream(ctx, req)
+	if err != nil {
+		if x.IsNotFound(err) {
+			return nil, errs.ErrRecordNotFound.WrapMsg("stream not found", "streamID", streamID)
+		}
+		return nil, errs.WrapMsg(err, "update livekit stream egress failed", "egressID", streamID)
+	}
+	return rtc.NewStream(info), nil
+}
+
+func (x *LiveKit) StopStream(ctx context.Context, streamID string) error {
+	if _, err := x.egressClient.StopEgress(ctx, &livekit.StopEgressRequest{EgressId: streamID}); err != nil {
+		if x.IsNotFound(err) {
+			return errs.ErrRecordNotFound.WrapMsg("stream not found", "streamID", streamID)
+		}
+		return errs.WrapMsg(err, "stop livekit egress failed", "egressID", streamID)
+	}
+	return nil
+}
@@ -7,23 +7,47 @@ import (
 	"github.com/livekit/protocol/livekit"
 	lksdk "github.com/livekit/server-sdk-go"
 	"github.com/openimsdk/openmeeting-server/pkg/common/config"
+	"github.com/openimsdk/openmeeting-server/pkg/common/constant"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/cache"
+	"github.com/openimsdk/openmeeting-server/pkg/protocol/meetingext"
 	"github.com/openimsdk/openmeeting-server/pkg/rpcclient"
 	"github.com/openimsdk/openmeeting-server/pkg/rtc"
+	"github.com/openimsdk/openmeeting-server/pkg/webhook"
 	"github.com/openimsdk/protocol/openmeeting/meeting"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
-	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
 	"github.com/twitchtv/twirp"
 	"google.golang.org/protobuf/proto"
 	"sync/atomic"
 	"time"
 )
 
-func NewLiveKit(conf *config.RTC) rtc.MeetingRtc {
+const (
+	// the room limits if they are not configured
+	defaultEmptyTimeout    = 86400
+	defaultMaxParticipants = 10000
+)
+
+const (
+	// systemTopic carries meeting.NotifyMeetingData
+	systemTopic = "system"
+	// systemExtTopic carries meetingext.NotifyMeetingExtData, so the clients only knowing systemTopic are not broken
+	systemExtTopic = "system_ext"
+	// chatTopic carries meetingext.MeetingChatMessage
+	chatTopic = "chat"
+)
+
+// NewLiveKit publisher receives the room events, it could be nil.
+func NewLiveKit(conf *config.RTC, publisher webhook.Publisher, roomLock cache.RoomLock) rtc.MeetingRtc {
 	return &LiveKit{
-		index:      0,
-		conf:       conf,
-		roomClient: lksdk.NewRoomServiceClient(conf.InnerURL, conf.ApiKey, conf.ApiSecret),
+		roomLock:     roomLock,
+		index:        0,
+		conf:         conf,
+		roomClient:   lksdk.NewRoomServiceClient(conf.InnerURL, conf.ApiKey, conf.ApiSecret),
+		egressClient: lksdk.NewEgressClient(conf.InnerURL, conf.ApiKey, conf.ApiSecret),
+		sipClient:    lksdk.NewSIPClient(conf.InnerURL, conf.ApiKey, conf.ApiSecret),
+		publisher:    publisher,
 	}
 }
 
@@ -46,7 +70,7 @@ func (x *LiveKit) GetJoinToken(ctx context.Context, roomID, identity string, met
 	}
 
 	if metadata != nil {
-		bytes, err := json.Marshal(metadata)
+		bytes, err := json.Marshal(&participantMetadata{ParticipantMetaData: metadata, Kind: constant.ParticipantKindStandard})
 		if err != nil {
 			log.ZError(ctx, "json.Marshal failed", err)
 			return "", "", errs.WrapMsg(err, "json marshall failed")
@@ -76,34 +100,16 @@ func (x *LiveKit) GetJoinToken(ctx context.Context, roomID, identity string, met
 	return jwt, x.getLiveURL(), nil
 }
 
-func (x *LiveKit) CreateRoom(ctx context.Context, meetingID, identify string, roomMetaData *meeting.MeetingMetadata, participantMetaData *meeting.ParticipantMetaData, userRpc *rpcclient.User) (sID, token, liveUrl string, err error) {
-	return x.createRoom(ctx, meetingID, identify, roomMetaData, participantMetaData, userRpc, func(room *livekit.Room) *lksdk.RoomCallback {
-		cb := NewRTC(meetingID, x)
-		callback := rtc.NewRoomCallback(
-			mcontext.NewCtx("room_callback_"+mcontext.GetOperationID(ctx)), meetingID, room.Sid, cb, userRpc)
-		return &lksdk.RoomCallback{
-			ParticipantCallback: lksdk.ParticipantCallback{
-				OnDataReceived: func(data []byte, rp *lksdk.RemoteParticipant) {
-					log.ZDebug(ctx, "data received:", "data:", string(data))
-				},
-			},
-			OnRoomMetadataChanged: func(metadata string) {
-				log.ZDebug(ctx, "meta data change", "metaData:", metadata)
-			},
-			OnParticipantConnected:    callback.OnParticipantConnected,
-			OnParticipantDisconnected: callback.OnParticipantDisconnected,
-			OnDisconnected:            callback.OnDisconnected,
-			OnReconnected:             callback.OnReconnected,
-			OnReconnecting:            callback.OnReconnecting,
-		}
-	})
+// CreateRoom the room events are received by the webhook of the LiveKit server, see ReceiveWebhook.
+func (x *LiveKit) CreateRoom(ctx context.Context, meetingID, identify string, roomMetaData *meeting.MeetingMetadata, participantMetaData *meeting.ParticipantMetaData, limit *rtc.RoomLimit, userRpc *rpcclient.User) (sID, token, liveUrl string, err error) {
+	return x.createRoom(ctx, meetingID, identify, roomMetaData, participantMetaData, limit)
 }
 
-func (x *LiveKit) createRoom(ctx context.Context, meetingID, identify string, roomMetaData any, participantMetaData *meeting.ParticipantMetaData, userRpc *rpcclient.User, callback func(room *livekit.Room) *lksdk.RoomCallback) (sID, token, liveUrl string, err error) {
+func (x *LiveKit) createRoom(ctx context.Context, meetingID, identify string, roomMetaData any, participantMetaData *meeting.ParticipantMetaData, limit *rtc.RoomLimit) (sID, token, liveUrl string, err error) {
 	req := &livekit.CreateRoomRequest{
 		Name:            meetingID,
-		EmptyTimeout:    86400,
-		MaxParticipants: 10000,
+		EmptyTimeout:    x.getEmptyTimeout(limit),
+		MaxParticipants: x.getMaxParticipants(limit),
 	}
 	if roomMetaData != nil {
 		bytes, err := json.Marshal(&roomMetaData)
@@ -117,6 +123,10 @@ func (x *LiveKit) createRoom(ctx context.Context, meetingID, identify string, ro
 		log.ZError(ctx, "Marshal failed", err)
 		return "", "", "", errs.WrapMsg(err, "create livekit room failed, meetingID", meetingID)
 	}
+	// the meeting has started before it is split into the breakout rooms
+	if _, _, ok := rtc.ParseBreakoutRoomID(meetingID); !ok {
+		x.publishEvent(ctx, &webhook.Event{Type: webhook.EventMeetingStarted, MeetingID: meetingID, OperatorUserID: identify})
+	}
 	token, liveUrl, err = x.GetJoinToken(ctx, meetingID, identify, participantMetaData, false)
 	if err != nil {
 		return "", "", "", errs.WrapMsg(err, "get join token failed, meetingID:", meetingID)
@@ -162,7 +172,48 @@ func (x *LiveKit) GetRoom(ctx context.Context, roomID string) (*livekit.Room, er
 	return roomsResp.Rooms[0], nil
 }
 
-func (x *LiveKit) GetRoomData(ctx context.Context, roomID string) (*meeting.MeetingMetadata, error) {
+func (x *LiveKit) getEmptyTimeout(limit *rtc.RoomLimit) uint32 {
+	if limit != nil && limit.EmptyTimeout > 0 {
+		return limit.EmptyTimeout
+	}
+	if x.conf.EmptyTimeout > 0 {
+		return x.conf.EmptyTimeout
+	}
+	return defaultEmptyTimeout
+}
+
+func (x *LiveKit) getMaxParticipants(limit *rtc.RoomLimit) uint32 {
+	if limit != nil && limit.MaxParticipants > 0 {
+		return limit.MaxParticipants
+	}
+	if x.conf.MaxParticipants > 0 {
+		return x.conf.MaxParticipants
+	}
+	return defaultMaxParticipants
+}
+
+// roomMetadata is the metadata of the room, ext keeps the state of the meeting which is not part of meeting.MeetingMetadata yet
+type roomMetadata struct {
+	*meeting.MeetingMetadata
+	Ext *meetingext.MeetingMetadataExt `json:"ext,omitempty"`
+	// Version is increased by each update, see ModifyRoomData
+	Version int64 `json:"version,omitempty"`
+}
+
+// participantMetadata is the metadata of the participant, the kind is not part of meeting.ParticipantMetaData yet
+type participantMetadata struct {
+	*meeting.ParticipantMetaData
+	Kind string `json:"kind,omitempty"`
+}
+
+func participantKind(participant *livekit.ParticipantInfo) string {
+	if participant.GetKind() == livekit.ParticipantInfo_SIP {
+		return constant.ParticipantKindSIP
+	}
+	return constant.ParticipantKindStandard
+}
+
+func (x *LiveKit) getRoomMetadata(ctx context.Context, roomID string) (*roomMetadata, error) {
 	resp, err := x.roomClient.ListRooms(ctx, &livekit.ListRoomsRequest{Names: []string{roomID}})
 	if err != nil {
 		log.ZError(ctx, "list room error", err)
@@ -173,34 +224,36 @@ func (x *LiveKit) GetRoomData(ctx context.Context, roomID string) (*meeting.Meet
 		log.ZError(ctx, "not found room", errs.ErrRecordNotFound.WrapMsg("roomIsNotExist"))
 		return nil, errs.ErrRecordNotFound.WrapMsg("roomIsNotExist")
 	}
-	var metaData meeting.MeetingMetadata
+	metaData := &roomMetadata{MeetingMetadata: &meeting.MeetingMetadata{}}
 	if resp.Rooms[0].Metadata == "" {
 		log.ZError(ctx, "meta data not init", errs.ErrRecordNotFound.WrapMsg("meta data not init"))
 		return nil, errs.ErrRecordNotFound.WrapMsg("meta data not init")
 	}
 
-	if err := json.Unmarshal([]byte(resp.Rooms[0].Metadata), &metaData); err != nil {
+	if err := json.Unmarshal([]byte(resp.Rooms[0].Metadata), metaData); err != nil {
 		log.ZError(ctx, "Unmarshal failed roomId:", err)
 		return nil, errs.WrapMsg(err, "Unmarshal failed roomId:", roomID)
 	}
-	return &metaData, nil
+	if metaData.Ext == nil {
+		metaData.Ext = &meetingext.MeetingMetadataExt{}
+	}
+	return metaData, nil
 }
 
-func (x *LiveKit) UpdateMetaData(ctx context.Context, updateData *meeting.MeetingMetadata) error {
-	meetingID := updateData.Detail.Info.SystemGenerated.MeetingID
-	bytes, err := json.Marshal(&updateData)
+func (x *LiveKit) GetRoomData(ctx context.Context, roomID string) (*meeting.MeetingMetadata, error) {
+	metaData, err := x.getRoomMetadata(ctx, roomID)
 	if err != nil {
-		return errs.Wrap(err)
+		return nil, err
 	}
-	_, err = x.roomClient.UpdateRoomMetadata(ctx, &livekit.UpdateRoomMetadataRequest{
-		Room:     meetingID,
-		Metadata: string(bytes),
-	})
+	return metaData.MeetingMetadata, nil
+}
 
+func (x *LiveKit) GetRoomDataExt(ctx context.Context, roomID string) (*meeting.MeetingMetadata, *meetingext.MeetingMetadataExt, error) {
+	metaData, err := x.getRoomMetadata(ctx, roomID)
 	if err != nil {
-		return errs.WrapMsg(err, "update room meta data failed, meetingID: ", meetingID)
+		return nil, nil, err
 	}
-	return nil
+	return metaData.MeetingMetadata, metaData.Ext, nil
 }
 
 func (x *LiveKit) CloseRoom(ctx context.Context, roomID string) error {
@@ -230,31 +283,94 @@ func (x *LiveKit) IsNotFound(err error) bool {
 	return ok && errCode.Code() == twirp.NotFound
 }
 
+// trackSources are the sources of the tracks by the stream type
+var trackSources = map[string][]livekit.TrackSource{
+	rtc.StreamTypeVideo:  {livekit.TrackSource_CAMERA},
+	rtc.StreamTypeAudio:  {livekit.TrackSource_MICROPHONE},
+	rtc.StreamTypeScreen: {livekit.TrackSource_SCREEN_SHARE, livekit.TrackSource_SCREEN_SHARE_AUDIO},
+}
+
+// getParticipant errs.ErrRecordNotFound is returned if the participant is not in the room
+func (x *LiveKit) getParticipant(ctx context.Context, roomID, userID string) (*livekit.ParticipantInfo, error) {
+	participant, err := x.roomClient.GetParticipant(ctx, &livekit.RoomParticipantIdentity{Room: roomID, Identity: userID})
+	if err != nil {
+		if x.IsNotFound(err) {
+			return nil, errs.ErrRecordNotFound.WrapMsg("participant not found", "roomID", roomID, "userID", userID)
+		}
+		return nil, errs.WrapMsg(err, "get room participant failed")
+	}
+	return participant, nil
+}
+
+// ToggleMimeStream mute or unmute the published tracks of the stream type,
+// errs.ErrRecordNotFound is returned if the participant is not in the room or did not publish the tracks.
+// Unmuting by the server needs enable_remote_unmute of the LiveKit server.
 func (x *LiveKit) ToggleMimeStream(ctx context.Context, roomID, userID, mineType string, mute bool) error {
-	//participant, err := x.roomClient.GetParticipant(ctx, &livekit.RoomParticipantIdentity{Room: roomID, Identity: userID})
-	//if err != nil {
-	//	return errs.WrapMsg(err, "get room participant failed")
-	//}
-	//var sid string
-	//for _, track := range participant.Tracks {
-	//	log.ZDebug(ctx, "participant tracks:", track.MimeType, track.Sid, track.Type)
-	//	if strings.Contains(track.MimeType, mineType) {
-	//		sid = track.Sid
-	//		break
-	//	}
-	//	if sid == "" {
-	//		return errs.New("mine type not found", mineType)
-	//	}
-	//}
-	//_, err = x.roomClient.MutePublishedTrack(ctx, &livekit.MuteRoomTrackRequest{
-	//	Room:     roomID,
-	//	Identity: userID,
-	//	TrackSid: sid,
-	//	Muted:    mute,
-	//})
-	//if err != nil {
-	//	return errs.WrapMsg(err, "mute published track failed")
-	//}
+	sources, ok := trackSources[mineType]
+	if !ok {
+		return errs.ErrArgs.WrapMsg("unknown stream type", "type", mineType)
+	}
+	participant, err := x.getParticipant(ctx, roomID, userID)
+	if err != nil {
+		return err
+	}
+	found := false
+	for _, track := range participant.Tracks {
+		// the phone participants publish the audio without the source
+		isPhoneAudio := participant.Kind == livekit.ParticipantInfo_SIP && mineType == rtc.StreamTypeAudio && track.Type == livekit.TrackType_AUDIO
+		if !isPhoneAudio && !datautil.Contain(track.Source, sources...) {
+			continue
+		}
+		found = true
+		if track.Muted == mute {
+			continue
+		}
+		log.ZDebug(ctx, "toggle participant track", "userID", userID, "trackSid", track.Sid, "source", track.Source, "mute", mute)
+		_, err = x.roomClient.MutePublishedTrack(ctx, &livekit.MuteRoomTrackRequest{
+			Room:     roomID,
+			Identity: userID,
+			TrackSid: track.Sid,
+			Muted:    mute,
+		})
+		if err != nil {
+			return errs.WrapMsg(err, "mute published track failed", "trackSid", track.Sid)
+		}
+	}
+	if !found {
+		return errs.ErrRecordNotFound.WrapMsg("track not found", "userID", userID, "type", mineType)
+	}
+	return nil
+}
+
+// UpdatePublishPermission the participant could publish the tracks of the stream types only, nothing if streamTypes is empty
+func (x *LiveKit) UpdatePublishPermission(ctx context.Context, roomID, userID string, streamTypes []string) error {
+	participant, err := x.getParticipant(ctx, roomID, userID)
+	if err != nil {
+		return err
+	}
+	// the phone participants publish the audio only, which is muted by ToggleMimeStream
+	if participant.Kind == livekit.ParticipantInfo_SIP {
+		return nil
+	}
+	permission := participant.Permission
+	if permission == nil {
+		permission = &livekit.ParticipantPermission{CanSubscribe: true, CanPublishData: true}
+	}
+	var sources []livekit.TrackSource
+	for _, streamType := range streamTypes {
+		sources = append(sources, trackSources[streamType]...)
+	}
+	// the empty sources of LiveKit allow all of them
+	permission.CanPublish = len(sources) > 0
+	permission.CanPublishSources = sources
+	_, err = x.roomClient.UpdateParticipant(ctx, &livekit.UpdateParticipantRequest{
+		Room:       roomID,
+		Identity:   userID,
+		Permission: permission,
+	})
+	if err != nil {
+		return errs.WrapMsg(err, "update participant permission failed", "roomID", roomID, "userID", userID)
+	}
 	return nil
 }
 
@@ -264,12 +380,30 @@ func (x *LiveKit) SendRoomData(ctx context.Context, roomID string, userIDList *[
 	//if err != nil {
 	//	return errs.WrapMsg(err, "marshal send data failed")
 	//}
+	return x.sendData(ctx, roomID, systemTopic, userIDList, sendData)
+}
+
+func (x *LiveKit) SendRoomExtData(ctx context.Context, roomID string, userIDList *[]string, sendData *meetingext.NotifyMeetingExtData) error {
+	return x.sendData(ctx, roomID, systemExtTopic, userIDList, sendData)
+}
+
+func (x *LiveKit) SendRoomSystemExtData(ctx context.Context, roomID string, userIDList *[]string, sendData *meetingext.NotifyMeetingExtData) error {
+	if err := x.sendData(ctx, roomID, systemExtTopic, userIDList, sendData); err != nil {
+		return err
+	}
+	return x.sendData(ctx, roomID, systemTopic, userIDList, sendData)
+}
+
+func (x *LiveKit) SendRoomChatData(ctx context.Context, roomID string, userIDList *[]string, sendData *meetingext.MeetingChatMessage) error {
+	return x.sendData(ctx, roomID, chatTopic, userIDList, sendData)
+}
+
+func (x *LiveKit) sendData(ctx context.Context, roomID, topic string, userIDList *[]string, sendData proto.Message) error {
 	sendMsg, err := proto.Marshal(sendData)
 	if err != nil {
 		return errs.WrapMsg(err, "marshal send data failed")
 	}
-	log.ZDebug(ctx, "send room data after marshal", "sendMsg:", sendMsg)
-	topic := "system"
+	log.ZDebug(ctx, "send room data after marshal", "topic", topic, "sendMsg:", sendMsg)
 	req := &livekit.SendDataRequest{
 		Room:  roomID,
 		Data:  sendMsg,
@@ -296,6 +430,9 @@ func (x *LiveKit) ListParticipants(ctx context.Context, roomID string) ([]*livek
 func (x *LiveKit) GetParticipantUserIDs(ctx context.Context, roomID string) ([]string, error) {
 	resp, err := x.roomClient.ListParticipants(ctx, &livekit.ListParticipantsRequest{Room: roomID})
 	if err != nil {
+		if x.IsNotFound(err) {
+			return nil, errs.ErrRecordNotFound.WrapMsg("room not found", "roomID", roomID)
+		}
 		return nil, errs.WrapMsg(err, "list participants failed")
 	}
 	userIDs := make([]string, 0, len(resp.Participants))
@@ -323,8 +460,13 @@ func (x *LiveKit) GetParticipantMetaData(ctx context.Context, roomID, userID str
 	return nil, errs.ErrRecordNotFound.WrapMsg("not found participant", userID)
 }
 
+// UpdateParticipantData the kind of the participant is kept
 func (x *LiveKit) UpdateParticipantData(ctx context.Context, data *meeting.ParticipantMetaData, roomID, userID string) error {
-	bytes, err := json.Marshal(data)
+	participant, err := x.getParticipant(ctx, roomID, userID)
+	if err != nil {
+		return err
+	}
+	bytes, err := json.Marshal(&participantMetadata{ParticipantMetaData: data, Kind: participantKind(participant)})
 	if err != nil {
 		log.ZError(ctx, "json.Marshal failed", err)
 		return errs.WrapMsg(err, "json marshall failed")
@@ -0,0 +1,38 @@
+package livekit
+
+import (
+	"github.com/openimsdk/openmeeting-server/pkg/protocol/meetingext"
+	"github.com/openimsdk/protocol/openmeeting/meeting"
+	"google.golang.org/protobuf/proto"
+	"testing"
+)
+
+// TestSystemExtData the speaking queue sent on the system topic is skipped by the clients decoding meeting.NotifyMeetingData
+func TestSystemExtData(t *testing.T) {
+	sendData := &meetingext.NotifyMeetingExtData{
+		OperatorUserID: "1",
+		MessageType: &meetingext.NotifyMeetingExtData_SpeakingQueueData{SpeakingQueueData: &meetingext.SpeakingQueueData{
+			RaisedHands: []*meetingext.RaisedHand{{UserID: "2", Nickname: "bob", RaiseTime: 1792310400}},
+		}},
+	}
+	data, err := proto.Marshal(sendData)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	notifyData := &meeting.NotifyMeetingData{}
+	if err := proto.Unmarshal(data, notifyData); err != nil {
+		t.Fatalf("unmarshal as NotifyMeetingData failed: %v", err)
+	}
+	if notifyData.OperatorUserID != "1" || notifyData.MessageType != nil {
+		t.Errorf("NotifyMeetingData = %v, want the operator only", notifyData)
+	}
+
+	extData := &meetingext.NotifyMeetingExtData{}
+	if err := proto.Unmarshal(data, extData); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(extData, sendData) {
+		t.Errorf("NotifyMeetingExtData = %v, want %v", extData, sendData)
+	}
+}
@@ -0,0 +1,136 @@
+package livekit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/livekit/protocol/livekit"
+	"github.com/openimsdk/openmeeting-server/pkg/common/servererrs"
+	"github.com/openimsdk/openmeeting-server/pkg/rtc"
+	"github.com/openimsdk/protocol/openmeeting/meeting"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"os"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// modifyRoomDataRetries is how many times the room data is modified again after the others updated it meanwhile
+	modifyRoomDataRetries = 10
+	// roomLockExpire releases the lock of the replica which died between the version check and the write
+	roomLockExpire     = 5 * time.Second
+	roomLockRetryDelay = 20 * time.Millisecond
+)
+
+// ModifyRoomData compares the version of the room data before saving it, the room lock only covers the comparison and the write,
+// so modify runs without holding it
+func (x *LiveKit) ModifyRoomData(ctx context.Context, roomID string, modify func(data *rtc.RoomData) (bool, error)) error {
+	for i := 0; i < modifyRoomDataRetries; i++ {
+		current, err := x.getRoomMetadata(ctx, roomID)
+		if err != nil {
+			return err
+		}
+		data := &rtc.RoomData{MetaData: current.MeetingMetadata, Ext: current.Ext, Version: current.Version}
+		changed, err := modify(data)
+		if err != nil {
+			return err
+		}
+		if !changed {
+			return nil
+		}
+		swapped, err := x.swapRoomMetadata(ctx, roomID, current.Version, &roomMetadata{
+			MeetingMetadata: data.MetaData,
+			Ext:             data.Ext,
+			Version:         current.Version + 1,
+		})
+		if err != nil {
+			return err
+		}
+		if swapped {
+			return nil
+		}
+		log.ZDebug(ctx, "room data is updated meanwhile, modify it again", "roomID", roomID, "version", current.Version)
+		time.Sleep(roomLockRetryDelay * time.Duration(i+1))
+	}
+	return servererrs.ErrMeetingConflict.WrapMsg("room data is updated by others at the same time", "roomID", roomID)
+}
+
+// InitRoomData the room created without the metadata gets it under the room lock, so it never overwrites the saved one
+func (x *LiveKit) InitRoomData(ctx context.Context, roomID string, metaData *meeting.MeetingMetadata) error {
+	for i := 0; i < modifyRoomDataRetries; i++ {
+		owner := x.roomLockOwner()
+		locked, err := x.roomLock.LockRoom(ctx, roomID, owner, roomLockExpire)
+		if err != nil {
+			return err
+		}
+		if !locked {
+			time.Sleep(roomLockRetryDelay * time.Duration(i+1))
+			continue
+		}
+		err = x.initRoomMetadata(ctx, roomID, metaData)
+		if unlockErr := x.roomLock.UnlockRoom(ctx, roomID, owner); unlockErr != nil {
+			log.ZWarn(ctx, "unlock room failed", unlockErr, "roomID", roomID)
+		}
+		return err
+	}
+	return servererrs.ErrMeetingConflict.WrapMsg("room is locked by others", "roomID", roomID)
+}
+
+func (x *LiveKit) initRoomMetadata(ctx context.Context, roomID string, metaData *meeting.MeetingMetadata) error {
+	room, err := x.GetRoom(ctx, roomID)
+	if err != nil {
+		return err
+	}
+	if room.Metadata != "" {
+		log.ZDebug(ctx, "room data is saved by others", "roomID", roomID)
+		return nil
+	}
+	return x.writeRoomMetadata(ctx, roomID, &roomMetadata{MeetingMetadata: metaData, Version: 1})
+}
+
+func (x *LiveKit) roomLockOwner() string {
+	return fmt.Sprintf("%d_%d_%d", os.Getpid(), time.Now().UnixNano(), atomic.AddUint64(&x.lockIndex, 1))
+}
+
+// swapRoomMetadata saves the room data if its version is still the expected one, false if it is not or the room is locked by others
+func (x *LiveKit) swapRoomMetadata(ctx context.Context, roomID string, version int64, updateData *roomMetadata) (bool, error) {
+	owner := x.roomLockOwner()
+	locked, err := x.roomLock.LockRoom(ctx, roomID, owner, roomLockExpire)
+	if err != nil {
+		return false, err
+	}
+	if !locked {
+		return false, nil
+	}
+	defer func() {
+		if err := x.roomLock.UnlockRoom(ctx, roomID, owner); err != nil {
+			log.ZWarn(ctx, "unlock room failed", err, "roomID", roomID)
+		}
+	}()
+	current, err := x.getRoomMetadata(ctx, roomID)
+	if err != nil {
+		return false, err
+	}
+	if current.Version != version {
+		return false, nil
+	}
+	if err := x.writeRoomMetadata(ctx, roomID, updateData); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (x *LiveKit) writeRoomMetadata(ctx context.Context, roomID string, updateData *roomMetadata) error {
+	bytes, err := json.Marshal(updateData)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if _, err := x.roomClient.UpdateRoomMetadata(ctx, &livekit.UpdateRoomMetadataRequest{
+		Room:     roomID,
+		Metadata: string(bytes),
+	}); err != nil {
+		return errs.WrapMsg(err, "update room meta data failed, meetingID: ", roomID)
+	}
+	return nil
+}
@@ -0,0 +1,241 @@
+package livekit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/livekit/protocol/livekit"
+	lksdk "github.com/livekit/server-sdk-go"
+	"github.com/openimsdk/openmeeting-server/pkg/common/servererrs"
+	"github.com/openimsdk/openmeeting-server/pkg/rtc"
+	"github.com/openimsdk/protocol/openmeeting/meeting"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// memoryRoomService keeps the metadata of one room in memory, the other methods are not used by the room data.
+type memoryRoomService struct {
+	livekit.RoomService
+	mu       sync.Mutex
+	roomID   string
+	metadata string
+	writes   int
+}
+
+func (m *memoryRoomService) ListRooms(_ context.Context, req *livekit.ListRoomsRequest) (*livekit.ListRoomsResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, name := range req.Names {
+		if name == m.roomID {
+			return &livekit.ListRoomsResponse{Rooms: []*livekit.Room{{Name: m.roomID, Metadata: m.metadata}}}, nil
+		}
+	}
+	return &livekit.ListRoomsResponse{}, nil
+}
+
+func (m *memoryRoomService) UpdateRoomMetadata(_ context.Context, req *livekit.UpdateRoomMetadataRequest) (*livekit.Room, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.metadata = req.Metadata
+	m.writes++
+	return &livekit.Room{Name: req.Room, Metadata: req.Metadata}, nil
+}
+
+func (m *memoryRoomService) load(t *testing.T) *roomMetadata {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	data := &roomMetadata{}
+	if err := json.Unmarshal([]byte(m.metadata), data); err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// store saves the room data as another replica does
+func (m *memoryRoomService) store(t *testing.T, data *roomMetadata) {
+	bytes, err := json.Marshal(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.metadata = string(bytes)
+}
+
+// memoryRoomLock is the room lock shared by the replicas.
+type memoryRoomLock struct {
+	mu     sync.Mutex
+	owners map[string]string
+}
+
+func (m *memoryRoomLock) LockRoom(_ context.Context, roomID, owner string, _ time.Duration) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.owners[roomID]; ok {
+		return false, nil
+	}
+	m.owners[roomID] = owner
+	return true, nil
+}
+
+func (m *memoryRoomLock) UnlockRoom(_ context.Context, roomID, owner string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.owners[roomID] == owner {
+		delete(m.owners, roomID)
+	}
+	return nil
+}
+
+func newTestLiveKit(t *testing.T) (*LiveKit, *memoryRoomService, *memoryRoomLock) {
+	service := &memoryRoomService{roomID: "1001"}
+	server := httptest.NewServer(livekit.NewRoomServiceServer(service))
+	t.Cleanup(server.Close)
+	lock := &memoryRoomLock{owners: make(map[string]string)}
+	x := &LiveKit{
+		roomClient: lksdk.NewRoomServiceClient(server.URL, "key", "secret"),
+		roomLock:   lock,
+	}
+	service.store(t, &roomMetadata{
+		MeetingMetadata: &meeting.MeetingMetadata{Detail: &meeting.MeetingInfoSetting{Info: &meeting.MeetingInfo{
+			CreatorDefinedMeeting: &meeting.CreatorDefinedMeetingInfo{HostUserID: "1"},
+		}}},
+		Version: 1,
+	})
+	return x, service, lock
+}
+
+func addCoHost(userID string) func(data *rtc.RoomData) (bool, error) {
+	return func(data *rtc.RoomData) (bool, error) {
+		info := data.MetaData.Detail.Info.CreatorDefinedMeeting
+		info.CoHostUSerID = append(info.CoHostUSerID, userID)
+		return true, nil
+	}
+}
+
+func TestModifyRoomData(t *testing.T) {
+	x, service, _ := newTestLiveKit(t)
+	if err := x.ModifyRoomData(context.Background(), "1001", addCoHost("2")); err != nil {
+		t.Fatal(err)
+	}
+	data := service.load(t)
+	if data.Version != 2 || fmt.Sprint(data.Detail.Info.CreatorDefinedMeeting.CoHostUSerID) != "[2]" {
+		t.Errorf("room data = %v, version %d, want co-host 2 with version 2", data.MeetingMetadata, data.Version)
+	}
+
+	// the room data not changed is not written
+	err := x.ModifyRoomData(context.Background(), "1001", func(data *rtc.RoomData) (bool, error) {
+		return false, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if service.writes != 1 {
+		t.Errorf("writes = %d, want 1", service.writes)
+	}
+}
+
+func TestModifyRoomDataUpdatedMeanwhile(t *testing.T) {
+	x, service, _ := newTestLiveKit(t)
+	var calls int
+	err := x.ModifyRoomData(context.Background(), "1001", func(data *rtc.RoomData) (bool, error) {
+		calls++
+		if calls == 1 {
+			// another replica saves its change after the room data is read
+			current := service.load(t)
+			current.Detail.Info.CreatorDefinedMeeting.CoHostUSerID = []string{"3"}
+			current.Version++
+			service.store(t, current)
+		}
+		return addCoHost("2")(data)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Errorf("modify is called %d times, want 2", calls)
+	}
+	// the change of the other replica is kept
+	data := service.load(t)
+	if data.Version != 3 || fmt.Sprint(data.Detail.Info.CreatorDefinedMeeting.CoHostUSerID) != "[3 2]" {
+		t.Errorf("room data = %v, version %d, want co-hosts [3 2] with version 3", data.MeetingMetadata, data.Version)
+	}
+}
+
+func TestModifyRoomDataConflict(t *testing.T) {
+	x, service, _ := newTestLiveKit(t)
+	var calls int
+	err := x.ModifyRoomData(context.Background(), "1001", func(data *rtc.RoomData) (bool, error) {
+		calls++
+		// the room data is always updated by others meanwhile
+		current := service.load(t)
+		current.Version++
+		service.store(t, current)
+		return addCoHost("2")(data)
+	})
+	if !servererrs.ErrMeetingConflict.Is(err) {
+		t.Fatalf("ModifyRoomData() error = %v, want %v", err, servererrs.ErrMeetingConflict)
+	}
+	if calls != modifyRoomDataRetries {
+		t.Errorf("modify is called %d times, want %d", calls, modifyRoomDataRetries)
+	}
+	if service.writes != 0 {
+		t.Errorf("writes = %d, want the room data not written", service.writes)
+	}
+}
+
+func TestModifyRoomDataLocked(t *testing.T) {
+	x, service, lock := newTestLiveKit(t)
+	// another replica holds the lock between its version check and write
+	if _, err := lock.LockRoom(context.Background(), "1001", "other", roomLockExpire); err != nil {
+		t.Fatal(err)
+	}
+	var calls int
+	err := x.ModifyRoomData(context.Background(), "1001", func(data *rtc.RoomData) (bool, error) {
+		calls++
+		if calls == 2 {
+			if err := lock.UnlockRoom(context.Background(), "1001", "other"); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return addCoHost("2")(data)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 || service.writes != 1 {
+		t.Errorf("modify is called %d times with %d writes, want 2 times with 1 write", calls, service.writes)
+	}
+}
+
+func TestModifyRoomDataConcurrently(t *testing.T) {
+	x, service, _ := newTestLiveKit(t)
+	userIDs := []string{"2", "3", "4", "5"}
+	var wg sync.WaitGroup
+	errCh := make(chan error, len(userIDs))
+	for _, userID := range userIDs {
+		wg.Add(1)
+		go func(userID string) {
+			defer wg.Done()
+			errCh <- x.ModifyRoomData(context.Background(), "1001", addCoHost(userID))
+		}(userID)
+	}
+	wg.Wait()
+	close(errCh)
+	for err := range errCh {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	// no change is lost
+	data := service.load(t)
+	coHosts := make(map[string]bool)
+	for _, userID := range data.Detail.Info.CreatorDefinedMeeting.CoHostUSerID {
+		coHosts[userID] = true
+	}
+	if data.Version != int64(len(userIDs)+1) || len(coHosts) != len(userIDs) {
+		t.Errorf("room data = %v, version %d, want all the co-hosts with version %d", data.MeetingMetadata, data.Version, len(userIDs)+1)
+	}
+}
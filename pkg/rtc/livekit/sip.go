@@ -0,0 +1,80 @@
+package livekit
+
+import (
+	"context"
+	"github.com/livekit/protocol/livekit"
+	"github.com/openimsdk/openmeeting-server/pkg/rtc"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+	"regexp"
+	"sort"
+)
+
+func (x *LiveKit) CreateSIPTrunk(ctx context.Context, trunk *rtc.SIPTrunk) (string, error) {
+	info, err := x.sipClient.CreateSIPTrunk(ctx, &livekit.CreateSIPTrunkRequest{
+		InboundAddresses:    trunk.InboundAddresses,
+		InboundNumbersRegex: sipNumbersRegex(trunk.Numbers),
+		InboundUsername:     trunk.InboundUsername,
+		InboundPassword:     trunk.InboundPassword,
+	})
+	if err != nil {
+		return "", errs.WrapMsg(err, "create livekit sip trunk failed")
+	}
+	log.ZInfo(ctx, "sip trunk created", "trunkID", info.GetSipTrunkId(), "numbers", trunk.Numbers)
+	return info.GetSipTrunkId(), nil
+}
+
+func (x *LiveKit) FindSIPTrunk(ctx context.Context, numbers []string) (string, error) {
+	resp, err := x.sipClient.ListSIPTrunk(ctx, &livekit.ListSIPTrunkRequest{})
+	if err != nil {
+		return "", errs.WrapMsg(err, "list livekit sip trunks failed")
+	}
+	expected := sipNumbersRegex(numbers)
+	for _, info := range resp.GetItems() {
+		regex := append([]string{}, info.GetInboundNumbersRegex()...)
+		sort.Strings(regex)
+		if datautil.Equal(regex, expected) {
+			return info.GetSipTrunkId(), nil
+		}
+	}
+	return "", errs.ErrRecordNotFound.WrapMsg("sip trunk not found", "numbers", numbers)
+}
+
+// CreateSIPDispatchRule the callers entering the pin are dispatched into the room directly.
+func (x *LiveKit) CreateSIPDispatchRule(ctx context.Context, roomID, pin string, trunkIDs []string, hidePhoneNumber bool) (string, error) {
+	info, err := x.sipClient.CreateSIPDispatchRule(ctx, &livekit.CreateSIPDispatchRuleRequest{
+		Rule: &livekit.SIPDispatchRule{
+			Rule: &livekit.SIPDispatchRule_DispatchRuleDirect{DispatchRuleDirect: &livekit.SIPDispatchRuleDirect{
+				RoomName: roomID,
+				Pin:      pin,
+			}},
+		},
+		TrunkIds:        trunkIDs,
+		HidePhoneNumber: hidePhoneNumber,
+	})
+	if err != nil {
+		return "", errs.WrapMsg(err, "create livekit sip dispatch rule failed", "roomID", roomID)
+	}
+	return info.GetSipDispatchRuleId(), nil
+}
+
+func (x *LiveKit) DeleteSIPDispatchRule(ctx context.Context, ruleID string) error {
+	if _, err := x.sipClient.DeleteSIPDispatchRule(ctx, &livekit.DeleteSIPDispatchRuleRequest{SipDispatchRuleId: ruleID}); err != nil {
+		if x.IsNotFound(err) {
+			return errs.ErrRecordNotFound.WrapMsg("sip dispatch rule not found", "ruleID", ruleID)
+		}
+		return errs.WrapMsg(err, "delete livekit sip dispatch rule failed", "ruleID", ruleID)
+	}
+	return nil
+}
+
+// sipNumbersRegex the trunk matches the called numbers by the regular expressions, which are sorted to be compared
+func sipNumbersRegex(numbers []string) []string {
+	regex := make([]string, 0, len(numbers))
+	for _, number := range numbers {
+		regex = append(regex, "^"+regexp.QuoteMeta(number)+"$")
+	}
+	sort.Strings(regex)
+	return regex
+}
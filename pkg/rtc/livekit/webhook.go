@@ -0,0 +1,49 @@
+package livekit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/livekit"
+	"github.com/openimsdk/openmeeting-server/pkg/rtc"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"google.golang.org/protobuf/encoding/protojson"
+	"strings"
+)
+
+// ReceiveWebhook checks the auth token is signed with the configured api key and secret,
+// and carries the checksum of the body, the same way as the webhook receiver of the livekit sdk.
+func (x *LiveKit) ReceiveWebhook(ctx context.Context, body []byte, authToken string) (*livekit.WebhookEvent, error) {
+	authToken = strings.TrimPrefix(authToken, "Bearer ")
+	if authToken == "" {
+		return nil, errs.ErrNoPermission.WrapMsg("livekit webhook without auth token")
+	}
+	verifier, err := auth.ParseAPIToken(authToken)
+	if err != nil {
+		return nil, errs.ErrNoPermission.WrapMsg("parse livekit webhook auth token failed: " + err.Error())
+	}
+	if verifier.APIKey() != x.conf.ApiKey {
+		return nil, errs.ErrNoPermission.WrapMsg("livekit webhook signed by unknown api key", "apiKey", verifier.APIKey())
+	}
+	claims, err := verifier.Verify(x.conf.ApiSecret)
+	if err != nil {
+		return nil, errs.ErrNoPermission.WrapMsg("verify livekit webhook auth token failed: " + err.Error())
+	}
+	sum := sha256.Sum256(body)
+	if claims.Sha256 != base64.StdEncoding.EncodeToString(sum[:]) {
+		return nil, errs.ErrNoPermission.WrapMsg("livekit webhook checksum mismatch")
+	}
+
+	event := &livekit.WebhookEvent{}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true, AllowPartial: true}).Unmarshal(body, event); err != nil {
+		return nil, errs.ErrArgs.WrapMsg("unmarshal livekit webhook failed: " + err.Error())
+	}
+	log.ZDebug(ctx, "receive livekit webhook", "event", event.Event, "room", event.Room.GetName(), "id", event.Id)
+	return event, nil
+}
+
+func (x *LiveKit) GetRoomCallback(roomID string, onHostChanged rtc.HostChangedFunc) rtc.CallbackInterface {
+	return NewRTC(roomID, x, onHostChanged)
+}
@@ -3,24 +3,124 @@ package rtc
 import (
 	"context"
 	"github.com/livekit/protocol/livekit"
+	"github.com/openimsdk/openmeeting-server/pkg/protocol/meetingext"
 	"github.com/openimsdk/openmeeting-server/pkg/rpcclient"
 	"github.com/openimsdk/protocol/openmeeting/meeting"
 )
 
+// stream types of the tracks published by the participants
+const (
+	StreamTypeVideo  = "video"  // the camera
+	StreamTypeAudio  = "audio"  // the microphone
+	StreamTypeScreen = "screen" // the screen share and its audio
+)
+
+// RoomLimit limits the room created by the rtc server.
+type RoomLimit struct {
+	MaxParticipants uint32
+	EmptyTimeout    uint32 // seconds the room is kept after the last participant left
+}
+
+// Recording is the recording of the room composed into files by the rtc server.
+type Recording struct {
+	RecordingID string
+	RoomID      string
+	Status      string // see the recording status in constant
+	StartTime   int64  // seconds, 0 if not started yet
+	EndTime     int64  // seconds, 0 if not ended yet
+	Error       string
+	Files       []*RecordingFile
+}
+
+// RecordingFile is a file of the recording, available after the recording ended.
+type RecordingFile struct {
+	Filename string
+	Location string
+	Size     int64 // bytes
+	Duration int64 // seconds
+}
+
+// Stream is the live stream of the room composed by the rtc server and pushed to the rtmp urls.
+type Stream struct {
+	StreamID string
+	RoomID   string
+	Status   string // see the recording status in constant, which is shared by the streams
+	Error    string
+}
+
+// SIPTrunk is the inbound trunk of the phone numbers the callers dial in to the rooms.
+type SIPTrunk struct {
+	Numbers          []string // the trunk accepts the calls to the numbers only, or all the calls if empty
+	InboundAddresses []string // CIDR or IPs of the SIP provider, all if empty
+	InboundUsername  string
+	InboundPassword  string
+}
+
+// RoomData is the metadata of the room together with the ext data, the version increases with each update
+type RoomData struct {
+	MetaData *meeting.MeetingMetadata
+	Ext      *meetingext.MeetingMetadataExt
+	Version  int64
+}
+
 type MeetingRtc interface {
 	GetJoinToken(ctx context.Context, roomID, identity string, metadata *meeting.ParticipantMetaData, isListener bool) (string, string, error)
-	CreateRoom(ctx context.Context, roomID, identify string, roomMetaData *meeting.MeetingMetadata, participantMetaData *meeting.ParticipantMetaData, userRpc *rpcclient.User) (sID, token, liveUrl string, err error)
+	// CreateRoom the defaults of the rtc configuration apply to the zero values of limit, or all of them if limit is nil
+	CreateRoom(ctx context.Context, roomID, identify string, roomMetaData *meeting.MeetingMetadata, participantMetaData *meeting.ParticipantMetaData, limit *RoomLimit, userRpc *rpcclient.User) (sID, token, liveUrl string, err error)
 	GetRoomData(ctx context.Context, roomID string) (*meeting.MeetingMetadata, error)
+	// GetRoomDataExt gets the room metadata together with the ext data which is not part of meeting.MeetingMetadata
+	GetRoomDataExt(ctx context.Context, roomID string) (*meeting.MeetingMetadata, *meetingext.MeetingMetadataExt, error)
 	GetAllRooms(ctx context.Context) ([]*livekit.Room, error)
 	GetRoom(ctx context.Context, roomID string) (*livekit.Room, error)
 	RoomIsExist(ctx context.Context, roomID string) (string, error)
-	UpdateMetaData(ctx context.Context, info *meeting.MeetingMetadata) error
+	// ModifyRoomData applies modify on the latest room data and saves it if modify returns true, modify runs again on
+	// the latest data if the room data is updated by others meanwhile, so it should not have side effects
+	ModifyRoomData(ctx context.Context, roomID string, modify func(data *RoomData) (bool, error)) error
+	// InitRoomData saves the metadata of the room which has none, the metadata saved by others is kept
+	InitRoomData(ctx context.Context, roomID string, metaData *meeting.MeetingMetadata) error
 	CloseRoom(ctx context.Context, roomID string) error
 	RemoveParticipant(ctx context.Context, roomID, userID string) error
+	// ToggleMimeStream mutes the published tracks of the stream type, errs.ErrRecordNotFound if there is none of them
 	ToggleMimeStream(ctx context.Context, roomID, userID, mineType string, mute bool) error
+	// UpdatePublishPermission limits the participant to publish the tracks of the stream types
+	UpdatePublishPermission(ctx context.Context, roomID, userID string, streamTypes []string) error
 	SendRoomData(ctx context.Context, roomID string, userIDList *[]string, sendData *meeting.NotifyMeetingData) error
+	// SendRoomExtData sends the room data which is not part of meeting.NotifyMeetingData on its own topic
+	SendRoomExtData(ctx context.Context, roomID string, userIDList *[]string, sendData *meetingext.NotifyMeetingExtData) error
+	// SendRoomSystemExtData sends the room data on the system topic as well as its own, the message type should not take
+	// the field numbers of meeting.NotifyMeetingData, so the clients only knowing meeting.NotifyMeetingData skip it
+	SendRoomSystemExtData(ctx context.Context, roomID string, userIDList *[]string, sendData *meetingext.NotifyMeetingExtData) error
+	// SendRoomChatData sends the chat message on the chat topic
+	SendRoomChatData(ctx context.Context, roomID string, userIDList *[]string, sendData *meetingext.MeetingChatMessage) error
+	// ListParticipants the metadata of the participants carries their kind, see the participant kinds in constant
 	ListParticipants(ctx context.Context, roomID string) ([]*livekit.ParticipantInfo, error)
+	// GetParticipantUserIDs errs.ErrRecordNotFound if the room is not found
 	GetParticipantUserIDs(ctx context.Context, roomID string) ([]string, error)
 	UpdateParticipantData(ctx context.Context, data *meeting.ParticipantMetaData, roomID, userID string) error
 	GetParticipantMetaData(ctx context.Context, roomID, userID string) (*meeting.ParticipantMetaData, error)
+	// StartRecording starts recording the whole room into a file
+	StartRecording(ctx context.Context, roomID string) (*Recording, error)
+	// StopRecording the files are available after the recording completes, which is notified by the webhook
+	StopRecording(ctx context.Context, recordingID string) (*Recording, error)
+	// ListRecordings lists the recordings of the room kept by the rtc server, only the ones in progress if active
+	ListRecordings(ctx context.Context, roomID string, active bool) ([]*Recording, error)
+	// StartStream starts streaming the whole room to the rtmp urls
+	StartStream(ctx context.Context, roomID string, urls []string) (*Stream, error)
+	// UpdateStream adds or removes the rtmp urls of the stream in progress
+	UpdateStream(ctx context.Context, streamID string, addURLs, removeURLs []string) (*Stream, error)
+	// StopStream errs.ErrRecordNotFound if the stream is not found
+	StopStream(ctx context.Context, streamID string) error
+	// CreateSIPTrunk creates the inbound trunk and returns its id
+	CreateSIPTrunk(ctx context.Context, trunk *SIPTrunk) (string, error)
+	// FindSIPTrunk finds the trunk accepting the calls to exactly the numbers, errs.ErrRecordNotFound if there is none
+	FindSIPTrunk(ctx context.Context, numbers []string) (string, error)
+	// CreateSIPDispatchRule dispatches the calls of the trunks entering the pin into the room and returns the id of the rule
+	CreateSIPDispatchRule(ctx context.Context, roomID, pin string, trunkIDs []string, hidePhoneNumber bool) (string, error)
+	// DeleteSIPDispatchRule errs.ErrRecordNotFound if the rule is not found
+	DeleteSIPDispatchRule(ctx context.Context, ruleID string) error
+	// ReceiveWebhook verifies the webhook is signed by the rtc server and parses the event
+	ReceiveWebhook(ctx context.Context, body []byte, authToken string) (*livekit.WebhookEvent, error)
+	// GetRoomCallback returns the callback applying the room events observed by the rtc server,
+	// onHostChanged is called after the callback handed the host over, e.g., the host left the room
+	GetRoomCallback(roomID string, onHostChanged HostChangedFunc) CallbackInterface
 }
@@ -0,0 +1,219 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/openimsdk/openmeeting-server/pkg/common/config"
+	"github.com/openimsdk/openmeeting-server/pkg/common/securetools"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/controller"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultWorkers          = 4
+	defaultQueueSize        = 1024
+	defaultTimeout          = 5 * time.Second
+	defaultMaxRetries       = 5
+	defaultRetryInterval    = 2 * time.Second
+	defaultMaxRetryInterval = 5 * time.Minute
+)
+
+// delivery is one event to one subscription, the payload is marshalled once for all the attempts.
+type delivery struct {
+	deliveryID   string
+	subscription *model.WebhookSubscription
+	eventType    string
+	payload      []byte
+	attempts     int
+}
+
+// Deliverer posts the published events to the subscriptions stored in mongo. A failed delivery is retried
+// with exponential backoff to the latest url of the subscription, and recorded as a dead letter when the retries are used up.
+type Deliverer struct {
+	storage          controller.Webhook
+	client           *http.Client
+	events           chan *Event
+	deliveries       chan *delivery
+	workers          int
+	maxRetries       int
+	retryInterval    time.Duration
+	maxRetryInterval time.Duration
+}
+
+func NewDeliverer(storage controller.Webhook, conf *config.Webhook) *Deliverer {
+	d := &Deliverer{
+		storage:          storage,
+		client:           &http.Client{Timeout: defaultTimeout},
+		workers:          defaultWorkers,
+		maxRetries:       defaultMaxRetries,
+		retryInterval:    defaultRetryInterval,
+		maxRetryInterval: defaultMaxRetryInterval,
+	}
+	queueSize := defaultQueueSize
+	if conf.Workers > 0 {
+		d.workers = conf.Workers
+	}
+	if conf.QueueSize > 0 {
+		queueSize = conf.QueueSize
+	}
+	if conf.Timeout > 0 {
+		d.client.Timeout = time.Duration(conf.Timeout) * time.Second
+	}
+	if conf.MaxRetries > 0 {
+		d.maxRetries = conf.MaxRetries
+	}
+	if conf.RetryInterval > 0 {
+		d.retryInterval = time.Duration(conf.RetryInterval) * time.Second
+	}
+	if conf.MaxRetryInterval > 0 {
+		d.maxRetryInterval = time.Duration(conf.MaxRetryInterval) * time.Second
+	}
+	d.events = make(chan *Event, queueSize)
+	d.deliveries = make(chan *delivery, queueSize)
+	return d
+}
+
+// Start runs the workers until the context is done, the retries waiting for the backoff are dropped then.
+func (d *Deliverer) Start(ctx context.Context) {
+	for i := 0; i < d.workers; i++ {
+		go d.run(ctx)
+	}
+}
+
+// Publish queues the event, it is dropped if the queue is full.
+func (d *Deliverer) Publish(ctx context.Context, event *Event) {
+	if event.EventID == "" {
+		eventID, err := securetools.GenerateToken(16)
+		if err != nil {
+			log.ZError(ctx, "generate webhook event id failed", err, "type", event.Type)
+			return
+		}
+		event.EventID = eventID
+	}
+	if event.CreateTime == 0 {
+		event.CreateTime = time.Now().UnixMilli()
+	}
+	select {
+	case d.events <- event:
+	default:
+		log.ZWarn(ctx, "webhook event queue is full, drop the event", nil, "type", event.Type, "meetingID", event.MeetingID)
+	}
+}
+
+func (d *Deliverer) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-d.events:
+			d.dispatch(ctx, event)
+		case one := <-d.deliveries:
+			d.deliver(ctx, one)
+		}
+	}
+}
+
+// dispatch fans the event out to the subscriptions of its type.
+func (d *Deliverer) dispatch(ctx context.Context, event *Event) {
+	ctx = mcontext.SetOperationID(ctx, fmt.Sprintf("webhook_%s", event.EventID))
+	subscriptions, err := d.storage.FindSubscriptionsByEvent(ctx, event.Type)
+	if err != nil {
+		log.ZError(ctx, "find webhook subscriptions failed", err, "type", event.Type)
+		return
+	}
+	if len(subscriptions) == 0 {
+		return
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		log.ZError(ctx, "marshal webhook event failed", err, "type", event.Type)
+		return
+	}
+	for _, subscription := range subscriptions {
+		d.deliver(ctx, &delivery{
+			deliveryID:   fmt.Sprintf("%s_%s", event.EventID, subscription.SubscriptionID),
+			subscription: subscription,
+			eventType:    event.Type,
+			payload:      payload,
+		})
+	}
+}
+
+func (d *Deliverer) deliver(ctx context.Context, one *delivery) {
+	ctx = mcontext.SetOperationID(ctx, one.deliveryID)
+	if one.attempts > 0 && !d.reloadSubscription(ctx, one) {
+		return
+	}
+	one.attempts++
+	err := Post(ctx, d.client, one.subscription.URL, one.subscription.Secret, one.deliveryID, one.eventType, one.payload)
+	if err == nil {
+		return
+	}
+	if one.attempts > d.maxRetries {
+		d.deadLetter(ctx, one, err)
+		return
+	}
+	backoff := d.backoff(one.attempts)
+	log.ZWarn(ctx, "webhook delivery failed, retry later", err, "url", one.subscription.URL, "attempts", one.attempts, "backoff", backoff)
+	time.AfterFunc(backoff, func() {
+		// wait for room in the queue, the delivery still has retries left
+		select {
+		case <-ctx.Done():
+		case d.deliveries <- one:
+		}
+	})
+}
+
+// reloadSubscription gets the latest url and secret of the subscription before a retry, the delivery is dropped
+// if the subscription is deleted, disabled or does not subscribe to the event anymore.
+func (d *Deliverer) reloadSubscription(ctx context.Context, one *delivery) bool {
+	subscription, err := d.storage.TakeSubscription(ctx, one.subscription.SubscriptionID)
+	if err != nil {
+		if errors.Is(err, errs.ErrRecordNotFound) {
+			log.ZInfo(ctx, "webhook subscription is deleted, drop the delivery", "subscriptionID", one.subscription.SubscriptionID)
+			return false
+		}
+		log.ZWarn(ctx, "reload webhook subscription failed, retry with the loaded one", err, "subscriptionID", one.subscription.SubscriptionID)
+		return true
+	}
+	if !subscription.Enable || (len(subscription.Events) > 0 && !datautil.Contain(one.eventType, subscription.Events...)) {
+		log.ZInfo(ctx, "webhook subscription is disabled or unsubscribed, drop the delivery", "subscriptionID", subscription.SubscriptionID, "type", one.eventType)
+		return false
+	}
+	one.subscription = subscription
+	return true
+}
+
+// backoff returns the wait before the next attempt, doubled after every failed attempt.
+func (d *Deliverer) backoff(attempts int) time.Duration {
+	backoff := d.retryInterval
+	for i := 1; i < attempts && backoff < d.maxRetryInterval; i++ {
+		backoff *= 2
+	}
+	return min(backoff, d.maxRetryInterval)
+}
+
+func (d *Deliverer) deadLetter(ctx context.Context, one *delivery, err error) {
+	log.ZError(ctx, "webhook delivery failed, move to dead letter", err, "url", one.subscription.URL, "attempts", one.attempts)
+	letter := &model.WebhookDeadLetter{
+		DeliveryID:     one.deliveryID,
+		SubscriptionID: one.subscription.SubscriptionID,
+		URL:            one.subscription.URL,
+		EventType:      one.eventType,
+		Payload:        string(one.payload),
+		Attempts:       one.attempts,
+		LastError:      err.Error(),
+		CreateTime:     time.Now().UnixMilli(),
+	}
+	if err := d.storage.CreateDeadLetter(ctx, letter); err != nil {
+		log.ZError(ctx, "save webhook dead letter failed", err, "deliveryID", one.deliveryID)
+	}
+}
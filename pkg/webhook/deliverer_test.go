@@ -0,0 +1,222 @@
+package webhook
+
+import (
+	"context"
+	"github.com/openimsdk/openmeeting-server/pkg/common/config"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/controller"
+	"github.com/openimsdk/openmeeting-server/pkg/common/storage/model"
+	"github.com/openimsdk/tools/errs"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// memoryStorage keeps the subscriptions and dead letters in memory, the other methods are not used by the Deliverer.
+type memoryStorage struct {
+	controller.Webhook
+	mu            sync.Mutex
+	subscriptions map[string]*model.WebhookSubscription
+	deadLetters   []*model.WebhookDeadLetter
+}
+
+func (m *memoryStorage) setSubscription(subscription model.WebhookSubscription) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.subscriptions[subscription.SubscriptionID] = &subscription
+}
+
+func (m *memoryStorage) TakeSubscription(_ context.Context, subscriptionID string) (*model.WebhookSubscription, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	subscription, ok := m.subscriptions[subscriptionID]
+	if !ok {
+		return nil, errs.ErrRecordNotFound.WrapMsg("subscription not found")
+	}
+	copied := *subscription
+	return &copied, nil
+}
+
+func (m *memoryStorage) CreateDeadLetter(_ context.Context, letter *model.WebhookDeadLetter) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.deadLetters = append(m.deadLetters, letter)
+	return nil
+}
+
+func (m *memoryStorage) deadLetterCount() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.deadLetters)
+}
+
+// receiver records the signed requests and fails the first failures of them.
+type receiver struct {
+	*httptest.Server
+	mu       sync.Mutex
+	failures int
+	secrets  []string
+	received chan struct{}
+}
+
+func newReceiver(t *testing.T, failures int, secrets ...string) *receiver {
+	r := &receiver{failures: failures, received: make(chan struct{}, 16)}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		timestamp, _ := strconv.ParseInt(req.Header.Get(HeaderTimestamp), 10, 64)
+		r.mu.Lock()
+		for _, secret := range secrets {
+			if Verify(secret, timestamp, body, req.Header.Get(HeaderSignature)) {
+				r.secrets = append(r.secrets, secret)
+			}
+		}
+		fail := r.failures > 0
+		r.failures--
+		r.mu.Unlock()
+		if fail {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		r.received <- struct{}{}
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+
+func (r *receiver) wait(t *testing.T, n int) {
+	t.Helper()
+	for i := 0; i < n; i++ {
+		select {
+		case <-r.received:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("received %d requests, want %d", i, n)
+		}
+	}
+}
+
+func newTestDeliverer(storage *memoryStorage, queueSize int) *Deliverer {
+	d := NewDeliverer(storage, &config.Webhook{QueueSize: queueSize, MaxRetries: 2})
+	d.retryInterval = 10 * time.Millisecond
+	d.maxRetryInterval = 20 * time.Millisecond
+	return d
+}
+
+func newTestDelivery(subscription model.WebhookSubscription) *delivery {
+	return &delivery{
+		deliveryID:   "e1_" + subscription.SubscriptionID,
+		subscription: &subscription,
+		eventType:    EventMeetingStarted,
+		payload:      []byte(`{"eventID":"e1"}`),
+	}
+}
+
+func TestDelivererRetryReloadsSubscription(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	r := newReceiver(t, 1, "old", "new")
+	storage := &memoryStorage{subscriptions: map[string]*model.WebhookSubscription{}}
+	subscription := model.WebhookSubscription{SubscriptionID: "s1", URL: r.URL, Secret: "old", Enable: true}
+	storage.setSubscription(subscription)
+	d := newTestDeliverer(storage, 0)
+	// long enough for rotating the secret before the retry
+	d.retryInterval = 500 * time.Millisecond
+	d.maxRetryInterval = d.retryInterval
+	d.Start(ctx)
+
+	d.deliver(ctx, newTestDelivery(subscription))
+	r.wait(t, 1)
+	subscription.Secret = "new"
+	storage.setSubscription(subscription)
+	r.wait(t, 1)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.secrets) != 2 || r.secrets[0] != "old" || r.secrets[1] != "new" {
+		t.Errorf("signed by %v, want [old new]", r.secrets)
+	}
+	if n := storage.deadLetterCount(); n != 0 {
+		t.Errorf("dead letters = %d, want 0", n)
+	}
+}
+
+func TestDelivererRetryDropsDisabledSubscription(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	r := newReceiver(t, 10, "secret")
+	storage := &memoryStorage{subscriptions: map[string]*model.WebhookSubscription{}}
+	subscription := model.WebhookSubscription{SubscriptionID: "s1", URL: r.URL, Secret: "secret", Enable: true}
+	storage.setSubscription(subscription)
+	d := newTestDeliverer(storage, 0)
+	d.Start(ctx)
+
+	subscription.Enable = false
+	one := newTestDelivery(subscription)
+	storage.setSubscription(subscription)
+	d.deliver(ctx, one)
+	r.wait(t, 1)
+
+	select {
+	case <-r.received:
+		t.Error("the delivery to the disabled subscription is retried")
+	case <-time.After(200 * time.Millisecond):
+	}
+	if n := storage.deadLetterCount(); n != 0 {
+		t.Errorf("dead letters = %d, want 0", n)
+	}
+}
+
+func TestDelivererRetryWaitsForFullQueue(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	r := newReceiver(t, 1, "secret")
+	storage := &memoryStorage{subscriptions: map[string]*model.WebhookSubscription{}}
+	subscription := model.WebhookSubscription{SubscriptionID: "s1", URL: r.URL, Secret: "secret", Enable: true}
+	storage.setSubscription(subscription)
+	// the workers are not started, so the queue stays full
+	d := newTestDeliverer(storage, 1)
+	d.deliveries <- newTestDelivery(model.WebhookSubscription{SubscriptionID: "s2"})
+
+	one := newTestDelivery(subscription)
+	d.deliver(ctx, one)
+	r.wait(t, 1)
+	time.Sleep(100 * time.Millisecond)
+	if n := storage.deadLetterCount(); n != 0 {
+		t.Fatalf("dead letters = %d, want 0", n)
+	}
+
+	<-d.deliveries
+	select {
+	case retry := <-d.deliveries:
+		if retry != one {
+			t.Errorf("retried %s, want %s", retry.deliveryID, one.deliveryID)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the delivery is not queued for the retry")
+	}
+}
+
+func TestDelivererDeadLetter(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	r := newReceiver(t, 10, "secret")
+	storage := &memoryStorage{subscriptions: map[string]*model.WebhookSubscription{}}
+	subscription := model.WebhookSubscription{SubscriptionID: "s1", URL: r.URL, Secret: "secret", Enable: true}
+	storage.setSubscription(subscription)
+	d := newTestDeliverer(storage, 0)
+	d.Start(ctx)
+
+	d.deliver(ctx, newTestDelivery(subscription))
+	// the first attempt and the 2 retries
+	r.wait(t, 3)
+	deadline := time.Now().Add(5 * time.Second)
+	for storage.deadLetterCount() == 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	storage.mu.Lock()
+	defer storage.mu.Unlock()
+	if len(storage.deadLetters) != 1 || storage.deadLetters[0].Attempts != 3 {
+		t.Errorf("dead letters = %+v, want one after 3 attempts", storage.deadLetters)
+	}
+}
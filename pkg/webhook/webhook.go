@@ -0,0 +1,97 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/openimsdk/tools/errs"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	EventMeetingStarted      = "meeting.started"
+	EventMeetingEnded        = "meeting.ended"
+	EventRoomFinished        = "meeting.room_finished"
+	EventHostChanged         = "meeting.host_changed"
+	EventParticipantJoined   = "participant.joined"
+	EventParticipantLeft     = "participant.left"
+	EventParticipantsRemoved = "participant.removed"
+)
+
+// EventTypes are all the event types which could be subscribed.
+var EventTypes = []string{
+	EventMeetingStarted,
+	EventMeetingEnded,
+	EventRoomFinished,
+	EventHostChanged,
+	EventParticipantJoined,
+	EventParticipantLeft,
+	EventParticipantsRemoved,
+}
+
+const (
+	HeaderEvent     = "X-OpenMeeting-Event"
+	HeaderDelivery  = "X-OpenMeeting-Delivery"
+	HeaderTimestamp = "X-OpenMeeting-Timestamp"
+	// HeaderSignature is "sha256=" followed by the hex HMAC-SHA256 of "{timestamp}.{body}" keyed by the subscription secret
+	HeaderSignature = "X-OpenMeeting-Signature"
+)
+
+// Event is one meeting or participant event posted to the subscriptions as the JSON body.
+type Event struct {
+	EventID        string            `json:"eventID"`
+	Type           string            `json:"type"`
+	MeetingID      string            `json:"meetingID"`
+	UserIDs        []string          `json:"userIDs,omitempty"` // participants concerned by the event
+	OperatorUserID string            `json:"operatorUserID,omitempty"`
+	Data           map[string]string `json:"data,omitempty"`
+	CreateTime     int64             `json:"createTime"` // in milliseconds
+}
+
+// Publisher receives the events to deliver, publishing never blocks the caller.
+type Publisher interface {
+	Publish(ctx context.Context, event *Event)
+}
+
+// Sign returns the value of HeaderSignature.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature of the request body, the receivers could use it to check the requests are sent by us.
+func Verify(secret string, timestamp int64, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
+
+// Post signs the payload with the secret and posts it to the url once, a non 2xx response is an error.
+func Post(ctx context.Context, client *http.Client, url, secret, deliveryID, eventType string, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return errs.WrapMsg(err, "new webhook request failed", "url", url)
+	}
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, eventType)
+	req.Header.Set(HeaderDelivery, deliveryID)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(secret, timestamp, payload))
+	resp, err := client.Do(req)
+	if err != nil {
+		return errs.WrapMsg(err, "webhook request failed", "url", url)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return errs.New("webhook response status is not 2xx", "url", url, "status", resp.StatusCode).Wrap()
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func TestSign(t *testing.T) {
+	// openssl dgst -sha256 -hmac secret <<< '1792310400.{"eventID":"e1"}'
+	want := "sha256=4b57327626d2b571f773574f939e7e13a79728ad674f98af21b093f1639e0465"
+	if got := Sign("secret", 1792310400, []byte(`{"eventID":"e1"}`)); got != want {
+		t.Errorf("Sign() = %q, want %q", got, want)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	body := []byte(`{"eventID":"e1"}`)
+	signature := Sign("secret", 1792310400, body)
+	tests := []struct {
+		name      string
+		secret    string
+		timestamp int64
+		body      string
+		signature string
+		ok        bool
+	}{
+		{"valid", "secret", 1792310400, string(body), signature, true},
+		{"wrong secret", "other", 1792310400, string(body), signature, false},
+		{"replayed timestamp", "secret", 1792310401, string(body), signature, false},
+		{"tampered body", "secret", 1792310400, `{"eventID":"e2"}`, signature, false},
+		{"missing prefix", "secret", 1792310400, string(body), signature[len("sha256="):], false},
+		{"empty signature", "secret", 1792310400, string(body), "", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if ok := Verify(test.secret, test.timestamp, []byte(test.body), test.signature); ok != test.ok {
+				t.Errorf("Verify() = %v, want %v", ok, test.ok)
+			}
+		})
+	}
+}
+
+func TestPost(t *testing.T) {
+	payload := []byte(`{"eventID":"e1","type":"meeting.started"}`)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		timestamp, err := strconv.ParseInt(r.Header.Get(HeaderTimestamp), 10, 64)
+		if err != nil || !Verify("secret", timestamp, body, r.Header.Get(HeaderSignature)) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.Header.Get(HeaderEvent) != EventMeetingStarted || r.Header.Get(HeaderDelivery) != "d1" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	if err := Post(context.Background(), server.Client(), server.URL, "secret", "d1", EventMeetingStarted, payload); err != nil {
+		t.Errorf("Post() error = %v", err)
+	}
+	if err := Post(context.Background(), server.Client(), server.URL, "other", "d1", EventMeetingStarted, payload); err == nil {
+		t.Error("Post() should fail on the non 2xx response")
+	}
+}